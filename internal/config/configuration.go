@@ -14,6 +14,8 @@ import (
 	"github.com/phuslu/log"
 	"github.com/rotisserie/eris"
 
+	famedModel "github.com/morphysm/famed-github-backend/internal/famed/model"
+	"github.com/morphysm/famed-github-backend/internal/famed/model/comment"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 )
 
@@ -22,7 +24,7 @@ const delimiter = "."
 const envPrefix = "FAMED_"
 
 // FamedLabelKey is the label used in GitHub to tell our backend that this issue should be tracked by famed. // Todo: make it configurable.
-const FamedLabelKey = "famed"
+const FamedLabelKey = famedModel.FamedLabelKey
 
 // NewConfig returns a fully initialized(? maybe not the best word) configuration.
 // The configuration can be set and loaded from different sources. The following load order is used:
@@ -121,6 +123,50 @@ func verifyConfig(cfg Config) error {
 		return eris.New("config.json famed.updateFrequency must be set")
 	}
 
+	if !cfg.Famed.RewardFormula.Name.IsValid() {
+		return eris.New("config.json famed.rewardFormula.name must be one of polynomial, linear, step or flat")
+	}
+
+	if cfg.Famed.RewardFormula.KMultiplier < 0 {
+		return eris.New("config.json famed.rewardFormula.kMultiplier must not be negative")
+	}
+
+	if cfg.Famed.RewardFormula.Name == famedModel.Step && len(cfg.Famed.RewardFormula.Steps) == 0 {
+		return eris.New("config.json famed.rewardFormula.steps must be set for the step formula")
+	}
+
+	if !cfg.Famed.Granularity.IsValid() {
+		return eris.New("config.json famed.granularity must be one of week, month or quarter")
+	}
+
+	if cfg.Famed.Concurrency <= 0 {
+		return eris.New("config.json famed.concurrency must be greater than 0")
+	}
+
+	if cfg.Famed.BoardURL == "" {
+		return eris.New("config.json famed.boardURL must be set")
+	}
+
+	if _, err := comment.NewTemplates(cfg.Famed.Templates, cfg.Famed.Language); err != nil {
+		return eris.Wrap(err, "config.json famed.templates must be valid templates and famed.language a supported language")
+	}
+
+	if _, err := famedModel.NewEligibilityRules(cfg.Famed.EligibilityRules); err != nil {
+		return eris.Wrap(err, "config.json famed.eligibilityRules must be known eligibility rules")
+	}
+
+	if cfg.Storage.Path == "" {
+		return eris.New("config.json storage.path must be set")
+	}
+
+	if cfg.Queue.MaxAttempts <= 0 {
+		return eris.New("config.json queue.maxAttempts must be greater than 0")
+	}
+
+	if cfg.Queue.Backoff <= 0 {
+		return eris.New("config.json queue.backoff must be greater than 0")
+	}
+
 	if err := verifyLabel(cfg, FamedLabelKey); err != nil {
 		return err
 	}
@@ -3,6 +3,7 @@ package config
 import (
 	"github.com/phuslu/log"
 
+	famedModel "github.com/morphysm/famed-github-backend/internal/famed/model"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 )
 
@@ -50,7 +51,23 @@ var defaultConfig = map[string]interface{}{
 		model.High:     10000,
 		model.Critical: 25000,
 	},
-	"famed.currency":        "POINTS",
-	"famed.daystofix":       90,
-	"famed.updatefrequency": 120,
+	"famed.currency":                    "POINTS",
+	"famed.daystofix":                   90,
+	"famed.updatefrequency":             120,
+	"famed.granularity":                 "month",
+	"famed.concurrency":                 8,
+	"famed.boardurl":                    "https://www.famed.morphysm.com/teams",
+	"famed.language":                    "en",
+	"famed.eligibilityrules":            famedModel.DefaultEligibilityRules,
+	"famed.rewardformula.name":          "polynomial",
+	"famed.rewardformula.kmultiplier":   2,
+	"famed.rewardformula.reopenpenalty": 0.1,
+	"famed.rewardformula.steps": []famedModel.RewardStep{
+		{Days: 7, Factor: 1},
+		{Days: 30, Factor: 0.5},
+		{Days: 90, Factor: 0.25},
+	},
+	"storage.path":      "famed.db",
+	"queue.maxattempts": 5,
+	"queue.backoff":     10,
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/awnumar/memguard"
 	"github.com/phuslu/log"
 
+	famedModel "github.com/morphysm/famed-github-backend/internal/famed/model"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 )
 
@@ -31,13 +32,34 @@ type Config struct {
 	} `koanf:"github"`
 
 	Famed struct {
-		Labels          map[string]model.Label          `koanf:"labels"`
-		Rewards         map[model.IssueSeverity]float64 `koanf:"rewards"`
-		Currency        string                          `koanf:"currency"`
-		DaysToFix       int                             `koanf:"daystofix"`
-		UpdateFrequency int                             `koanf:"updatefrequency"`
+		Labels           map[string]model.Label           `koanf:"labels"`
+		Rewards          map[model.IssueSeverity]float64  `koanf:"rewards"`
+		Currency         string                           `koanf:"currency"`
+		DaysToFix        int                              `koanf:"daystofix"`
+		UpdateFrequency  int                              `koanf:"updatefrequency"`
+		Granularity      famedModel.Granularity           `koanf:"granularity"`
+		Concurrency      int                              `koanf:"concurrency"`
+		BoardURL         string                           `koanf:"boardurl"`
+		Templates        model.CommentTemplates           `koanf:"templates"`
+		Language         string                           `koanf:"language"`
+		EligibilityRules []famedModel.EligibilityRuleName `koanf:"eligibilityrules"`
+		RewardFormula    struct {
+			Name          famedModel.RewardFormula `koanf:"name"`
+			KMultiplier   int                      `koanf:"kmultiplier"`
+			ReopenPenalty float64                  `koanf:"reopenpenalty"`
+			Steps         []famedModel.RewardStep  `koanf:"steps"`
+		} `koanf:"rewardformula"`
 	} `koanf:"famed"`
 
+	Storage struct {
+		Path string `koanf:"path"`
+	} `koanf:"storage"`
+
+	Queue struct {
+		MaxAttempts int `koanf:"maxattempts"`
+		Backoff     int `koanf:"backoff"`
+	} `koanf:"queue"`
+
 	// TODO this should probably not be in memory
 	RedTeamLogins map[string]string `koanf:"redteamlogins"`
 
@@ -18,6 +18,15 @@ const (
 	ProjectWebsite = "https://www.famed.morphysm.com/"
 )
 
+// version is the semantic version of the build.
+// It is set when building with -ldflags "-X github.com/morphysm/famed-github-backend/internal/devtoolkit/buildinfo.version=<version>".
+var version = "0.0.0"
+
+// Version returns the semantic version of the build.
+func Version() string {
+	return version
+}
+
 type BuildInfo struct {
 	Version         *semver.Version
 	Date            time.Time
@@ -36,13 +45,12 @@ func NewBuildInfo() (*BuildInfo, error) {
 		CompilerVersion: runtime.Version(),
 	}
 
-	// TODO: Retrieve version from vcs tag
-	version, err := semver.NewVersion("0.0.0")
+	semVersion, err := semver.NewVersion(version)
 	if err != nil {
 		return nil, eris.Wrap(err, "failed to instantiate new semver")
 	}
 
-	buildInfo.Version = version
+	buildInfo.Version = semVersion
 
 	debugBuildInfo, ok := debug.ReadBuildInfo()
 	if !ok {
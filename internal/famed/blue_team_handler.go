@@ -6,7 +6,6 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/morphysm/famed-github-backend/internal/famed/model"
-	githubModel "github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 )
 
 // GetBlueTeam returns a list of contributors for the famed board.
@@ -21,23 +20,19 @@ func (gH *githubHandler) GetBlueTeam(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, model.ErrMissingRepoPathParameter.Error())
 	}
 
-	if ok := gH.githubInstallationClient.CheckInstallation(owner); !ok {
+	window, err := gH.parseWindow(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if ok := gH.githubInstallationClient.CheckInstallation(owner); !ok || gH.repoHidden(owner, repoName) {
 		return echo.NewHTTPError(http.StatusBadRequest, model.ErrAppNotInstalled.Error())
 	}
 
-	issues, err := gH.githubInstallationClient.GetEnrichedIssues(c.Request().Context(), owner, repoName, githubModel.Closed)
+	contributors, err := gH.blueTeam(c.Request().Context(), owner, repoName, window)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadGateway, err.Error())
 	}
 
-	if len(issues) == 0 {
-		return c.JSON(http.StatusOK, []*model.Contributor{})
-	}
-
-	// Use issues with events to generate contributor list
-	rewardStructure := model.NewRewardStructure(gH.famedConfig.Rewards, gH.famedConfig.DaysToFix, 2)
-	boardOptions := model.NewBoardOptions(gH.famedConfig.Currency, rewardStructure, gH.now())
-	contributors := model.NewBlueTeamFromIssues(issues, boardOptions)
-
 	return c.JSON(http.StatusOK, contributors)
 }
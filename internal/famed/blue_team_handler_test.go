@@ -1,6 +1,7 @@
 package famed_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -14,6 +15,8 @@ import (
 	model2 "github.com/morphysm/famed-github-backend/internal/famed/model"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers/providersfakes"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage/storagefakes"
 	"github.com/morphysm/famed-github-backend/pkg/pointer"
 )
 
@@ -42,7 +45,14 @@ func NewTestConfig() model2.Config {
 		rewards,
 		labels,
 		40,
+		model2.RewardFormulaOptions{Formula: model2.Polynomial, KMultiplier: 2},
+		model2.Month,
 		"bot-user[bot]",
+		8,
+		"https://www.famed.morphysm.com/teams",
+		model.CommentTemplates{},
+		"en",
+		model2.DefaultEligibilityRules,
 	)
 }
 
@@ -58,12 +68,28 @@ func TestGetContributors(t *testing.T) {
 		Owner            string
 		RepoName         string
 		AppInstalled     bool
+		RepoHidden       bool
+		RepoConfig       model.RepoConfig
 		Issues           []model.Issue
 		Events           []model.IssueEvent
-		PullRequest      *string
+		PullRequest      *model.PullRequest
 		ExpectedResponse string
 		ExpectedErr      error
 	}{
+		{
+			Name:        "App not installed",
+			Owner:       "testOwner",
+			RepoName:    "testRepo",
+			ExpectedErr: echo.NewHTTPError(http.StatusBadRequest, model2.ErrAppNotInstalled.Error()),
+		},
+		{
+			Name:         "Repository removed from installation",
+			Owner:        "testOwner",
+			RepoName:     "testRepo",
+			AppInstalled: true,
+			RepoHidden:   true,
+			ExpectedErr:  echo.NewHTTPError(http.StatusBadRequest, model2.ErrAppNotInstalled.Error()),
+		},
 		{
 			Name:         "Valid - One issue",
 			Owner:        "testOwner",
@@ -80,7 +106,7 @@ func TestGetContributors(t *testing.T) {
 				Severities: []model.IssueSeverity{model.IssueSeverity("low")},
 				Migrated:   false,
 			}},
-			PullRequest: pointer.String("testUser"),
+			PullRequest: &model.PullRequest{URL: "testUser"},
 			Events: []model.IssueEvent{
 				{
 					Event:     "assigned",
@@ -119,7 +145,7 @@ func TestGetContributors(t *testing.T) {
 					Migrated:   false,
 				},
 			},
-			PullRequest: pointer.String("testUser"),
+			PullRequest: &model.PullRequest{URL: "testUser"},
 			Events: []model.IssueEvent{
 				{
 					Event:     "assigned",
@@ -129,6 +155,46 @@ func TestGetContributors(t *testing.T) {
 			},
 			ExpectedResponse: "[{\"login\":\"testUser\",\"avatarUrl\":\"\",\"htmlUrl\":\"\",\"fixCount\":2,\"rewards\":[{\"date\":\"2022-04-05T00:00:00Z\",\"reward\":975,\"url\":\"TestURL\"},{\"date\":\"2022-04-05T00:00:00Z\",\"reward\":975,\"url\":\"TestURL\"}],\"rewardSum\":1950,\"currency\":\"POINTS\",\"rewardsLastYear\":[{\"month\":\"4.2022\",\"reward\":1950},{\"month\":\"3.2022\",\"reward\":0},{\"month\":\"2.2022\",\"reward\":0},{\"month\":\"1.2022\",\"reward\":0},{\"month\":\"12.2021\",\"reward\":0},{\"month\":\"11.2021\",\"reward\":0},{\"month\":\"10.2021\",\"reward\":0},{\"month\":\"9.2021\",\"reward\":0},{\"month\":\"8.2021\",\"reward\":0},{\"month\":\"7.2021\",\"reward\":0},{\"month\":\"6.2021\",\"reward\":0},{\"month\":\"5.2021\",\"reward\":0}],\"timeToDisclosure\":{\"time\":[1440,1440],\"mean\":1440,\"standardDeviation\":0},\"severities\":{\"low\":2},\"meanSeverity\":2}]\n",
 		},
+		{
+			Name:         "Valid - Ineligible issue",
+			Owner:        "testOwner",
+			RepoName:     "testRepo",
+			AppInstalled: true,
+			RepoConfig:   model.RepoConfig{EligibilityRules: []string{"milestone"}},
+			Issues: []model.Issue{
+				{
+					ID:         0,
+					Number:     0,
+					HTMLURL:    "TestURL",
+					Title:      "TestIssue",
+					CreatedAt:  open,
+					ClosedAt:   &closed,
+					Assignees:  []model.User{{Login: "testUser"}},
+					Severities: []model.IssueSeverity{model.IssueSeverity("low")},
+					Milestone:  pointer.String("v1.0.0"),
+				},
+				{
+					ID:         1,
+					Number:     1,
+					HTMLURL:    "IneligibleURL",
+					Title:      "TestIssue",
+					CreatedAt:  open,
+					ClosedAt:   &closed,
+					Assignees:  []model.User{{Login: "testUser"}},
+					Severities: []model.IssueSeverity{model.IssueSeverity("low")},
+				},
+			},
+			PullRequest: &model.PullRequest{URL: "testUser"},
+			Events: []model.IssueEvent{
+				{
+					Event:     "assigned",
+					CreatedAt: time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC),
+					Assignee:  &model.User{Login: "testUser"},
+				},
+			},
+			// The issue without milestone is not part of the board
+			ExpectedResponse: "[{\"login\":\"testUser\",\"avatarUrl\":\"\",\"htmlUrl\":\"\",\"fixCount\":1,\"rewards\":[{\"date\":\"2022-04-05T00:00:00Z\",\"reward\":975,\"url\":\"TestURL\"}],\"rewardSum\":975,\"currency\":\"POINTS\",\"rewardsLastYear\":[{\"month\":\"4.2022\",\"reward\":975},{\"month\":\"3.2022\",\"reward\":0},{\"month\":\"2.2022\",\"reward\":0},{\"month\":\"1.2022\",\"reward\":0},{\"month\":\"12.2021\",\"reward\":0},{\"month\":\"11.2021\",\"reward\":0},{\"month\":\"10.2021\",\"reward\":0},{\"month\":\"9.2021\",\"reward\":0},{\"month\":\"8.2021\",\"reward\":0},{\"month\":\"7.2021\",\"reward\":0},{\"month\":\"6.2021\",\"reward\":0},{\"month\":\"5.2021\",\"reward\":0}],\"timeToDisclosure\":{\"time\":[1440],\"mean\":1440,\"standardDeviation\":0},\"severities\":{\"low\":1},\"meanSeverity\":2}]\n",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -147,13 +213,17 @@ func TestGetContributors(t *testing.T) {
 
 			fakeInstallationClient := &providersfakes.FakeInstallationClient{}
 			fakeInstallationClient.CheckInstallationReturns(testCase.AppInstalled)
+			fakeInstallationClient.GetRepoConfigReturns(testCase.RepoConfig, nil)
 			enrichedIssues := make(map[int]model.EnrichedIssue, len(testCase.Issues))
 			for _, issue := range testCase.Issues {
 				enrichedIssues[issue.Number] = model.NewEnrichIssue(issue, testCase.PullRequest, testCase.Events)
 			}
 			fakeInstallationClient.GetEnrichedIssuesReturns(enrichedIssues, nil)
 
-			githubHandler := famed.NewHandler(nil, fakeInstallationClient, famedConfig, Now)
+			fakeStore := &storagefakes.FakeStore{}
+			fakeStore.IsRepoHiddenReturns(testCase.RepoHidden, nil)
+
+			githubHandler := famed.NewHandler(nil, fakeInstallationClient, fakeStore, famedConfig, Now)
 
 			// WHEN
 			err := githubHandler.GetBlueTeam(ctx)
@@ -168,3 +238,138 @@ func TestGetContributors(t *testing.T) {
 		})
 	}
 }
+
+func TestGetContributorsFromStore(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/github/repos/testOwner/testRepo/contributors", nil)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+	ctx.SetParamNames([]string{"owner", "repo_name"}...)
+	ctx.SetParamValues([]string{"testOwner", "testRepo"}...)
+
+	fakeInstallationClient := &providersfakes.FakeInstallationClient{}
+	fakeInstallationClient.CheckInstallationReturns(true)
+	fakeStore := &storagefakes.FakeStore{}
+	fakeStore.GetBoardReturns(storage.Board{Contributors: []*model2.Contributor{{Login: "testUser", RewardSum: 975}}}, true, nil)
+
+	githubHandler := famed.NewHandler(nil, fakeInstallationClient, fakeStore, NewTestConfig(), Now)
+
+	// WHEN
+	err := githubHandler.GetBlueTeam(ctx)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, 0, fakeInstallationClient.GetEnrichedIssuesCallCount())
+	assert.Equal(t, 0, fakeStore.PutBoardCallCount())
+	assert.Contains(t, rec.Body.String(), "\"login\":\"testUser\"")
+}
+
+func TestGetContributorsWindow(t *testing.T) {
+	t.Parallel()
+
+	marchClosed := time.Date(2022, 3, 15, 0, 0, 0, 0, time.UTC)
+	aprilClosed := time.Date(2022, 4, 5, 0, 0, 0, 0, time.UTC)
+	issues := map[int]model.EnrichedIssue{
+		1: {Issue: model.Issue{Number: 1, HTMLURL: "TestURL1", CreatedAt: marchClosed.Add(-24 * time.Hour), ClosedAt: &marchClosed, Assignees: []model.User{{Login: "testUser1"}}, Severities: []model.IssueSeverity{model.Low}, Migrated: true}},
+		2: {Issue: model.Issue{Number: 2, HTMLURL: "TestURL2", CreatedAt: aprilClosed.Add(-24 * time.Hour), ClosedAt: &aprilClosed, Assignees: []model.User{{Login: "testUser2"}}, Severities: []model.IssueSeverity{model.Low}, Migrated: true}},
+	}
+
+	testCases := []struct {
+		Name                 string
+		Query                string
+		ExpectedLogins       []string
+		ExpectedRewardSeries []string
+		ExpectedErr          error
+	}{
+		{
+			Name:                 "Since and until",
+			Query:                "since=2022-03-01&until=2022-04-01",
+			ExpectedLogins:       []string{"testUser1"},
+			ExpectedRewardSeries: []string{"3.2022"},
+		},
+		{
+			Name:                 "Since only",
+			Query:                "since=2022-04-01T00:00:00Z",
+			ExpectedLogins:       []string{"testUser2"},
+			ExpectedRewardSeries: []string{"4.2022"},
+		},
+		{
+			Name:                 "Until only",
+			Query:                "until=2022-04-01",
+			ExpectedLogins:       []string{"testUser1"},
+			ExpectedRewardSeries: []string{"3.2022", "2.2022", "1.2022", "12.2021", "11.2021", "10.2021", "9.2021", "8.2021", "7.2021", "6.2021", "5.2021", "4.2021"},
+		},
+		{
+			Name:        "Invalid since",
+			Query:       "since=yesterday",
+			ExpectedErr: echo.NewHTTPError(http.StatusBadRequest, model2.ErrInvalidSinceQueryParameter.Error()),
+		},
+		{
+			Name:        "Invalid until",
+			Query:       "until=tomorrow",
+			ExpectedErr: echo.NewHTTPError(http.StatusBadRequest, model2.ErrInvalidUntilQueryParameter.Error()),
+		},
+		{
+			Name:        "Since after until",
+			Query:       "since=2022-04-01&until=2022-03-01",
+			ExpectedErr: echo.NewHTTPError(http.StatusBadRequest, model2.ErrInvalidTimeWindow.Error()),
+		},
+		{
+			Name:        "Window too large",
+			Query:       "since=1900-01-01&until=2022-03-01",
+			ExpectedErr: echo.NewHTTPError(http.StatusBadRequest, model2.ErrTimeWindowTooLarge.Error()),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// GIVEN
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/github/repos/testOwner/testRepo/contributors?"+testCase.Query, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames([]string{"owner", "repo_name"}...)
+			ctx.SetParamValues([]string{"testOwner", "testRepo"}...)
+
+			fakeInstallationClient := &providersfakes.FakeInstallationClient{}
+			fakeInstallationClient.CheckInstallationReturns(true)
+			fakeStore := &storagefakes.FakeStore{}
+			fakeStore.GetBoardReturns(storage.Board{}, true, nil)
+			fakeStore.GetIssuesReturns(issues, nil)
+
+			githubHandler := famed.NewHandler(nil, fakeInstallationClient, fakeStore, NewTestConfig(), Now)
+
+			// WHEN
+			err := githubHandler.GetBlueTeam(ctx)
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedErr, err)
+			if testCase.ExpectedErr != nil {
+				return
+			}
+
+			var contributors []*model2.Contributor
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &contributors))
+			assert.Equal(t, 0, fakeInstallationClient.GetEnrichedIssuesCallCount())
+			assert.Equal(t, 0, fakeStore.PutBoardCallCount())
+
+			logins := make([]string, len(contributors))
+			for i, contributor := range contributors {
+				logins[i] = contributor.Login
+			}
+			assert.Equal(t, testCase.ExpectedLogins, logins)
+
+			series := make([]string, len(contributors[0].RewardsLastYear))
+			for i, reward := range contributors[0].RewardsLastYear {
+				series[i] = reward.Month
+			}
+			assert.Equal(t, testCase.ExpectedRewardSeries, series)
+			assert.Equal(t, contributors[0].RewardSum, contributors[0].RewardsLastYear[0].Reward)
+		})
+	}
+}
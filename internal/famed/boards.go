@@ -0,0 +1,346 @@
+package famed
+
+import (
+	"context"
+
+	"github.com/phuslu/log"
+
+	"github.com/morphysm/famed-github-backend/internal/config"
+	"github.com/morphysm/famed-github-backend/internal/famed/model"
+	"github.com/morphysm/famed-github-backend/internal/famed/model/comment"
+	githubModel "github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage"
+)
+
+// blueTeam returns the stored blue team of a repository.
+// If no board is stored yet, the board is computed from GitHub and stored.
+// A board limited to a time window is computed from the stored issues and is not stored.
+// Issues not meeting the eligibility rules of the repository are not part of the board.
+func (gH *githubHandler) blueTeam(ctx context.Context, owner string, repoName string, window model.Window) ([]*model.Contributor, error) {
+	if !window.IsZero() {
+		issues, err := gH.closedIssues(ctx, owner, repoName)
+		if err != nil {
+			return nil, err
+		}
+
+		return model.NewBlueTeamFromIssues(gH.eligibleIssues(ctx, owner, repoName, issues), gH.repoBoardOptions(ctx, owner, repoName, window)), nil
+	}
+
+	board, found, err := gH.store.GetBoard(owner, repoName, storage.BlueTeam)
+	if err != nil {
+		log.Error().Err(err).Msgf("[blueTeam] error while reading board of %s/%s from store", owner, repoName)
+	}
+	if found {
+		return board.Contributors, nil
+	}
+
+	return gH.refreshBlueTeam(ctx, owner, repoName)
+}
+
+// redTeam returns the stored red team of a repository.
+// If no board is stored yet, the board is computed from GitHub and stored.
+// A board limited to a time window is always computed from GitHub and is not stored.
+func (gH *githubHandler) redTeam(ctx context.Context, owner string, repoName string, window model.Window) ([]*model.Contributor, error) {
+	if !window.IsZero() {
+		issues, err := gH.famedIssues(ctx, owner, repoName)
+		if err != nil {
+			return nil, err
+		}
+
+		return model.NewRedTeamFromIssues(issues, gH.repoBoardOptions(ctx, owner, repoName, window))
+	}
+
+	board, found, err := gH.store.GetBoard(owner, repoName, storage.RedTeam)
+	if err != nil {
+		log.Error().Err(err).Msgf("[redTeam] error while reading board of %s/%s from store", owner, repoName)
+	}
+	if found {
+		return board.Contributors, nil
+	}
+
+	return gH.refreshRedTeam(ctx, owner, repoName)
+}
+
+// refreshBoards recomputes and stores the blue and red team of a repository.
+func (gH *githubHandler) refreshBoards(ctx context.Context, owner string, repoName string) {
+	if _, err := gH.refreshBlueTeam(ctx, owner, repoName); err != nil {
+		log.Error().Err(err).Msgf("[refreshBoards] error while refreshing blue team of %s/%s", owner, repoName)
+	}
+
+	if _, err := gH.refreshRedTeam(ctx, owner, repoName); err != nil {
+		log.Error().Err(err).Msgf("[refreshBoards] error while refreshing red team of %s/%s", owner, repoName)
+	}
+}
+
+// refreshBlueTeam fetches the closed issues of a repository from GitHub and stores them with the resulting blue team.
+func (gH *githubHandler) refreshBlueTeam(ctx context.Context, owner string, repoName string) ([]*model.Contributor, error) {
+	issues, err := gH.fetchClosedIssues(ctx, owner, repoName)
+	if err != nil {
+		return nil, err
+	}
+
+	return gH.storeBlueTeam(ctx, owner, repoName, issues), nil
+}
+
+// closedIssues returns the stored closed issues of a repository.
+// If the issues are not stored yet, they are fetched from GitHub and stored together with the resulting blue team.
+func (gH *githubHandler) closedIssues(ctx context.Context, owner string, repoName string) (map[int]githubModel.EnrichedIssue, error) {
+	// Issues are stored together with the blue team, a stored blue team implies stored issues
+	_, found, err := gH.store.GetBoard(owner, repoName, storage.BlueTeam)
+	if err == nil && found {
+		issues, err := gH.store.GetIssues(owner, repoName)
+		if err == nil {
+			return issues, nil
+		}
+
+		log.Error().Err(err).Msgf("[closedIssues] error while reading issues of %s/%s from store", owner, repoName)
+	}
+
+	issues, err := gH.fetchClosedIssues(ctx, owner, repoName)
+	if err != nil {
+		return nil, err
+	}
+
+	gH.storeBlueTeam(ctx, owner, repoName, issues)
+
+	return issues, nil
+}
+
+// fetchClosedIssues fetches the closed issues of a repository from GitHub and stores them.
+func (gH *githubHandler) fetchClosedIssues(ctx context.Context, owner string, repoName string) (map[int]githubModel.EnrichedIssue, error) {
+	issues, err := gH.githubInstallationClient.GetEnrichedIssues(ctx, owner, repoName, githubModel.Closed)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := gH.store.PutIssues(owner, repoName, issues); err != nil {
+		log.Error().Err(err).Msgf("[fetchClosedIssues] error while storing issues of %s/%s", owner, repoName)
+	}
+
+	return issues, nil
+}
+
+// refreshRedTeam fetches the famed issues of a repository from GitHub and stores the resulting red team.
+func (gH *githubHandler) refreshRedTeam(ctx context.Context, owner string, repoName string) ([]*model.Contributor, error) {
+	issues, err := gH.famedIssues(ctx, owner, repoName)
+	if err != nil {
+		return nil, err
+	}
+
+	contributors, err := model.NewRedTeamFromIssues(issues, gH.repoBoardOptions(ctx, owner, repoName, model.Window{}))
+	if err != nil {
+		return nil, err
+	}
+
+	gH.putBoard(owner, repoName, storage.RedTeam, contributors)
+
+	return contributors, nil
+}
+
+// famedIssues fetches the issues of a repository labeled with the famed label from GitHub.
+func (gH *githubHandler) famedIssues(ctx context.Context, owner string, repoName string) ([]githubModel.Issue, error) {
+	famedLabel := gH.famedConfig.Labels[config.FamedLabelKey]
+	issueState := githubModel.All
+
+	return gH.githubInstallationClient.GetIssuesByRepo(ctx, owner, repoName, []string{famedLabel.Name}, &issueState)
+}
+
+// updateBoards applies the issues of a repository changed since the boards were last updated to the store and updates the boards.
+// If complete, the issues are all issues of the repository and the closed ones replace the stored issues,
+// otherwise the closed issues are stored, the reopened ones removed and the blue team is recomputed from the stored issues.
+// The red team is refreshed from GitHub as it is not computed from the stored issues.
+func (gH *githubHandler) updateBoards(ctx context.Context, owner string, repoName string, issues map[int]githubModel.EnrichedIssue, complete bool) {
+	if complete {
+		closedIssues := make(map[int]githubModel.EnrichedIssue, len(issues))
+		for number, issue := range issues {
+			if issue.ClosedAt != nil {
+				closedIssues[number] = issue
+			}
+		}
+
+		if err := gH.store.PutIssues(owner, repoName, closedIssues); err != nil {
+			log.Error().Err(err).Msgf("[updateBoards] error while storing issues of %s/%s", owner, repoName)
+		}
+		gH.storeBlueTeam(ctx, owner, repoName, closedIssues)
+	} else {
+		for _, issue := range issues {
+			if issue.ClosedAt != nil {
+				gH.putIssue(owner, repoName, issue)
+				continue
+			}
+			gH.deleteIssue(owner, repoName, issue.Number)
+		}
+		gH.recomputeBlueTeam(ctx, owner, repoName)
+	}
+
+	if _, err := gH.refreshRedTeam(ctx, owner, repoName); err != nil {
+		log.Error().Err(err).Msgf("[updateBoards] error while refreshing red team of %s/%s", owner, repoName)
+	}
+}
+
+// storeClosedIssue adds a closed issue to the store and updates the stored blue team.
+// The blue team is only updated if it was stored before, otherwise it is computed from GitHub on its next request.
+func (gH *githubHandler) storeClosedIssue(ctx context.Context, owner string, repoName string, issue githubModel.EnrichedIssue) {
+	if gH.putIssue(owner, repoName, issue) {
+		gH.recomputeBlueTeam(ctx, owner, repoName)
+	}
+}
+
+// removeClosedIssue removes an issue from the store and updates the stored blue team.
+// The blue team is only updated if it was stored before, otherwise it is computed from GitHub on its next request.
+func (gH *githubHandler) removeClosedIssue(ctx context.Context, owner string, repoName string, issueNumber int) {
+	if gH.deleteIssue(owner, repoName, issueNumber) {
+		gH.recomputeBlueTeam(ctx, owner, repoName)
+	}
+}
+
+// putIssue stores a closed issue and returns whether it was stored, errors are logged.
+func (gH *githubHandler) putIssue(owner string, repoName string, issue githubModel.EnrichedIssue) bool {
+	if err := gH.store.PutIssue(owner, repoName, issue); err != nil {
+		log.Error().Err(err).Msgf("[putIssue] error while storing issue %s/%s#%d", owner, repoName, issue.Number)
+		return false
+	}
+
+	return true
+}
+
+// deleteIssue removes a stored issue and returns whether it was removed, errors are logged.
+func (gH *githubHandler) deleteIssue(owner string, repoName string, issueNumber int) bool {
+	if err := gH.store.DeleteIssue(owner, repoName, issueNumber); err != nil {
+		log.Error().Err(err).Msgf("[deleteIssue] error while removing issue %s/%s#%d", owner, repoName, issueNumber)
+		return false
+	}
+
+	return true
+}
+
+// recomputeBlueTeam computes the blue team of a repository from the stored issues and stores it.
+// The blue team is only recomputed if it was stored before, otherwise the stored issues may be incomplete.
+func (gH *githubHandler) recomputeBlueTeam(ctx context.Context, owner string, repoName string) {
+	_, found, err := gH.store.GetBoard(owner, repoName, storage.BlueTeam)
+	if err != nil || !found {
+		return
+	}
+
+	issues, err := gH.store.GetIssues(owner, repoName)
+	if err != nil {
+		log.Error().Err(err).Msgf("[recomputeBlueTeam] error while reading issues of %s/%s from store", owner, repoName)
+		return
+	}
+
+	gH.storeBlueTeam(ctx, owner, repoName, issues)
+}
+
+// storeBlueTeam computes the blue team from the given issues meeting the eligibility rules of the repository and stores it.
+func (gH *githubHandler) storeBlueTeam(ctx context.Context, owner string, repoName string, issues map[int]githubModel.EnrichedIssue) []*model.Contributor {
+	contributors := model.NewBlueTeamFromIssues(gH.eligibleIssues(ctx, owner, repoName, issues), gH.repoBoardOptions(ctx, owner, repoName, model.Window{}))
+
+	gH.putBoard(owner, repoName, storage.BlueTeam, contributors)
+
+	return contributors
+}
+
+// putBoard stores a board, errors are logged since the board can always be recomputed from GitHub.
+func (gH *githubHandler) putBoard(owner string, repoName string, team storage.Team, contributors []*model.Contributor) {
+	board := storage.Board{Contributors: contributors, UpdatedAt: gH.now()}
+	if err := gH.store.PutBoard(owner, repoName, team, board); err != nil {
+		log.Error().Err(err).Msgf("[putBoard] error while storing %s team of %s/%s", team, owner, repoName)
+	}
+}
+
+// repoHidden returns whether the boards of a repository are hidden because the repository was removed from its installation.
+func (gH *githubHandler) repoHidden(owner string, repoName string) bool {
+	hidden, err := gH.store.IsRepoHidden(owner, repoName)
+	if err != nil {
+		log.Error().Err(err).Msgf("[repoHidden] error while reading visibility of %s/%s from store", owner, repoName)
+	}
+
+	return hidden
+}
+
+// repoConfig returns the famed config merged with the config committed to a repository.
+// If the repository's config cannot be read, the famed config is returned.
+func (gH *githubHandler) repoConfig(ctx context.Context, owner string, repoName string) model.Config {
+	repoConfig, err := gH.githubInstallationClient.GetRepoConfig(ctx, owner, repoName)
+	if err != nil {
+		log.Error().Err(err).Msgf("[repoConfig] error while reading config of %s/%s, falling back to famed config", owner, repoName)
+		return gH.famedConfig
+	}
+
+	return gH.famedConfig.Merge(repoConfig)
+}
+
+// repoCommentTemplates returns the comment templates of a repository.
+// If the templates or the language of the repository's config are invalid, the error is logged and the templates of the famed config are used.
+// Parsed templates are cached per repository until its comment templates or language change.
+func (gH *githubHandler) repoCommentTemplates(ctx context.Context, owner string, repoName string) comment.Templates {
+	repoConfig := gH.repoConfig(ctx, owner, repoName)
+	templates, err := gH.templates.Get(owner, repoName, repoConfig.CommentTemplates, repoConfig.Language)
+	if err == nil {
+		return templates
+	}
+	log.Error().Err(err).Msgf("[repoCommentTemplates] error while parsing comment templates or language of %s/%s, falling back to famed config", owner, repoName)
+
+	templates, err = gH.templates.Get("", "", gH.famedConfig.CommentTemplates, gH.famedConfig.Language)
+	if err != nil {
+		log.Error().Err(err).Msg("[repoCommentTemplates] error while parsing comment templates or language of famed config, falling back to default templates")
+		return comment.DefaultTemplates()
+	}
+
+	return templates
+}
+
+// repoEligibilityRules returns the eligibility rules enabled for a repository.
+// If the rules of the repository's config are unknown, the error is logged and the rules of the famed config are used.
+func (gH *githubHandler) repoEligibilityRules(ctx context.Context, owner string, repoName string) model.EligibilityRules {
+	rules, err := model.NewEligibilityRules(gH.repoConfig(ctx, owner, repoName).EligibilityRules)
+	if err == nil {
+		return rules
+	}
+	log.Error().Err(err).Msgf("[repoEligibilityRules] error while parsing eligibility rules of %s/%s, falling back to famed config", owner, repoName)
+
+	rules, err = model.NewEligibilityRules(gH.famedConfig.EligibilityRules)
+	if err != nil {
+		log.Error().Err(err).Msg("[repoEligibilityRules] error while parsing eligibility rules of famed config, falling back to default rules")
+		rules, _ = model.NewEligibilityRules(model.DefaultEligibilityRules)
+	}
+
+	return rules
+}
+
+// eligibleIssues returns the issues meeting the eligibility rules of a repository.
+// The issues are filtered when the boards are computed, so that changed rules apply to the stored issues.
+func (gH *githubHandler) eligibleIssues(ctx context.Context, owner string, repoName string, issues map[int]githubModel.EnrichedIssue) map[int]githubModel.EnrichedIssue {
+	rules := gH.repoEligibilityRules(ctx, owner, repoName)
+
+	eligible := make(map[int]githubModel.EnrichedIssue, len(issues))
+	for number, issue := range issues {
+		if err := rules.Check(issue.Issue, issue.PullRequest); err != nil {
+			log.Debug().Msgf("[eligibleIssues] skipping issue %s/%s#%d: %v", owner, repoName, issue.Number, err)
+			continue
+		}
+		eligible[number] = issue
+	}
+
+	return eligible
+}
+
+// repoBoardOptions returns the options to compute the boards and rewards of a repository with, limited to the given time window.
+// The options use the repository's config and the reward overrides set by its maintainers.
+func (gH *githubHandler) repoBoardOptions(ctx context.Context, owner string, repoName string, window model.Window) model.BoardOptions {
+	boardOptions := gH.boardOptions(gH.repoConfig(ctx, owner, repoName), window)
+
+	overrides, err := gH.store.GetOverrides(owner, repoName)
+	if err != nil {
+		log.Error().Err(err).Msgf("[repoBoardOptions] error while reading overrides of %s/%s from store", owner, repoName)
+	}
+	boardOptions.Overrides = overrides
+
+	return boardOptions
+}
+
+// boardOptions returns the options to compute boards and rewards with the given config, limited to the given time window.
+func (gH *githubHandler) boardOptions(famedConfig model.Config, window model.Window) model.BoardOptions {
+	rewardStructure := model.NewRewardStructure(famedConfig.Rewards, famedConfig.DaysToFix, famedConfig.RewardFormula)
+	return model.NewBoardOptions(famedConfig.Currency, rewardStructure, famedConfig.Granularity, window, gH.now())
+}
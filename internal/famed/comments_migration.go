@@ -0,0 +1,99 @@
+package famed
+
+import (
+	"context"
+
+	"github.com/phuslu/log"
+
+	"github.com/morphysm/famed-github-backend/internal/devtoolkit/buildinfo"
+	famedModel "github.com/morphysm/famed-github-backend/internal/famed/model"
+	"github.com/morphysm/famed-github-backend/internal/famed/model/comment"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+	libSync "github.com/morphysm/famed-github-backend/pkg/sync"
+)
+
+// commentsOutdated returns true if the bot comments of a repository were not yet migrated to the version of the current build.
+func (gH *githubHandler) commentsOutdated(owner string, repoName string) bool {
+	version, found, err := gH.store.GetCommentsVersion(owner, repoName)
+	if err != nil {
+		// Migrating the comments again is always correct
+		log.Error().Err(err).Msgf("[commentsOutdated] error while reading comments version of %s/%s", owner, repoName)
+		return true
+	}
+
+	return !found || version != buildinfo.Version()
+}
+
+// putCommentsVersion stores the version of the current build as the version the bot comments of a repository were migrated to,
+// errors are logged since the comments are migrated again by the next run.
+func (gH *githubHandler) putCommentsVersion(owner string, repoName string) {
+	if err := gH.store.PutCommentsVersion(owner, repoName, buildinfo.Version()); err != nil {
+		log.Error().Err(err).Msgf("[putCommentsVersion] error while storing comments version of %s/%s", owner, repoName)
+	}
+}
+
+// migrateComments rewrites the bot comments rendered by an older version than the current build to the current format in a concurrent fashion.
+// The comments are rewritten in place, the bodies in commentsIssues are replaced for following updates to find the migrated comments.
+func (gH *githubHandler) migrateComments(ctx context.Context, owner, repoName string, commentsIssues map[*model.EnrichedIssue][]model.IssueComment, updates *SafeIssueCommentsUpdates) error {
+	executor := libSync.NewSharedExecutor(ctx, gH.budget)
+	for issue, comments := range commentsIssues {
+		issue, comments := *issue, comments
+		executor.Go(func(ctx context.Context) error {
+			return gH.migrateIssueComments(ctx, owner, repoName, issue, comments, updates)
+		})
+	}
+
+	return executor.Wait()
+}
+
+// migrateIssueComments rewrites the outdated bot comments of an issue.
+func (gH *githubHandler) migrateIssueComments(ctx context.Context, owner, repoName string, issue model.EnrichedIssue, comments []model.IssueComment, updates *SafeIssueCommentsUpdates) error {
+	for i, com := range comments {
+		if com.User.Login != gH.famedConfig.BotLogin {
+			continue
+		}
+
+		commentType, outdated := comment.OutdatedType(com.Body)
+		if !outdated {
+			continue
+		}
+
+		body, err := gH.migratedCommentBody(ctx, owner, repoName, issue, comments, commentType, com.Body)
+		if err == nil {
+			err = gH.githubInstallationClient.UpdateComment(ctx, owner, repoName, com.ID, body)
+		}
+		if err != nil {
+			log.Error().Err(err).Msgf("[migrateIssueComments] error while migrating comment with id: %d", com.ID)
+			if updates != nil {
+				updates.AddError(issue.Number, err, commentType)
+			}
+			return err
+		}
+
+		comments[i].Body = body
+		if updates != nil {
+			updates.AddAction(issue.Number, migrateAction, commentType)
+		}
+	}
+
+	return nil
+}
+
+// migratedCommentBody returns the body of an outdated comment of the given type rendered in the current format.
+func (gH *githubHandler) migratedCommentBody(ctx context.Context, owner, repoName string, issue model.EnrichedIssue, comments []model.IssueComment, commentType comment.Type, body string) (string, error) {
+	switch commentType {
+	case comment.EligibleCommentType:
+		return comment.NewEligibleComment(gH.repoCommentTemplates(ctx, owner, repoName), gH.repoEligibilityRules(ctx, owner, repoName), issue.Issue, issue.PullRequest).String()
+	case comment.RewardCommentType:
+		return gH.currentRewardComment(ctx, owner, repoName, issue, comments).String()
+	case comment.OverridesCommentType:
+		identifier, ok := comment.ParseIdentifier(body)
+		if !ok || identifier.Overrides == nil {
+			return "", famedModel.ErrCommentNotMigratable
+		}
+
+		return comment.NewOverridesComment(*identifier.Overrides).String()
+	}
+
+	return "", famedModel.ErrCommentNotMigratable
+}
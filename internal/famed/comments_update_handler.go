@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/phuslu/log"
@@ -13,14 +15,16 @@ import (
 	"github.com/morphysm/famed-github-backend/internal/famed/model/comment"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 	"github.com/morphysm/famed-github-backend/pkg/arrays"
+	libSync "github.com/morphysm/famed-github-backend/pkg/sync"
 )
 
 type action string
 
 const (
-	updateAction action = "update"
-	orderAction  action = "order"
-	deleteAction action = "delete"
+	updateAction  action = "update"
+	orderAction   action = "order"
+	deleteAction  action = "delete"
+	migrateAction action = "migrate"
 )
 
 type SafeIssueCommentsUpdates struct {
@@ -86,6 +90,19 @@ func (sICU *SafeIssueCommentsUpdates) AddAction(issueNumber int, action action,
 	sICU.m[issueNumber] = update
 }
 
+// actionCount returns the number of actions taken on comments.
+func (sICU *SafeIssueCommentsUpdates) actionCount() int {
+	sICU.RLock()
+	defer sICU.RUnlock()
+
+	count := 0
+	for _, update := range sICU.m {
+		count += len(update.EligibleComment.Actions) + len(update.RewardComment.Actions)
+	}
+
+	return count
+}
+
 func (sICU *SafeIssueCommentsUpdates) AddError(issueNumber int, err error, commentType comment.Type) {
 	sICU.Lock()
 	defer sICU.Unlock()
@@ -107,11 +124,14 @@ func (sICU *SafeIssueCommentsUpdates) AddError(issueNumber int, err error, comme
 }
 
 type updateCommentsResponse struct {
-	Updates map[int]IssueCommentUpdate `json:"updates"`
+	Updates  map[int]IssueCommentUpdate  `json:"updates"`
+	Comments []famedModel.PlannedComment `json:"comments,omitempty"`
 }
 
 // GetUpdateComments updates the comments in a GitHub repo.
-// TODO improve efficiency. Multiple get comments calls could be avoided. Updates could be reduced to necessary.
+// If the dryRun query parameter is true, no comment is changed
+// and the comment changes that would have been made are returned with the previous bodies and diffs.
+// TODO improve efficiency. Updates could be reduced to necessary.
 func (gH *githubHandler) GetUpdateComments(ctx echo.Context) error {
 	owner := ctx.Param("owner")
 	if owner == "" {
@@ -123,54 +143,90 @@ func (gH *githubHandler) GetUpdateComments(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, famedModel.ErrMissingRepoPathParameter.Error())
 	}
 
-	if ok := gH.githubInstallationClient.CheckInstallation(owner); !ok {
-		return echo.NewHTTPError(http.StatusBadRequest, famedModel.ErrAppNotInstalled.Error())
+	var (
+		dryRun bool
+		err    error
+	)
+	if param := ctx.QueryParam("dryRun"); param != "" {
+		dryRun, err = strconv.ParseBool(param)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, famedModel.ErrInvalidDryRunQueryParameter.Error())
+		}
 	}
 
-	issues, err := gH.githubInstallationClient.GetEnrichedIssues(ctx.Request().Context(), owner, repoName, model.All)
-	if err != nil {
-		return fmt.Errorf("failed to get issues for repository: %w", err)
+	if ok := gH.githubInstallationClient.CheckInstallation(owner); !ok {
+		return echo.NewHTTPError(http.StatusBadRequest, famedModel.ErrAppNotInstalled.Error())
 	}
 
-	commentsIssues := make(map[*model.EnrichedIssue][]model.IssueComment, len(issues))
-	for _, issue := range issues {
-		comments, err := gH.githubInstallationClient.GetComments(ctx.Request().Context(), owner, repoName, issue.Number)
+	if !dryRun {
+		updates, err := gH.updateRepoComments(ctx.Request().Context(), owner, repoName)
 		if err != nil {
 			return err
 		}
-		commentsIssues[&issue] = comments
+
+		return ctx.JSON(http.StatusOK, updateCommentsResponse{Updates: updates.m})
+	}
+
+	dryRunHandler, plannedComments := gH.dryRun()
+	updates, err := dryRunHandler.updateRepoComments(ctx.Request().Context(), owner, repoName)
+	if err != nil {
+		return err
 	}
 
+	return ctx.JSON(http.StatusOK, updateCommentsResponse{Updates: updates.m, Comments: plannedComments()})
+}
+
+// updateRepoComments migrates outdated comments, deletes duplicate comments, updates and orders the comments of all issues of a repository.
+func (gH *githubHandler) updateRepoComments(ctx context.Context, owner, repoName string) (*SafeIssueCommentsUpdates, error) {
+	issues, comments, err := gH.githubInstallationClient.GetEnrichedIssuesWithComments(ctx, owner, repoName, model.All, time.Time{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issues for repository: %w", err)
+	}
+
+	commentsIssues := newCommentsIssues(issues, comments)
+
 	updates := NewSafeIssueCommentsUpdates()
-	gH.deleteDuplicateComments(ctx.Request().Context(), owner, repoName, commentsIssues, updates)
+	// Comments without identifier are only recognized as bot comments once migrated
+	_ = gH.migrateComments(ctx, owner, repoName, commentsIssues, updates)
+	gH.deleteDuplicateComments(ctx, owner, repoName, commentsIssues, updates)
 
-	var wg sync.WaitGroup
-	wg.Add(2)
+	// The errors are part of the updates
+	_ = gH.updateComments(ctx, owner, repoName, commentsIssues, updates)
+	gH.orderComments(ctx, owner, repoName, commentsIssues, updates)
 
-	go func() {
-		defer wg.Done()
-		gH.updateRewardComments(ctx.Request().Context(), owner, repoName, commentsIssues, updates)
-	}()
-	go func() {
-		defer wg.Done()
-		gH.updateEligibleComments(ctx.Request().Context(), owner, repoName, commentsIssues, updates)
-	}()
+	return updates, nil
+}
+
+// newCommentsIssues pairs the issues with their comments by issue number.
+func newCommentsIssues(issues map[int]model.EnrichedIssue, comments map[int][]model.IssueComment) map[*model.EnrichedIssue][]model.IssueComment {
+	commentsIssues := make(map[*model.EnrichedIssue][]model.IssueComment, len(issues))
+	for number := range issues {
+		issue := issues[number]
+		commentsIssues[&issue] = comments[number]
+	}
 
-	wg.Wait()
-	gH.orderComments(ctx.Request().Context(), owner, repoName, commentsIssues, updates)
+	return commentsIssues
+}
 
-	return ctx.JSON(http.StatusOK, updateCommentsResponse{Updates: updates.m})
+// updateComments updates the reward and eligible comments of the issues concurrently.
+func (gH *githubHandler) updateComments(ctx context.Context, owner, repoName string, commentsIssues map[*model.EnrichedIssue][]model.IssueComment, updates *SafeIssueCommentsUpdates) error {
+	executor := libSync.NewSharedExecutor(ctx, gH.budget)
+	executor.Go(func(ctx context.Context) error {
+		return gH.updateRewardComments(ctx, owner, repoName, commentsIssues, updates)
+	})
+	executor.Go(func(ctx context.Context) error {
+		return gH.updateEligibleComments(ctx, owner, repoName, commentsIssues, updates)
+	})
+
+	return executor.Wait()
 }
 
 // updateRewardComments checks all comments and updates comments where necessary in a concurrent fashion.
-func (gH *githubHandler) updateRewardComments(ctx context.Context, owner, repoName string, commentsIssues map[*model.EnrichedIssue][]model.IssueComment, updates *SafeIssueCommentsUpdates) {
-	var wg sync.WaitGroup
-	defer wg.Wait()
-	i := 0
+func (gH *githubHandler) updateRewardComments(ctx context.Context, owner, repoName string, commentsIssues map[*model.EnrichedIssue][]model.IssueComment, updates *SafeIssueCommentsUpdates) error {
+	executor := libSync.NewSharedExecutor(ctx, gH.budget)
 	for issue, comments := range commentsIssues {
-		wg.Add(1)
-		go func(ctx context.Context, wg *sync.WaitGroup, owner, repoName string, issue model.EnrichedIssue) {
-			defer wg.Done()
+		issue, comments := *issue, comments
+		executor.Go(func(ctx context.Context) error {
 			update, err := gH.updateRewardComment(ctx, owner, repoName, issue, comments)
 			if updates != nil && err != nil {
 				updates.AddError(issue.Number, err, comment.RewardCommentType)
@@ -178,27 +234,21 @@ func (gH *githubHandler) updateRewardComments(ctx context.Context, owner, repoNa
 			if updates != nil && update {
 				updates.AddAction(issue.Number, updateAction, comment.RewardCommentType)
 			}
-		}(ctx, &wg, owner, repoName, *issue)
-		i++
+			return err
+		})
 	}
+
+	return executor.Wait()
 }
 
 // updateRewardComment should be run as  a go routine to check a handleClosedEvent and update the handleClosedEvent if necessary.
+// Open issues are only updated if they have a reward comment from before they were reopened.
 func (gH *githubHandler) updateRewardComment(ctx context.Context, owner, repoName string, issue model.EnrichedIssue, comments []model.IssueComment) (bool, error) {
-	boardOptions := famedModel.NewBoardOptions(gH.famedConfig.Currency, famedModel.NewRewardStructure(gH.famedConfig.Rewards, gH.famedConfig.DaysToFix, 2), gH.now())
-	contributors, err := famedModel.NewBlueTeamFromIssue(issue, boardOptions)
-	var newComment comment.Comment
-	if err != nil {
-		newComment = comment.NewErrorRewardComment(err)
-	}
-	if len(contributors) == 0 {
-		newComment = comment.NewErrorRewardComment(comment.ErrNoContributors)
-	}
-	if err == nil && len(contributors) > 0 {
-		newComment = comment.NewRewardComment(contributors, gH.famedConfig.Currency, owner, repoName)
+	if _, found := comment.Comments(comments).FindComment(gH.famedConfig.BotLogin, comment.RewardCommentType); issue.ClosedAt == nil && !found {
+		return false, nil
 	}
 
-	updated, err := gH.postOrUpdateComment(ctx, owner, repoName, issue.Number, newComment, comments)
+	updated, err := gH.postOrUpdateComment(ctx, owner, repoName, issue.Number, gH.currentRewardComment(ctx, owner, repoName, issue, comments), comments)
 	if err != nil {
 		log.Error().Err(err).Msg("[updateRewardComment] error while posting reward comment")
 		return false, err
@@ -207,13 +257,43 @@ func (gH *githubHandler) updateRewardComment(ctx context.Context, owner, repoNam
 	return updated, nil
 }
 
-func (gH *githubHandler) updateEligibleComments(ctx context.Context, owner, repoName string, commentsIssues map[*model.EnrichedIssue][]model.IssueComment, updates *SafeIssueCommentsUpdates) {
-	var wg sync.WaitGroup
-	defer wg.Wait()
+// currentRewardComment returns the reward comment of an issue with the overrides of its overrides comment and the recorded payout statuses.
+// In contrast to rewardComment no payouts are recorded.
+// The reward of an open issue is pending until the issue is closed again, an issue not meeting the eligibility rules gets an error comment.
+func (gH *githubHandler) currentRewardComment(ctx context.Context, owner, repoName string, issue model.EnrichedIssue, comments []model.IssueComment) comment.Comment {
+	if issue.ClosedAt == nil {
+		return comment.NewReopenedRewardComment()
+	}
+
+	templates := gH.repoCommentTemplates(ctx, owner, repoName)
+	if err := gH.repoEligibilityRules(ctx, owner, repoName).Check(issue.Issue, issue.PullRequest); err != nil {
+		return comment.NewErrorRewardComment(templates, err)
+	}
+
+	boardOptions := gH.repoBoardOptions(ctx, owner, repoName, famedModel.Window{})
+	// The overrides comment is the source of truth for the overrides, the store only caches them
+	if overrides, found := comment.Comments(comments).FindOverrides(gH.famedConfig.BotLogin); found {
+		boardOptions = boardOptions.WithOverrides(issue.Number, overrides)
+		gH.putOverrides(owner, repoName, issue.Number, overrides)
+	}
+
+	contributors, breakdown, err := famedModel.NewBlueTeamFromIssue(issue, boardOptions)
+	if err != nil {
+		return comment.NewErrorRewardComment(templates, err)
+	}
+	if len(contributors) == 0 {
+		return comment.NewErrorRewardComment(templates, comment.ErrNoContributors)
+	}
+
+	return comment.NewRewardComment(templates, contributors, breakdown, boardOptions.Currency, gH.famedConfig.RepoBoardURL(owner, repoName), gH.issuePayoutStatuses(owner, repoName, issue.Number))
+}
+
+// updateEligibleComments checks all comments and updates eligible comments where necessary in a concurrent fashion.
+func (gH *githubHandler) updateEligibleComments(ctx context.Context, owner, repoName string, commentsIssues map[*model.EnrichedIssue][]model.IssueComment, updates *SafeIssueCommentsUpdates) error {
+	executor := libSync.NewSharedExecutor(ctx, gH.budget)
 	for issue, comments := range commentsIssues {
-		wg.Add(1)
-		go func(issue model.Issue) {
-			defer wg.Done()
+		issue, comments := issue, comments
+		executor.Go(func(ctx context.Context) error {
 			update, err := gH.updateEligibleComment(ctx, owner, repoName, issue, comments)
 			if updates != nil && err != nil {
 				updates.AddError(issue.Number, err, comment.EligibleCommentType)
@@ -221,20 +301,17 @@ func (gH *githubHandler) updateEligibleComments(ctx context.Context, owner, repo
 			if updates != nil && update {
 				updates.AddAction(issue.Number, updateAction, comment.EligibleCommentType)
 			}
-		}(issue.Issue)
+			return err
+		})
 	}
 
-	return
+	return executor.Wait()
 }
 
-func (gH *githubHandler) updateEligibleComment(ctx context.Context, owner, repoName string, issue model.Issue, comments []model.IssueComment) (bool, error) {
-	pullRequest, err := gH.githubInstallationClient.GetIssuePullRequest(ctx, owner, repoName, issue.Number)
-	if err != nil {
-		log.Error().Err(err).Msg("[updateEligibleComment] error while fetching pull request")
-		return false, err
-	}
-
-	eligibleComment := comment.NewEligibleComment(issue, pullRequest)
+// updateEligibleComment posts or updates the eligible comment of an issue.
+// The linked pull request is the one the issue was enriched with, it is not fetched again.
+func (gH *githubHandler) updateEligibleComment(ctx context.Context, owner, repoName string, issue *model.EnrichedIssue, comments []model.IssueComment) (bool, error) {
+	eligibleComment := comment.NewEligibleComment(gH.repoCommentTemplates(ctx, owner, repoName), gH.repoEligibilityRules(ctx, owner, repoName), issue.Issue, issue.PullRequest)
 	updated, err := gH.postOrUpdateComment(ctx, owner, repoName, issue.Number, eligibleComment, comments)
 	if err != nil {
 		log.Error().Err(err).Msg("[updateEligibleComment] error while posting eligible comment")
@@ -2,9 +2,11 @@ package famed_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,18 +15,20 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/morphysm/famed-github-backend/internal/famed"
+	famedModel "github.com/morphysm/famed-github-backend/internal/famed/model"
+	"github.com/morphysm/famed-github-backend/internal/famed/model/comment"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers/providersfakes"
-	"github.com/morphysm/famed-github-backend/pkg/pointer"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage/storagefakes"
 )
 
 const (
-	eligibleCommentV1 = "<!--{\"type\":\"eligible\",\"version\":\"TODO\"}-->\n" +
+	eligibleCommentV1 = "<!--{\"type\":\"eligible\",\"version\":\"0.0.0\"}-->\n" +
 		"🤖 Assignees for issue **TestIssue #0** are now eligible to Get Famed.\n\n" +
 		"✅ Add assignees to track contribution times of the issue 🦸‍♀️🦹️\n" +
 		"✅ Add a single severity (CVSS) label to compute the score 🏷️️\n\n" +
 		"Happy hacking! 🦾💙❤️️"
-	rewardCommentV1 = "<!--{\"type\":\"reward\",\"version\":\"TODO\"}-->\n@testUser - you Got Famed! 💎 Check out your new score here: https://www.famed.morphysm.com/teams/testOwner/testRepo\n| Contributor | Time | Reward |\n| ----------- | ----------- | ----------- |\n|testUser|24h0m0s|975 POINTS|"
+	rewardCommentV1 = "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n@testUser - you Got Famed! 💎 Check out your new score here: https://www.famed.morphysm.com/teams/testOwner/testRepo\n| Contributor | Time | Share | Reward | Status |\n| ----------- | ----------- | ----------- | ----------- | ----------- |\n|testUser|1 day|100%|975 POINTS|suggested|\n\n- Severity: low (1,000 POINTS)\n- Time open: 1 day of 40 days to fix\n- Reopened (k): 0\n- Decay factor: 0.975\n\n<details>\n<summary>How the reward is calculated</summary>\n\n`reward = severity reward × max(0, (1 − days open / days to fix)^(k multiplier × k + 1))`\n\n`975 = 1,000 × max(0, (1 − 1 / 40)^(2 × 0 + 1))`\n\nThe reward is divided by the share of time each contributor was assigned to the issue.\n</details>"
 )
 
 func TestGetUpdateComment(t *testing.T) {
@@ -42,7 +46,7 @@ func TestGetUpdateComment(t *testing.T) {
 		Name                               string
 		Issues                             map[int]model.EnrichedIssue
 		Comments                           []model.IssueComment
-		PullRequest                        *string
+		PullRequest                        *model.PullRequest
 		ExpectedGetEnrichedIssuesCallCount int
 		ExpectedGetCommentsCallCount       int
 		ExpectedPostCommentCallCount       int
@@ -81,9 +85,9 @@ func TestGetUpdateComment(t *testing.T) {
 				},
 			}},
 			Comments:                           []model.IssueComment{{ID: 1, User: model.User{Login: botUser}, Body: eligibleCommentV1}, {ID: 2, User: model.User{Login: botUser}, Body: rewardCommentV1}},
-			PullRequest:                        pointer.String("test"),
+			PullRequest:                        &model.PullRequest{URL: "test"},
 			ExpectedGetEnrichedIssuesCallCount: 1,
-			ExpectedGetCommentsCallCount:       1,
+			ExpectedGetCommentsCallCount:       0,
 			ExpectedPostCommentCallCount:       0,
 			ExpectedUpdateCommentCallCount:     0,
 			ExpectedDeleteCommentCallCount:     0,
@@ -119,9 +123,9 @@ func TestGetUpdateComment(t *testing.T) {
 				},
 			}},
 			Comments:                           []model.IssueComment{{ID: 1, User: model.User{Login: botUser}, Body: eligibleCommentV1 + "foo"}, {ID: 2, User: model.User{Login: botUser}, Body: rewardCommentV1}},
-			PullRequest:                        pointer.String("test"),
+			PullRequest:                        &model.PullRequest{URL: "test"},
 			ExpectedGetEnrichedIssuesCallCount: 1,
-			ExpectedGetCommentsCallCount:       1,
+			ExpectedGetCommentsCallCount:       0,
 			ExpectedPostCommentCallCount:       0,
 			ExpectedUpdateCommentCallCount:     1,
 			ExpectedDeleteCommentCallCount:     0,
@@ -157,9 +161,9 @@ func TestGetUpdateComment(t *testing.T) {
 				},
 			}},
 			Comments:                           []model.IssueComment{{ID: 1, User: model.User{Login: botUser}, Body: eligibleCommentV1}, {ID: 2, User: model.User{Login: botUser}, Body: rewardCommentV1}},
-			PullRequest:                        pointer.String("test"),
+			PullRequest:                        &model.PullRequest{URL: "test"},
 			ExpectedGetEnrichedIssuesCallCount: 1,
-			ExpectedGetCommentsCallCount:       1,
+			ExpectedGetCommentsCallCount:       0,
 			ExpectedPostCommentCallCount:       0,
 			ExpectedUpdateCommentCallCount:     1,
 			ExpectedDeleteCommentCallCount:     0,
@@ -195,9 +199,9 @@ func TestGetUpdateComment(t *testing.T) {
 				},
 			}},
 			Comments:                           []model.IssueComment{{ID: 1, User: model.User{Login: botUser}, Body: eligibleCommentV1}},
-			PullRequest:                        pointer.String("test"),
+			PullRequest:                        &model.PullRequest{URL: "test"},
 			ExpectedGetEnrichedIssuesCallCount: 1,
-			ExpectedGetCommentsCallCount:       1,
+			ExpectedGetCommentsCallCount:       0,
 			ExpectedPostCommentCallCount:       1,
 			ExpectedUpdateCommentCallCount:     0,
 			ExpectedDeleteCommentCallCount:     0,
@@ -233,9 +237,9 @@ func TestGetUpdateComment(t *testing.T) {
 				},
 			}},
 			Comments:                           []model.IssueComment{{ID: 2, User: model.User{Login: botUser}, Body: rewardCommentV1}},
-			PullRequest:                        pointer.String("test"),
+			PullRequest:                        &model.PullRequest{URL: "test"},
 			ExpectedGetEnrichedIssuesCallCount: 1,
-			ExpectedGetCommentsCallCount:       1,
+			ExpectedGetCommentsCallCount:       0,
 			ExpectedPostCommentCallCount:       1,
 			ExpectedUpdateCommentCallCount:     1,
 			ExpectedDeleteCommentCallCount:     0,
@@ -271,9 +275,9 @@ func TestGetUpdateComment(t *testing.T) {
 				},
 			}},
 			Comments:                           []model.IssueComment{{ID: 1, User: model.User{Login: botUser}, Body: rewardCommentV1}, {ID: 2, User: model.User{Login: botUser}, Body: eligibleCommentV1}},
-			PullRequest:                        pointer.String("test"),
+			PullRequest:                        &model.PullRequest{URL: "test"},
 			ExpectedGetEnrichedIssuesCallCount: 1,
-			ExpectedGetCommentsCallCount:       1,
+			ExpectedGetCommentsCallCount:       0,
 			ExpectedPostCommentCallCount:       0,
 			ExpectedUpdateCommentCallCount:     2,
 			ExpectedDeleteCommentCallCount:     0,
@@ -307,9 +311,9 @@ func TestGetUpdateComment(t *testing.T) {
 					},
 				}}},
 			Comments:                           []model.IssueComment{{ID: 1, User: model.User{Login: botUser}, Body: eligibleCommentV1}, {ID: 2, User: model.User{Login: botUser}, Body: rewardCommentV1}, {ID: 3, User: model.User{Login: botUser}, Body: eligibleCommentV1}},
-			PullRequest:                        pointer.String("test"),
+			PullRequest:                        &model.PullRequest{URL: "test"},
 			ExpectedGetEnrichedIssuesCallCount: 1,
-			ExpectedGetCommentsCallCount:       1,
+			ExpectedGetCommentsCallCount:       0,
 			ExpectedPostCommentCallCount:       0,
 			ExpectedUpdateCommentCallCount:     0,
 			ExpectedDeleteCommentCallCount:     1,
@@ -345,9 +349,9 @@ func TestGetUpdateComment(t *testing.T) {
 				},
 			}},
 			Comments:                           []model.IssueComment{{ID: 1, User: model.User{Login: botUser}, Body: eligibleCommentV1}, {ID: 2, User: model.User{Login: botUser}, Body: rewardCommentV1}, {ID: 3, User: model.User{Login: botUser}, Body: rewardCommentV1}},
-			PullRequest:                        pointer.String("test"),
+			PullRequest:                        &model.PullRequest{URL: "test"},
 			ExpectedGetEnrichedIssuesCallCount: 1,
-			ExpectedGetCommentsCallCount:       1,
+			ExpectedGetCommentsCallCount:       0,
 			ExpectedPostCommentCallCount:       0,
 			ExpectedUpdateCommentCallCount:     0,
 			ExpectedDeleteCommentCallCount:     1,
@@ -373,7 +377,11 @@ func TestGetUpdateComment(t *testing.T) {
 
 			fakeInstallationClient := &providersfakes.FakeInstallationClient{}
 			fakeInstallationClient.CheckInstallationReturns(true)
-			fakeInstallationClient.GetEnrichedIssuesReturns(testCase.Issues, nil)
+			comments := make(map[int][]model.IssueComment, len(testCase.Issues))
+			for number := range testCase.Issues {
+				comments[number] = testCase.Comments
+			}
+			fakeInstallationClient.GetEnrichedIssuesWithCommentsReturns(testCase.Issues, comments, nil)
 			fakeInstallationClient.EnrichIssuesStub = func(ctx context.Context, owner string, repoName string, issues []model.Issue) map[int]model.EnrichedIssue {
 				enrichedIssues := make(map[int]model.EnrichedIssue, len(issues))
 				for i, issue := range issues {
@@ -382,16 +390,15 @@ func TestGetUpdateComment(t *testing.T) {
 
 				return enrichedIssues
 			}
-			fakeInstallationClient.GetCommentsReturns(testCase.Comments, nil)
 
-			githubHandler := famed.NewHandler(nil, fakeInstallationClient, famedConfig, Now)
+			githubHandler := famed.NewHandler(nil, fakeInstallationClient, &storagefakes.FakeStore{}, famedConfig, Now)
 
 			// WHEN
 			err := githubHandler.GetUpdateComments(ctx)
 
 			// THEN
 			//Get issues
-			assert.Equal(t, testCase.ExpectedGetEnrichedIssuesCallCount, fakeInstallationClient.GetEnrichedIssuesCallCount())
+			assert.Equal(t, testCase.ExpectedGetEnrichedIssuesCallCount, fakeInstallationClient.GetEnrichedIssuesWithCommentsCallCount())
 
 			//Get comments
 			assert.Equal(t, testCase.ExpectedGetCommentsCallCount, fakeInstallationClient.GetCommentsCallCount())
@@ -411,6 +418,9 @@ func TestGetUpdateComment(t *testing.T) {
 			// Delete comments
 			assert.Equal(t, testCase.ExpectedDeleteCommentCallCount, fakeInstallationClient.DeleteCommentCallCount())
 
+			// The pull requests the issues were enriched with are not fetched again
+			assert.Equal(t, 0, fakeInstallationClient.GetIssuePullRequestCallCount())
+
 			// Response
 			assert.Equal(t, testCase.ExpectedResponse, rec.Body.String())
 
@@ -425,3 +435,272 @@ func TestGetUpdateComment(t *testing.T) {
 		})
 	}
 }
+
+func TestGetUpdateCommentDryRun(t *testing.T) {
+	t.Parallel()
+
+	open := time.Date(2022, 4, 4, 0, 0, 0, 0, time.UTC)
+	closed := open.Add(24 * time.Hour)
+	famedConfig := NewTestConfig()
+	botUser := famedConfig.BotLogin
+	eligibleComment := strings.ReplaceAll(eligibleCommentV1, "#0", "#1")
+	issues := map[int]model.EnrichedIssue{1: {
+		Issue: model.Issue{
+			Number:     1,
+			HTMLURL:    "TestURL",
+			Title:      "TestIssue",
+			CreatedAt:  open,
+			ClosedAt:   &closed,
+			Assignees:  []model.User{{Login: "testUser"}},
+			Severities: []model.IssueSeverity{model.IssueSeverity("low")},
+		},
+		Events: []model.IssueEvent{
+			{Event: "assigned", CreatedAt: open, Assignee: &model.User{Login: "testUser"}},
+			{Event: "closed", CreatedAt: closed, Assignee: &model.User{Login: "testUser"}},
+		},
+	}}
+
+	testCases := []struct {
+		Name             string
+		Path             string
+		Comments         []model.IssueComment
+		ExpectedComments []famedModel.PlannedComment
+		ExpectedErr      *echo.HTTPError
+	}{
+		{
+			Name:     "Rotate",
+			Path:     "/famed/repos/testOwner/testRepo/update?dryRun=true",
+			Comments: []model.IssueComment{{ID: 1, User: model.User{Login: botUser}, Body: rewardCommentV1}, {ID: 2, User: model.User{Login: botUser}, Body: eligibleComment}},
+			ExpectedComments: []famedModel.PlannedComment{
+				famedModel.NewPlannedComment(famedModel.CommentUpdated, "testOwner", "testRepo", 1, 2, eligibleComment, rewardCommentV1),
+				famedModel.NewPlannedComment(famedModel.CommentUpdated, "testOwner", "testRepo", 1, 1, rewardCommentV1, eligibleComment),
+			},
+		},
+		{
+			Name:     "Delete Reward",
+			Path:     "/famed/repos/testOwner/testRepo/update?dryRun=true",
+			Comments: []model.IssueComment{{ID: 1, User: model.User{Login: botUser}, Body: eligibleComment}, {ID: 2, User: model.User{Login: botUser}, Body: rewardCommentV1}, {ID: 3, User: model.User{Login: botUser}, Body: rewardCommentV1}},
+			ExpectedComments: []famedModel.PlannedComment{
+				famedModel.NewPlannedComment(famedModel.CommentDeleted, "testOwner", "testRepo", 1, 3, rewardCommentV1, ""),
+			},
+		},
+		{
+			Name:        "Invalid dry run",
+			Path:        "/famed/repos/testOwner/testRepo/update?dryRun=maybe",
+			ExpectedErr: echo.NewHTTPError(http.StatusBadRequest, famedModel.ErrInvalidDryRunQueryParameter.Error()),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// GIVEN
+			rec := httptest.NewRecorder()
+			ctx := echo.New().NewContext(httptest.NewRequest(http.MethodPost, testCase.Path, nil), rec)
+			ctx.SetParamNames("owner", "repo_name")
+			ctx.SetParamValues("testOwner", "testRepo")
+
+			fakeInstallationClient := &providersfakes.FakeInstallationClient{}
+			fakeInstallationClient.CheckInstallationReturns(true)
+			fakeInstallationClient.GetEnrichedIssuesWithCommentsReturns(issues, map[int][]model.IssueComment{1: testCase.Comments}, nil)
+			githubHandler := famed.NewHandler(nil, fakeInstallationClient, &storagefakes.FakeStore{}, famedConfig, Now)
+
+			// WHEN
+			err := githubHandler.GetUpdateComments(ctx)
+
+			// THEN
+			assert.Equal(t, 0, fakeInstallationClient.PostCommentCallCount())
+			assert.Equal(t, 0, fakeInstallationClient.UpdateCommentCallCount())
+			assert.Equal(t, 0, fakeInstallationClient.DeleteCommentCallCount())
+			if testCase.ExpectedErr != nil {
+				assert.Equal(t, testCase.ExpectedErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			var response struct {
+				Comments []famedModel.PlannedComment `json:"comments"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, testCase.ExpectedComments, response.Comments)
+		})
+	}
+}
+
+func TestGetUpdateCommentReopened(t *testing.T) {
+	t.Parallel()
+
+	open := time.Date(2022, 4, 4, 0, 0, 0, 0, time.UTC)
+	famedConfig := NewTestConfig()
+	botUser := famedConfig.BotLogin
+	owner := "testOwner"
+	repoName := "testRepo"
+	reopenedIssue := func(number int) model.EnrichedIssue {
+		return model.EnrichedIssue{
+			Issue: model.Issue{
+				Number:     number,
+				HTMLURL:    "TestURL",
+				Title:      "TestIssue",
+				CreatedAt:  open,
+				Assignees:  []model.User{{Login: "testUser"}},
+				Severities: []model.IssueSeverity{model.Low},
+			},
+			Events: []model.IssueEvent{
+				{Event: "assigned", CreatedAt: open, Assignee: &model.User{Login: "testUser"}},
+				{Event: "closed", CreatedAt: open.Add(24 * time.Hour)},
+				{Event: "reopened", CreatedAt: open.Add(48 * time.Hour)},
+			},
+		}
+	}
+	reopenedComment, err := comment.NewReopenedRewardComment().String()
+	assert.NoError(t, err)
+
+	// GIVEN
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/github/repos/%s/%s/update", owner, repoName), nil)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+	ctx.SetParamNames([]string{"owner", "repo_name"}...)
+	ctx.SetParamValues([]string{owner, repoName}...)
+
+	fakeInstallationClient := &providersfakes.FakeInstallationClient{}
+	fakeInstallationClient.CheckInstallationReturns(true)
+	fakeInstallationClient.GetEnrichedIssuesWithCommentsReturns(
+		map[int]model.EnrichedIssue{1: reopenedIssue(1), 2: reopenedIssue(2)},
+		map[int][]model.IssueComment{
+			1: {{ID: 1, User: model.User{Login: botUser}, Body: strings.ReplaceAll(eligibleCommentV1, "#0", "#1")}, {ID: 2, User: model.User{Login: botUser}, Body: rewardCommentV1}},
+			2: {{ID: 3, User: model.User{Login: botUser}, Body: strings.ReplaceAll(eligibleCommentV1, "#0", "#2")}},
+		},
+		nil,
+	)
+
+	githubHandler := famed.NewHandler(nil, fakeInstallationClient, &storagefakes.FakeStore{}, famedConfig, Now)
+
+	// WHEN
+	err = githubHandler.GetUpdateComments(ctx)
+
+	// THEN
+	assert.NoError(t, err)
+	// The reward comment of the reopened issue is replaced, the open issue without reward comment gets none
+	assert.Equal(t, 0, fakeInstallationClient.PostCommentCallCount())
+	assert.Equal(t, 1, fakeInstallationClient.UpdateCommentCallCount())
+	_, _, _, commentID, body := fakeInstallationClient.UpdateCommentArgsForCall(0)
+	assert.Equal(t, int64(2), commentID)
+	assert.Equal(t, reopenedComment, body)
+	assert.Equal(t, "{\"updates\":{\"1\":{\"eligibleComment\":{\"actions\":[],\"errors\":[]},\"rewardComment\":{\"actions\":[\"update\"],\"errors\":[]}}}}\n", rec.Body.String())
+}
+
+func TestGetUpdateCommentIneligible(t *testing.T) {
+	t.Parallel()
+
+	open := time.Date(2022, 4, 4, 0, 0, 0, 0, time.UTC)
+	closed := open.Add(24 * time.Hour)
+	famedConfig := NewTestConfig()
+	botUser := famedConfig.BotLogin
+	owner := "testOwner"
+	repoName := "testRepo"
+	ineligibleIssue := model.EnrichedIssue{
+		Issue: model.Issue{
+			Number:     1,
+			HTMLURL:    "TestURL",
+			Title:      "TestIssue",
+			CreatedAt:  open,
+			ClosedAt:   &closed,
+			Assignees:  []model.User{{Login: "testUser"}},
+			Severities: []model.IssueSeverity{model.Low},
+		},
+		Events: []model.IssueEvent{
+			{Event: "assigned", CreatedAt: open, Assignee: &model.User{Login: "testUser"}},
+			{Event: "closed", CreatedAt: closed},
+		},
+		PullRequest: &model.PullRequest{URL: "test"},
+	}
+	errorComment, err := comment.NewErrorRewardComment(comment.DefaultTemplates(), famedModel.ErrIssuePullRequestNotMerged).String()
+	assert.NoError(t, err)
+
+	// GIVEN
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/github/repos/%s/%s/update", owner, repoName), nil)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+	ctx.SetParamNames([]string{"owner", "repo_name"}...)
+	ctx.SetParamValues([]string{owner, repoName}...)
+
+	fakeInstallationClient := &providersfakes.FakeInstallationClient{}
+	fakeInstallationClient.CheckInstallationReturns(true)
+	fakeInstallationClient.GetRepoConfigReturns(model.RepoConfig{EligibilityRules: []string{string(famedModel.PullRequestMergedRule)}}, nil)
+	fakeInstallationClient.GetEnrichedIssuesWithCommentsReturns(
+		map[int]model.EnrichedIssue{1: ineligibleIssue},
+		map[int][]model.IssueComment{
+			1: {{ID: 1, User: model.User{Login: botUser}, Body: rewardCommentV1}},
+		},
+		nil,
+	)
+
+	githubHandler := famed.NewHandler(nil, fakeInstallationClient, &storagefakes.FakeStore{}, famedConfig, Now)
+
+	// WHEN
+	err = githubHandler.GetUpdateComments(ctx)
+
+	// THEN
+	assert.NoError(t, err)
+	// The reward comment of the issue with an unmerged pull request is replaced by an error comment
+	var rewardCommentBodies []string
+	for i := 0; i < fakeInstallationClient.UpdateCommentCallCount(); i++ {
+		_, _, _, commentID, body := fakeInstallationClient.UpdateCommentArgsForCall(i)
+		if commentID == 1 {
+			rewardCommentBodies = append(rewardCommentBodies, body)
+		}
+	}
+	assert.Equal(t, []string{errorComment}, rewardCommentBodies)
+}
+
+func TestGetUpdateCommentEligiblePullRequest(t *testing.T) {
+	t.Parallel()
+
+	open := time.Date(2022, 4, 4, 0, 0, 0, 0, time.UTC)
+	famedConfig := NewTestConfig()
+	owner := "testOwner"
+	repoName := "testRepo"
+	issue := model.EnrichedIssue{
+		Issue: model.Issue{
+			Number:     1,
+			HTMLURL:    "TestURL",
+			Title:      "TestIssue",
+			CreatedAt:  open,
+			Assignees:  []model.User{{Login: "testUser"}},
+			Severities: []model.IssueSeverity{model.Low},
+		},
+		PullRequest: &model.PullRequest{URL: "test"},
+		Events: []model.IssueEvent{
+			{Event: "assigned", CreatedAt: open, Assignee: &model.User{Login: "testUser"}},
+		},
+	}
+
+	// GIVEN
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/github/repos/%s/%s/update", owner, repoName), nil)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+	ctx.SetParamNames([]string{"owner", "repo_name"}...)
+	ctx.SetParamValues([]string{owner, repoName}...)
+
+	fakeInstallationClient := &providersfakes.FakeInstallationClient{}
+	fakeInstallationClient.CheckInstallationReturns(true)
+	fakeInstallationClient.GetRepoConfigReturns(model.RepoConfig{EligibilityRules: []string{string(famedModel.PullRequestRule)}}, nil)
+	fakeInstallationClient.GetEnrichedIssuesWithCommentsReturns(map[int]model.EnrichedIssue{1: issue}, map[int][]model.IssueComment{1: {}}, nil)
+
+	githubHandler := famed.NewHandler(nil, fakeInstallationClient, &storagefakes.FakeStore{}, famedConfig, Now)
+
+	// WHEN
+	err := githubHandler.GetUpdateComments(ctx)
+
+	// THEN
+	assert.NoError(t, err)
+	// The eligible comment is rendered with the pull request the issue was enriched with
+	assert.Equal(t, 0, fakeInstallationClient.GetIssuePullRequestCallCount())
+	assert.Equal(t, 1, fakeInstallationClient.PostCommentCallCount())
+	_, _, _, _, body := fakeInstallationClient.PostCommentArgsForCall(0)
+	assert.Contains(t, body, "✅ Link a pull request fixing the issue")
+}
@@ -0,0 +1,223 @@
+package famed
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v41/github"
+	"github.com/shurcooL/githubv4"
+
+	famedModel "github.com/morphysm/famed-github-backend/internal/famed/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage"
+)
+
+// dryRun returns a copy of the handler that reads from GitHub and the store as usual,
+// but records the comment changes it would make instead of making them and drops all other writes.
+// The recorded changes are returned by the returned function.
+func (gH *githubHandler) dryRun() (*githubHandler, func() []famedModel.PlannedComment) {
+	client := &dryRunInstallationClient{
+		InstallationClient: gH.githubInstallationClient,
+		known:              make(map[int64]knownComment),
+	}
+
+	return &githubHandler{
+		githubAppClient:          gH.githubAppClient,
+		githubInstallationClient: client,
+		store:                    dryRunStore{Store: gH.store},
+		famedConfig:              gH.famedConfig,
+		now:                      gH.now,
+		templates:                gH.templates,
+		budget:                   gH.budget,
+	}, client.plannedComments
+}
+
+// dryRunInstallationClient is an InstallationClient recording comment changes instead of sending them to GitHub.
+// The bodies of the comments read through the client are remembered to record the changes with their previous body.
+// Label changes and changes to the installation clients are dropped.
+type dryRunInstallationClient struct {
+	providers.InstallationClient
+
+	mu       sync.Mutex
+	comments []famedModel.PlannedComment
+	known    map[int64]knownComment
+}
+
+// knownComment is the body of a comment as read from GitHub or as left by a previously recorded change.
+type knownComment struct {
+	issueNumber int
+	body        string
+}
+
+func (c *dryRunInstallationClient) plannedComments() []famedModel.PlannedComment {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]famedModel.PlannedComment{}, c.comments...)
+}
+
+// remember stores the bodies of the comments of an issue, changes already recorded take precedence.
+func (c *dryRunInstallationClient) remember(issueNumber int, comments []model.IssueComment) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, comment := range comments {
+		if _, ok := c.known[comment.ID]; !ok {
+			c.known[comment.ID] = knownComment{issueNumber: issueNumber, body: comment.Body}
+		}
+	}
+}
+
+// plan records a change of the comment with the given ID, a commentID of 0 records a new comment.
+func (c *dryRunInstallationClient) plan(action famedModel.CommentAction, owner string, repoName string, issueNumber int, commentID int64, body string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	known := c.known[commentID]
+	if issueNumber == 0 {
+		issueNumber = known.issueNumber
+	}
+	if commentID != 0 {
+		c.known[commentID] = knownComment{issueNumber: issueNumber, body: body}
+	}
+
+	c.comments = append(c.comments, famedModel.NewPlannedComment(action, owner, repoName, issueNumber, commentID, known.body, body))
+}
+
+func (c *dryRunInstallationClient) GetComments(ctx context.Context, owner string, repoName string, issueNumber int) ([]model.IssueComment, error) {
+	comments, err := c.InstallationClient.GetComments(ctx, owner, repoName, issueNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	c.remember(issueNumber, comments)
+	return comments, nil
+}
+
+func (c *dryRunInstallationClient) GetEnrichedIssuesWithComments(ctx context.Context, owner string, repoName string, state model.IssueState, since time.Time) (map[int]model.EnrichedIssue, map[int][]model.IssueComment, error) {
+	issues, comments, err := c.InstallationClient.GetEnrichedIssuesWithComments(ctx, owner, repoName, state, since)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for number, issueComments := range comments {
+		c.remember(number, issueComments)
+	}
+	return issues, comments, nil
+}
+
+func (c *dryRunInstallationClient) PostComment(_ context.Context, owner string, repoName string, issueNumber int, comment string) error {
+	c.plan(famedModel.CommentPosted, owner, repoName, issueNumber, 0, comment)
+	return nil
+}
+
+func (c *dryRunInstallationClient) UpdateComment(_ context.Context, owner string, repoName string, commentID int64, comment string) error {
+	c.plan(famedModel.CommentUpdated, owner, repoName, 0, commentID, comment)
+	return nil
+}
+
+func (c *dryRunInstallationClient) DeleteComment(_ context.Context, owner string, repoName string, commentID int64) error {
+	c.plan(famedModel.CommentDeleted, owner, repoName, 0, commentID, "")
+	return nil
+}
+
+func (c *dryRunInstallationClient) PostLabel(context.Context, string, string, model.Label) error {
+	return nil
+}
+
+func (c *dryRunInstallationClient) UpdateLabel(context.Context, string, string, model.Label) error {
+	return nil
+}
+
+func (c *dryRunInstallationClient) InvalidateRepoConfig(string, string) {}
+
+func (c *dryRunInstallationClient) AddInstallation(string, int64) error {
+	return nil
+}
+
+func (c *dryRunInstallationClient) AddGitHubClient(string, *github.Client) {}
+
+func (c *dryRunInstallationClient) AddGitHubGQLClient(string, *githubv4.Client) {}
+
+func (c *dryRunInstallationClient) RemoveInstallation(string) {}
+
+func (c *dryRunInstallationClient) SuspendInstallation(string) {}
+
+// dryRunStore is a Store dropping all writes.
+type dryRunStore struct {
+	storage.Store
+}
+
+func (s dryRunStore) PutIssues(string, string, map[int]model.EnrichedIssue) error {
+	return nil
+}
+
+func (s dryRunStore) PutIssue(string, string, model.EnrichedIssue) error {
+	return nil
+}
+
+func (s dryRunStore) DeleteIssue(string, string, int) error {
+	return nil
+}
+
+func (s dryRunStore) PutBoard(string, string, storage.Team, storage.Board) error {
+	return nil
+}
+
+func (s dryRunStore) PutLedgerEntry(famedModel.LedgerEntry) error {
+	return nil
+}
+
+func (s dryRunStore) DeleteLedgerEntry(string, string, int, string) error {
+	return nil
+}
+
+func (s dryRunStore) PutOverrides(string, string, int, famedModel.RewardOverrides) error {
+	return nil
+}
+
+func (s dryRunStore) DeleteOverrides(string, string, int) error {
+	return nil
+}
+
+func (s dryRunStore) DeleteRepo(string, string) error {
+	return nil
+}
+
+func (s dryRunStore) SetRepoHidden(string, string, bool) error {
+	return nil
+}
+
+func (s dryRunStore) PutJob(job storage.Job) (storage.Job, error) {
+	return job, nil
+}
+
+func (s dryRunStore) DeleteJob(uint64) error {
+	return nil
+}
+
+func (s dryRunStore) DeadLetterJob(storage.Job, int) error {
+	return nil
+}
+
+func (s dryRunStore) AddDelivery(storage.Delivery, []byte, int) (bool, error) {
+	return true, nil
+}
+
+func (s dryRunStore) SetDeliveryStatus(string, storage.DeliveryStatus, string, time.Time) error {
+	return nil
+}
+
+func (s dryRunStore) PutHighWaterMark(string, string, time.Time) error {
+	return nil
+}
+
+func (s dryRunStore) PutCommentsVersion(string, string, string) error {
+	return nil
+}
+
+func (s dryRunStore) AddCleanStateRun(storage.CleanStateRun, int) error {
+	return nil
+}
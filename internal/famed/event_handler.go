@@ -1,35 +1,183 @@
 package famed
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
 	"net/http"
+	"time"
 
+	"github.com/google/go-github/v41/github"
 	"github.com/phuslu/log"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage"
 )
 
+// deliveryHistorySize is the number of most recent webhook deliveries recorded to skip redeliveries and replay them.
+const deliveryHistorySize = 1000
+
 // PostEvent receives the events send to the webhook set in the GitHub App.
-// IssueEvents are handled by handleIssuesEvent.
-// All other events are ignored.
+// Deliveries already received, identified by their X-GitHub-Delivery header, are skipped unless they failed or were dead-lettered.
+// Once the event queue is started, validated events are persisted as jobs and acknowledged with 202,
+// so that they are processed after the request returned and survive restarts.
+// Before that, events are processed within the request.
 func (gH *githubHandler) PostEvent(c echo.Context) error {
+	payload, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	c.Request().Body = io.NopCloser(bytes.NewReader(payload))
+
 	event, err := gH.githubInstallationClient.ValidateWebHookEvent(c.Request())
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
+	var (
+		deliveryID = github.DeliveryID(c.Request())
+		eventType  = github.WebHookType(c.Request())
+	)
+	if !gH.addDelivery(deliveryID, eventType, payload) {
+		log.Info().Msgf("[PostEvent] skipping redelivery %s", deliveryID)
+		return c.NoContent(http.StatusOK)
+	}
+
+	return gH.dispatchEvent(c, event, eventType, deliveryID, payload)
+}
+
+// dispatchEvent enqueues an event and responds with 202 once the event queue is started,
+// before that the event is handled within the request.
+func (gH *githubHandler) dispatchEvent(c echo.Context, event interface{}, eventType string, deliveryID string, payload []byte) error {
+	if gH.queue != nil {
+		err := gH.queue.enqueue(eventKey(event), eventType, deliveryID, payload)
+		if err != nil {
+			log.Error().Err(err).Msg("[dispatchEvent] error while enqueuing event")
+			gH.setDeliveryStatus(deliveryID, storage.DeliveryFailed, err)
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+
+		return c.NoContent(http.StatusAccepted)
+	}
+
+	if err := gH.handleEvent(c.Request().Context(), event); err != nil {
+		gH.setDeliveryStatus(deliveryID, storage.DeliveryFailed, err)
+		return err
+	}
+	gH.setDeliveryStatus(deliveryID, storage.DeliveryProcessed, nil)
+
+	return c.NoContent(http.StatusOK)
+}
+
+// addDelivery records a delivery and its payload and returns false if it was already received and did not fail.
+// Requests without delivery ID are not deduplicated, store errors are logged and the delivery is processed.
+func (gH *githubHandler) addDelivery(deliveryID string, eventType string, payload []byte) bool {
+	if deliveryID == "" {
+		return true
+	}
+
+	now := gH.now()
+	added, err := gH.store.AddDelivery(storage.Delivery{
+		ID:         deliveryID,
+		EventType:  eventType,
+		Status:     storage.DeliveryReceived,
+		ReceivedAt: now,
+		UpdatedAt:  now,
+	}, payload, deliveryHistorySize)
+	if err != nil {
+		log.Error().Err(err).Msgf("[addDelivery] error while recording delivery %s", deliveryID)
+		return true
+	}
+
+	return added
+}
+
+// setDeliveryStatus updates the status of a delivery, errors are logged since the status is only informational.
+func (gH *githubHandler) setDeliveryStatus(deliveryID string, status storage.DeliveryStatus, cause error) {
+	if deliveryID == "" {
+		return
+	}
+
+	var lastError string
+	if cause != nil {
+		lastError = cause.Error()
+	}
+
+	err := gH.store.SetDeliveryStatus(deliveryID, status, lastError, gH.now())
+	if err != nil {
+		log.Error().Err(err).Msgf("[setDeliveryStatus] error while updating delivery %s", deliveryID)
+	}
+}
+
+// StartEventQueue starts processing the persisted webhook events until the context is cancelled.
+// Failed events are retried up to maxAttempts times, waiting backoff before the first retry and doubling the wait with every further retry.
+func (gH *githubHandler) StartEventQueue(ctx context.Context, maxAttempts int, backoff time.Duration) {
+	// Jobs are scheduled by the wall clock, gH.now is only used for the business logic
+	gH.queue = newEventQueue(gH.store, gH.handleJob, time.Now, maxAttempts, backoff)
+	go gH.queue.run(ctx)
+}
+
+// WaitEventQueue blocks until the event queue stopped after the context it was started with was cancelled
+// and the jobs being processed returned. It returns immediately if the queue was not started.
+func (gH *githubHandler) WaitEventQueue() {
+	if gH.queue == nil {
+		return
+	}
+
+	<-gH.queue.stopped
+}
+
+// handleJob parses the payload of a queued event and handles it.
+func (gH *githubHandler) handleJob(ctx context.Context, job storage.Job) error {
+	event, err := gH.githubInstallationClient.ParseWebHookEvent(ctx, job.EventType, job.Payload)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return gH.handleEvent(ctx, event)
+}
+
+// handleEvent dispatches an event to its handler.
+// IssueEvents are handled by handleIssuesEvent.
+// IssueCommentEvents are handled by handleIssueCommentEvent.
+// PushEvents are handled by handlePushEvent.
+// All other events are ignored.
+func (gH *githubHandler) handleEvent(ctx context.Context, event interface{}) error {
 	switch event := event.(type) {
 	case model.IssuesEvent:
-		gH.issuesEventWG.Wait(event.Issue.ID)
-		defer gH.issuesEventWG.Done(event.Issue.ID)
-		return gH.handleIssuesEvent(c, event)
+		return gH.handleIssuesEvent(ctx, event)
+	case model.IssueCommentEvent:
+		return gH.handleIssueCommentEvent(ctx, event)
 	case model.InstallationRepositoriesEvent:
-		return gH.handleInstallationRepositoriesEvent(c, event)
+		return gH.handleInstallationRepositoriesEvent(ctx, event)
 	case model.InstallationEvent:
-		return gH.handleInstallationEvent(c, event)
+		return gH.handleInstallationEvent(ctx, event)
+	case model.PushEvent:
+		return gH.handlePushEvent(ctx, event)
 	default:
 		log.Warn().Msgf("received unhandled event: %v\n", event)
-		return c.NoContent(http.StatusOK)
+		return nil
+	}
+}
+
+// eventKey returns the key ordering the jobs of an event.
+// Events of the same issue are processed in order, as are the installation events of an owner and the pushes to a repository.
+func eventKey(event interface{}) string {
+	switch event := event.(type) {
+	case model.IssuesEvent:
+		return fmt.Sprintf("issue/%d", event.Issue.ID)
+	case model.IssueCommentEvent:
+		return fmt.Sprintf("issue/%d", event.Issue.ID)
+	case model.InstallationRepositoriesEvent:
+		return "installation/" + event.Installation.Account.Login
+	case model.InstallationEvent:
+		return "installation/" + event.Installation.Account.Login
+	case model.PushEvent:
+		return fmt.Sprintf("push/%s/%s", event.Repo.Owner.Login, event.Repo.Name)
+	default:
+		return "unhandled"
 	}
 }
@@ -1,36 +1,50 @@
 package famed
 
 import (
-	"net/http"
+	"context"
 
 	"github.com/phuslu/log"
 
-	"github.com/labstack/echo/v4"
-
-	model2 "github.com/morphysm/famed-github-backend/internal/famed/model"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 )
 
-// handleInstallationRepositoriesEvent adds the labels needed for Famed to the added repository
-func (gH *githubHandler) handleInstallationEvent(c echo.Context, event model.InstallationEvent) error {
-	if event.Action != "created" {
-		log.Error().Msg("[handleInstallationEvent] error is not valid installation created event")
-		return model2.ErrEventNotInstallationCreated
-	}
-
-	err := gH.githubInstallationClient.AddInstallation(event.Installation.Account.Login, event.Installation.ID)
-	if err != nil {
-		return err
-	}
-
-	repoNames := make([]string, len(event.Repositories))
-	for i, repository := range event.Repositories {
-		repoNames[i] = repository.Name
-	}
-	errors := gH.githubInstallationClient.PostLabels(c.Request().Context(), event.Installation.Account.Login, repoNames, gH.famedConfig.Labels)
-	for _, err := range errors {
-		log.Error().Err(err).Msg("[handleInstallationEvent] error while posting labels")
+// handleInstallationEvent keeps the installation clients in sync with the lifecycle of the app's installations.
+// Created installations get a client and the labels needed for Famed are reconciled in their repositories,
+// deleted installations lose their client and suspended installations have their client disabled until unsuspended.
+func (gH *githubHandler) handleInstallationEvent(ctx context.Context, event model.InstallationEvent) error {
+	owner := event.Installation.Account.Login
+
+	switch model.InstallationAction(event.Action) {
+	case model.InstallationCreated:
+		err := gH.githubInstallationClient.AddInstallation(owner, event.Installation.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, repository := range event.Repositories {
+			gH.setRepoHidden(owner, repository.Name, false)
+			gH.reconcileLabels(ctx, owner, repository.Name)
+		}
+
+	case model.InstallationDeleted:
+		gH.githubInstallationClient.RemoveInstallation(owner)
+
+	case model.InstallationSuspend:
+		gH.githubInstallationClient.SuspendInstallation(owner)
+
+	case model.InstallationUnsuspend:
+		fallthrough
+
+	case model.InstallationNewPermissionsAccepted:
+		// Adding the installation again enables its client and requests tokens with the accepted permissions
+		err := gH.githubInstallationClient.AddInstallation(owner, event.Installation.ID)
+		if err != nil {
+			return err
+		}
+
+	default:
+		log.Debug().Msgf("[handleInstallationEvent] ignoring unhandled installation action %s", event.Action)
 	}
 
-	return c.NoContent(http.StatusOK)
+	return nil
 }
@@ -15,16 +15,22 @@ import (
 	"github.com/morphysm/famed-github-backend/internal/famed/model"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers/providersfakes"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage/storagefakes"
 	"github.com/morphysm/famed-github-backend/pkg/pointer"
 )
 
 func TestPostInstallationEvent(t *testing.T) {
 	t.Parallel()
 
+	installation := &github.Installation{ID: pointer.Int64(0), Account: &github.User{Login: pointer.String("TestUser")}}
+
 	testCases := []struct {
-		Name        string
-		Event       *github.InstallationEvent
-		ExpectedErr *echo.HTTPError
+		Name            string
+		Event           *github.InstallationEvent
+		ExpectedAdd     int
+		ExpectedRemove  int
+		ExpectedSuspend int
+		ExpectedErr     *echo.HTTPError
 	}{
 		{
 			Name:        "Empty github repository event",
@@ -35,9 +41,48 @@ func TestPostInstallationEvent(t *testing.T) {
 			Name: "Valid",
 			Event: &github.InstallationEvent{
 				Action:       pointer.String("created"),
-				Installation: &github.Installation{ID: pointer.Int64(0), Account: &github.User{Login: pointer.String("TestUser")}},
+				Installation: installation,
+			},
+			ExpectedAdd: 1,
+		},
+		{
+			Name: "Deleted",
+			Event: &github.InstallationEvent{
+				Action:       pointer.String("deleted"),
+				Installation: installation,
+			},
+			ExpectedRemove: 1,
+		},
+		{
+			Name: "Suspend",
+			Event: &github.InstallationEvent{
+				Action:       pointer.String("suspend"),
+				Installation: installation,
+			},
+			ExpectedSuspend: 1,
+		},
+		{
+			Name: "Unsuspend",
+			Event: &github.InstallationEvent{
+				Action:       pointer.String("unsuspend"),
+				Installation: installation,
+			},
+			ExpectedAdd: 1,
+		},
+		{
+			Name: "New permissions accepted",
+			Event: &github.InstallationEvent{
+				Action:       pointer.String("new_permissions_accepted"),
+				Installation: installation,
+			},
+			ExpectedAdd: 1,
+		},
+		{
+			Name: "Unhandled action",
+			Event: &github.InstallationEvent{
+				Action:       pointer.String("unknown"),
+				Installation: installation,
 			},
-			ExpectedErr: nil,
 		},
 	}
 
@@ -62,14 +107,23 @@ func TestPostInstallationEvent(t *testing.T) {
 			cl, _ := providers.NewInstallationClient("", nil, nil, "", "famed", nil)
 			fakeInstallationClient.ValidateWebHookEventStub = cl.ValidateWebHookEvent
 
-			githubHandler := famed.NewHandler(nil, fakeInstallationClient, NewTestConfig(), Now)
+			githubHandler := famed.NewHandler(nil, fakeInstallationClient, &storagefakes.FakeStore{}, NewTestConfig(), Now)
 
 			// WHEN
 			err = githubHandler.PostEvent(ctx)
 
 			// THEN
 			if testCase.ExpectedErr == nil {
-				assert.Equal(t, 1, fakeInstallationClient.AddInstallationCallCount())
+				assert.Equal(t, testCase.ExpectedAdd, fakeInstallationClient.AddInstallationCallCount())
+				assert.Equal(t, testCase.ExpectedRemove, fakeInstallationClient.RemoveInstallationCallCount())
+				assert.Equal(t, testCase.ExpectedSuspend, fakeInstallationClient.SuspendInstallationCallCount())
+				if testCase.ExpectedRemove == 1 {
+					assert.Equal(t, *testCase.Event.Installation.Account.Login, fakeInstallationClient.RemoveInstallationArgsForCall(0))
+				}
+				if testCase.ExpectedSuspend == 1 {
+					assert.Equal(t, *testCase.Event.Installation.Account.Login, fakeInstallationClient.SuspendInstallationArgsForCall(0))
+				}
+				assert.NoError(t, err)
 				if fakeInstallationClient.AddInstallationCallCount() == 1 {
 					owner, installationID := fakeInstallationClient.AddInstallationArgsForCall(0)
 					assert.Equal(t, *testCase.Event.Installation.Account.Login, owner)
@@ -1,31 +1,46 @@
 package famed
 
 import (
-	"net/http"
+	"context"
 
 	"github.com/phuslu/log"
 
-	"github.com/labstack/echo/v4"
-
-	model2 "github.com/morphysm/famed-github-backend/internal/famed/model"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 )
 
-// handleInstallationRepositoriesEvent adds the labels needed for Famed to the added repository
-func (gH *githubHandler) handleInstallationRepositoriesEvent(c echo.Context, event model.InstallationRepositoriesEvent) error {
-	if event.Action != "added" {
-		log.Error().Msg("[handleInstallationRepositoriesEvent] error is not valid repo added event")
-		return model2.ErrEventNotRepoAdded
-	}
+// handleInstallationRepositoriesEvent reconciles the labels needed for Famed in the added repositories
+// and hides the boards of the removed repositories.
+func (gH *githubHandler) handleInstallationRepositoriesEvent(ctx context.Context, event model.InstallationRepositoriesEvent) error {
+	owner := event.Installation.Account.Login
 
-	repoNames := make([]string, len(event.RepositoriesAdded))
-	for i, repository := range event.RepositoriesAdded {
-		repoNames[i] = repository.Name
-	}
-	errors := gH.githubInstallationClient.PostLabels(c.Request().Context(), event.Installation.Account.Login, repoNames, gH.famedConfig.Labels)
-	for _, err := range errors {
-		log.Error().Err(err).Msg("[handleInstallationRepositoriesEvent] error while posting labels")
+	switch model.InstallationAction(event.Action) {
+	case model.InstallationRepositoriesAdded:
+		for _, repository := range event.RepositoriesAdded {
+			gH.setRepoHidden(owner, repository.Name, false)
+			gH.reconcileLabels(ctx, owner, repository.Name)
+		}
+
+	case model.InstallationRepositoriesRemoved:
+		// Stored issues and boards are dropped, they are recomputed from GitHub if the repository is added again
+		for _, repository := range event.RepositoriesRemoved {
+			gH.setRepoHidden(owner, repository.Name, true)
+
+			if err := gH.store.DeleteRepo(owner, repository.Name); err != nil {
+				log.Error().Err(err).Msgf("[handleInstallationRepositoriesEvent] error while removing %s/%s from store", owner, repository.Name)
+			}
+			gH.githubInstallationClient.InvalidateRepoConfig(owner, repository.Name)
+		}
+
+	default:
+		log.Debug().Msgf("[handleInstallationRepositoriesEvent] ignoring unhandled installation repositories action %s", event.Action)
 	}
 
-	return c.NoContent(http.StatusOK)
+	return nil
+}
+
+// setRepoHidden hides or shows the boards of a repository, errors are logged since the repository's visibility is restored on its next installation event.
+func (gH *githubHandler) setRepoHidden(owner string, repoName string, hidden bool) {
+	if err := gH.store.SetRepoHidden(owner, repoName, hidden); err != nil {
+		log.Error().Err(err).Msgf("[setRepoHidden] error while storing visibility of %s/%s", owner, repoName)
+	}
 }
@@ -17,6 +17,7 @@ import (
 	model "github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers/providersfakes"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage/storagefakes"
 	"github.com/morphysm/famed-github-backend/pkg/pointer"
 )
 
@@ -37,10 +38,11 @@ func TestPostInstallationRepositoriesEvent(t *testing.T) {
 	}
 
 	testCases := []struct {
-		Name          string
-		Event         *github.InstallationRepositoriesEvent
-		ExpectedRepos []string
-		ExpectedErr   *echo.HTTPError
+		Name                 string
+		Event                *github.InstallationRepositoriesEvent
+		ExpectedRepos        []string
+		ExpectedRemovedRepos []string
+		ExpectedErr          *echo.HTTPError
 	}{
 		{
 			Name:        "Empty github event",
@@ -57,6 +59,16 @@ func TestPostInstallationRepositoriesEvent(t *testing.T) {
 			ExpectedRepos: []string{"TestRepo1"},
 			ExpectedErr:   nil,
 		},
+		{
+			Name: "Removed",
+			Event: &github.InstallationRepositoriesEvent{
+				Action:              pointer.String("removed"),
+				RepositoriesRemoved: []*github.Repository{{Name: pointer.String("TestRepo1")}, {Name: pointer.String("TestRepo2")}},
+				Installation:        &github.Installation{Account: &github.User{Login: pointer.String("TestUser")}},
+			},
+			ExpectedRemovedRepos: []string{"TestRepo1", "TestRepo2"},
+			ExpectedErr:          nil,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -80,20 +92,38 @@ func TestPostInstallationRepositoriesEvent(t *testing.T) {
 			cl, _ := providers.NewInstallationClient("", nil, nil, "", "famed", nil)
 			fakeInstallationClient.ValidateWebHookEventStub = cl.ValidateWebHookEvent
 
-			githubHandler := famed.NewHandler(nil, fakeInstallationClient, famedConfig, Now)
+			fakeStore := &storagefakes.FakeStore{}
+			githubHandler := famed.NewHandler(nil, fakeInstallationClient, fakeStore, famedConfig, Now)
 
 			// WHEN
 			err = githubHandler.PostEvent(ctx)
 
 			// THEN
+			if testCase.ExpectedRemovedRepos != nil {
+				assert.NoError(t, err)
+				assert.Equal(t, 0, fakeInstallationClient.GetLabelsCallCount())
+				assert.Equal(t, len(testCase.ExpectedRemovedRepos), fakeStore.DeleteRepoCallCount())
+				assert.Equal(t, len(testCase.ExpectedRemovedRepos), fakeStore.SetRepoHiddenCallCount())
+				for i, expectedRepo := range testCase.ExpectedRemovedRepos {
+					owner, repoName, hidden := fakeStore.SetRepoHiddenArgsForCall(i)
+					assert.Equal(t, *testCase.Event.Installation.Account.Login, owner)
+					assert.Equal(t, expectedRepo, repoName)
+					assert.True(t, hidden)
+				}
+				return
+			}
+
 			if testCase.ExpectedErr == nil {
-				assert.Equal(t, 1, fakeInstallationClient.PostLabelsCallCount())
-				if fakeInstallationClient.PostLabelsCallCount() == 1 {
-					_, owner, repos, labels := fakeInstallationClient.PostLabelsArgsForCall(0)
+				assert.Equal(t, len(testCase.ExpectedRepos), fakeInstallationClient.GetLabelsCallCount())
+				assert.Equal(t, len(testCase.ExpectedRepos)*len(famedConfig.Labels), fakeInstallationClient.PostLabelCallCount())
+				labels := make(map[string]model.Label, fakeInstallationClient.PostLabelCallCount())
+				for i := 0; i < fakeInstallationClient.PostLabelCallCount(); i++ {
+					_, owner, repoName, label := fakeInstallationClient.PostLabelArgsForCall(i)
 					assert.Equal(t, *testCase.Event.Installation.Account.Login, owner)
-					assert.Equal(t, testCase.ExpectedRepos, repos)
-					assert.Equal(t, famedConfig.Labels, labels)
+					assert.Contains(t, testCase.ExpectedRepos, repoName)
+					labels[label.Name] = label
 				}
+				assert.Equal(t, famedConfig.Labels, labels)
 			} else {
 				assert.Equal(t, testCase.ExpectedErr, err)
 			}
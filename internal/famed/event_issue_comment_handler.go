@@ -0,0 +1,114 @@
+package famed
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/phuslu/log"
+
+	famedModel "github.com/morphysm/famed-github-backend/internal/famed/model"
+	"github.com/morphysm/famed-github-backend/internal/famed/model/comment"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+// handleIssueCommentEvent applies the Famed commands of a new issue comment to the reward overrides of the issue.
+// Commands are only accepted from users with write permission on the repository, invalid commands are logged and ignored.
+// The overrides are stored in the overrides comment and the reward comment of a closed issue is recalculated.
+func (gH *githubHandler) handleIssueCommentEvent(ctx context.Context, event model.IssueCommentEvent) error {
+	if event.Action != "created" ||
+		strings.EqualFold(event.Comment.User.Login, gH.famedConfig.BotLogin) ||
+		!famedModel.HasCommands(event.Comment.Body) {
+		return nil
+	}
+
+	var (
+		owner    = event.Repo.Owner.Login
+		repoName = event.Repo.Name
+	)
+
+	permission, err := gH.githubInstallationClient.GetPermission(ctx, owner, repoName, event.Comment.User.Login)
+	if err != nil {
+		log.Error().Err(err).Msg("[handleIssueCommentEvent] error while retrieving commenter permission")
+		return echo.NewHTTPError(http.StatusBadGateway, err.Error())
+	}
+	if !permission.CanMaintain() {
+		log.Warn().Msgf("[handleIssueCommentEvent] ignoring commands of %s without write permission on %s/%s", event.Comment.User.Login, owner, repoName)
+		return nil
+	}
+
+	commands, err := famedModel.ParseCommands(event.Comment.Body)
+	if err != nil {
+		log.Warn().Msgf("[handleIssueCommentEvent] ignoring invalid command in comment %d on %s/%s: %v", event.Comment.ID, owner, repoName, err)
+		return nil
+	}
+
+	comments, err := gH.githubInstallationClient.GetComments(ctx, owner, repoName, event.Issue.Number)
+	if err != nil {
+		log.Error().Err(err).Msg("[handleIssueCommentEvent] error while retrieving comments")
+		return echo.NewHTTPError(http.StatusBadGateway, err.Error())
+	}
+
+	overrides, found := comment.Comments(comments).FindOverrides(gH.famedConfig.BotLogin)
+	overrides = overrides.Apply(commands)
+	gH.putOverrides(owner, repoName, event.Issue.Number, overrides)
+
+	if found || !overrides.IsZero() {
+		if _, err := gH.postOrUpdateComment(ctx, owner, repoName, event.Issue.Number, comment.NewOverridesComment(overrides), comments); err != nil {
+			log.Error().Err(err).Msg("[handleIssueCommentEvent] error while posting overrides comment")
+			return echo.NewHTTPError(http.StatusBadGateway, err.Error())
+		}
+	}
+
+	// Rewards are only calculated for closed issues
+	if event.Issue.ClosedAt == nil {
+		return nil
+	}
+
+	templates := gH.repoCommentTemplates(ctx, owner, repoName)
+	issue := gH.githubInstallationClient.EnrichIssue(ctx, owner, repoName, event.Issue)
+	gH.storeClosedIssue(ctx, owner, repoName, issue)
+
+	// Payouts are only recorded for issues meeting the eligibility rules
+	var rewardComment comment.Comment
+	if err := gH.repoEligibilityRules(ctx, owner, repoName).Check(issue.Issue, issue.PullRequest); err != nil {
+		rewardComment = comment.NewErrorRewardComment(templates, err)
+	} else {
+		boardOptions := gH.repoBoardOptions(ctx, owner, repoName, famedModel.Window{}).WithOverrides(issue.Number, overrides)
+		rewardComment = gH.rewardComment(owner, repoName, issue, boardOptions, templates)
+	}
+
+	if _, err := gH.postOrUpdateComment(ctx, owner, repoName, issue.Number, rewardComment, comments); err != nil {
+		log.Error().Err(err).Msg("[handleIssueCommentEvent] error while posting reward comment")
+		return echo.NewHTTPError(http.StatusBadGateway, err.Error())
+	}
+
+	return nil
+}
+
+// putOverrides stores the reward overrides of an issue, errors are logged since the overrides are kept in the overrides comment.
+func (gH *githubHandler) putOverrides(owner string, repoName string, issueNumber int, overrides famedModel.RewardOverrides) {
+	if err := gH.store.PutOverrides(owner, repoName, issueNumber, overrides); err != nil {
+		log.Error().Err(err).Msgf("[putOverrides] error while storing overrides of %s/%s#%d", owner, repoName, issueNumber)
+	}
+}
+
+// moveOverrides moves the reward overrides of a transferred issue to the issue's new location.
+func (gH *githubHandler) moveOverrides(owner string, repoName string, issueNumber int, transfer model.IssueTransfer) {
+	overrides, err := gH.store.GetOverrides(owner, repoName)
+	if err != nil {
+		log.Error().Err(err).Msgf("[moveOverrides] error while reading overrides of %s/%s from store", owner, repoName)
+		return
+	}
+
+	issueOverrides, found := overrides[issueNumber]
+	if !found {
+		return
+	}
+
+	gH.putOverrides(transfer.Repo.Owner.Login, transfer.Repo.Name, transfer.Number, issueOverrides)
+	if err := gH.store.DeleteOverrides(owner, repoName, issueNumber); err != nil {
+		log.Error().Err(err).Msgf("[moveOverrides] error while removing overrides of %s/%s#%d", owner, repoName, issueNumber)
+	}
+}
@@ -0,0 +1,154 @@
+package famed_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v41/github"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/internal/famed"
+	famedModel "github.com/morphysm/famed-github-backend/internal/famed/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers/providersfakes"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage/storagefakes"
+	"github.com/morphysm/famed-github-backend/pkg/pointer"
+)
+
+//nolint:funlen
+func TestPostIssueCommentEvent(t *testing.T) {
+	t.Parallel()
+
+	newEvent := func(body string) *github.IssueCommentEvent {
+		return &github.IssueCommentEvent{
+			Action: pointer.String("created"),
+			Issue: &github.Issue{
+				ID:        pointer.Int64(0),
+				Title:     pointer.String("test"),
+				HTMLURL:   pointer.String("TestURL"),
+				Labels:    []*github.Label{{Name: pointer.String("famed")}, {Name: pointer.String("high")}},
+				Number:    pointer.Int(1),
+				Assignees: []*github.User{{Login: pointer.String("alice")}},
+				CreatedAt: pointer.Time(time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)),
+				ClosedAt:  pointer.Time(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			Comment: &github.IssueComment{
+				ID:   pointer.Int64(2),
+				Body: pointer.String(body),
+				User: &github.User{Login: pointer.String("maintainer")},
+			},
+			Repo: &github.Repository{
+				Name:  pointer.String("test"),
+				Owner: &github.User{Login: pointer.String("test")},
+			},
+		}
+	}
+
+	testCases := []struct {
+		Name                    string
+		Event                   *github.IssueCommentEvent
+		Permission              model.Permission
+		ExpectedPermissionCalls int
+		ExpectedOverrides       *famedModel.RewardOverrides
+		ExpectedComments        []string
+		ExpectedErr             *echo.HTTPError
+	}{
+		{
+			Name:  "No command",
+			Event: newEvent("Thanks for the fix!"),
+		},
+		{
+			Name:                    "Invalid command",
+			Event:                   newEvent("/famed pay @alice"),
+			Permission:              model.PermissionWrite,
+			ExpectedPermissionCalls: 1,
+		},
+		{
+			Name:                    "Missing permission",
+			Event:                   newEvent("/famed exclude @alice"),
+			Permission:              model.PermissionRead,
+			ExpectedPermissionCalls: 1,
+		},
+		{
+			Name:                    "Invalid command without permission",
+			Event:                   newEvent("/famed pay @alice"),
+			Permission:              model.PermissionRead,
+			ExpectedPermissionCalls: 1,
+		},
+		{
+			Name:                    "Split",
+			Event:                   newEvent("/famed split @alice 60 @bob 40"),
+			Permission:              model.PermissionWrite,
+			ExpectedPermissionCalls: 1,
+			ExpectedOverrides:       &famedModel.RewardOverrides{Split: map[string]float64{"alice": 60, "bob": 40}},
+			ExpectedComments: []string{
+				"<!--{\"type\":\"overrides\",\"version\":\"0.0.0\",\"overrides\":{\"split\":{\"alice\":60,\"bob\":40}}}-->\n### Famed reward overrides\n- Split: @alice 60, @bob 40",
+				"<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n@alice @bob - you Got Famed! 💎 Check out your new score here: https://www.famed.morphysm.com/teams/test/test\n| Contributor | Time | Share | Reward | Status |\n| ----------- | ----------- | ----------- | ----------- | ----------- |\n|alice|31 days|60%|404 POINTS|suggested|\n|bob|0 minutes|40%|269 POINTS|suggested|\n\n- Severity: high (3,000 POINTS)\n- Time open: 31 days of 40 days to fix\n- Reopened (k): 0\n- Decay factor: 0.225\n\n<details>\n<summary>How the reward is calculated</summary>\n\n`reward = severity reward × max(0, (1 − days open / days to fix)^(k multiplier × k + 1))`\n\n`675 = 3,000 × max(0, (1 − 31 / 40)^(2 × 0 + 1))`\n\nThe reward is divided by the split set by the maintainers.\n</details>",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// GIVEN
+			e := echo.New()
+			b := new(bytes.Buffer)
+			err := json.NewEncoder(b).Encode(testCase.Event)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/github/webhooks/event", b)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(github.EventTypeHeader, "issue_comment")
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			fakeInstallationClient := &providersfakes.FakeInstallationClient{}
+			fakeInstallationClient.GetPermissionReturns(testCase.Permission, nil)
+			fakeInstallationClient.EnrichIssueStub = func(ctx context.Context, owner string, repoName string, issue model.Issue) model.EnrichedIssue {
+				return model.NewEnrichIssue(issue, nil, []model.IssueEvent{{
+					Event:     "assigned",
+					CreatedAt: time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC),
+					Assignee:  &model.User{Login: "alice"},
+				}})
+			}
+			cl, _ := providers.NewInstallationClient("", nil, nil, "", "famed", nil)
+			fakeInstallationClient.ValidateWebHookEventStub = cl.ValidateWebHookEvent
+			fakeStore := &storagefakes.FakeStore{}
+
+			githubHandler := famed.NewHandler(nil, fakeInstallationClient, fakeStore, NewTestConfig(), Now)
+
+			// WHEN
+			err = githubHandler.PostEvent(ctx)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				assert.Equal(t, testCase.ExpectedErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedPermissionCalls, fakeInstallationClient.GetPermissionCallCount())
+			if testCase.ExpectedOverrides != nil {
+				assert.Equal(t, 1, fakeStore.PutOverridesCallCount())
+				_, _, issueNumber, overrides := fakeStore.PutOverridesArgsForCall(0)
+				assert.Equal(t, 1, issueNumber)
+				assert.Equal(t, *testCase.ExpectedOverrides, overrides)
+			} else {
+				assert.Equal(t, 0, fakeStore.PutOverridesCallCount())
+			}
+			assert.Equal(t, len(testCase.ExpectedComments), fakeInstallationClient.PostCommentCallCount())
+			for i, expectedComment := range testCase.ExpectedComments {
+				_, _, _, _, comment := fakeInstallationClient.PostCommentArgsForCall(i)
+				assert.Equal(t, expectedComment, comment)
+			}
+		})
+	}
+}
@@ -2,10 +2,8 @@ package famed
 
 import (
 	"context"
-	"net/http"
 	"strings"
 
-	"github.com/labstack/echo/v4"
 	"github.com/phuslu/log"
 
 	famedModel "github.com/morphysm/famed-github-backend/internal/famed/model"
@@ -15,11 +13,10 @@ import (
 
 // handleIssuesEvent handles issue events and posts a suggested payout handleClosedEvent to the GitHub API,
 // if the famed label is set and the issue is closed.
-func (gH *githubHandler) handleIssuesEvent(c echo.Context, event model.IssuesEvent) error {
+func (gH *githubHandler) handleIssuesEvent(ctx context.Context, event model.IssuesEvent) error {
 	var (
 		comment comment.Comment
 		err     error
-		ctx     = c.Request().Context()
 	)
 
 	switch event.Action {
@@ -40,15 +37,50 @@ func (gH *githubHandler) handleIssuesEvent(c echo.Context, event model.IssuesEve
 		fallthrough
 
 	case string(model.Unlabeled):
+		if event.FamedLabelRemoved {
+			gH.handleFamedLabelRemovedEvent(ctx, event)
+			return nil
+		}
+		fallthrough
+
+	case string(model.Edited):
+		fallthrough
+
+	case string(model.Milestoned):
+		fallthrough
+
+	case string(model.Demilestoned):
 		comment, err = gH.handleUpdatedEvent(ctx, event)
 		if err != nil {
 			log.Error().Err(err).Msg("[handleIssuesEvent] error while generating eligible comment for labeled event")
 			return err
 		}
 
+	case string(model.Reopened):
+		err = gH.handleReopenedEvent(ctx, event)
+		if err != nil {
+			log.Error().Err(err).Msg("[handleIssuesEvent] error while updating reward comment for reopened event")
+			return err
+		}
+
+		return nil
+
+	case string(model.Deleted):
+		gH.handleDeletedEvent(ctx, event)
+		return nil
+
+	case string(model.Transferred):
+		err = gH.handleTransferredEvent(ctx, event)
+		if err != nil {
+			log.Error().Err(err).Msg("[handleIssuesEvent] error while moving reward of transferred event")
+			return err
+		}
+
+		return nil
+
 	default:
-		log.Error().Err(famedModel.ErrEventNotHandled).Msg("[handleIssueEvent] error")
-		return famedModel.ErrEventNotHandled
+		log.Debug().Msgf("[handleIssuesEvent] ignoring unhandled issues action %s", event.Action)
+		return nil
 	}
 
 	comments, err := gH.githubInstallationClient.GetComments(ctx, event.Repo.Owner.Login, event.Repo.Name, event.Issue.Number)
@@ -64,32 +96,100 @@ func (gH *githubHandler) handleIssuesEvent(c echo.Context, event model.IssuesEve
 		return err
 	}
 
-	return c.NoContent(http.StatusOK)
+	return nil
 }
 
-// handleClosedEvent returns a reward comment if event and issue qualifies and reopens the issue if close conditions are not met.
+// handleClosedEvent returns a reward comment if event and issue qualifies
+// and an error reward comment if the issue does not meet the eligibility rules of the repository.
 func (gH *githubHandler) handleClosedEvent(ctx context.Context, event model.IssuesEvent) comment.Comment {
-	if len(event.Issue.Assignees) == 0 {
-		return comment.NewErrorRewardComment(famedModel.ErrIssueMissingAssignee)
-	}
+	templates := gH.repoCommentTemplates(ctx, event.Repo.Owner.Login, event.Repo.Name)
+	rules := gH.repoEligibilityRules(ctx, event.Repo.Owner.Login, event.Repo.Name)
 
 	issue := gH.githubInstallationClient.EnrichIssue(ctx, event.Repo.Owner.Login, event.Repo.Name, event.Issue)
-	// TODO: Commented out for dev connect
-	//if issue.PullRequest == nil {
-	//	return comment.NewErrorRewardComment(famedModel.ErrIssueMissingPullRequest)
-	//}
-
-	rewardStructure := famedModel.NewRewardStructure(gH.famedConfig.Rewards, gH.famedConfig.DaysToFix, 2)
-	boardOptions := famedModel.NewBoardOptions(gH.famedConfig.Currency, rewardStructure, gH.now())
-	contributors, err := famedModel.NewBlueTeamFromIssue(issue, boardOptions)
+	if err := rules.Check(issue.Issue, issue.PullRequest); err != nil {
+		return comment.NewErrorRewardComment(templates, err)
+	}
+	gH.storeClosedIssue(ctx, event.Repo.Owner.Login, event.Repo.Name, issue)
+
+	boardOptions := gH.repoBoardOptions(ctx, event.Repo.Owner.Login, event.Repo.Name, famedModel.Window{})
+
+	return gH.rewardComment(event.Repo.Owner.Login, event.Repo.Name, issue, boardOptions, templates)
+}
+
+// rewardComment returns the reward comment of a closed issue rendered with the given templates and records the suggested payouts in the ledger.
+func (gH *githubHandler) rewardComment(owner string, repoName string, issue model.EnrichedIssue, boardOptions famedModel.BoardOptions, templates comment.Templates) comment.Comment {
+	contributors, breakdown, err := famedModel.NewBlueTeamFromIssue(issue, boardOptions)
 	if err != nil {
-		return comment.NewErrorRewardComment(err)
+		return comment.NewErrorRewardComment(templates, err)
 	}
 	if len(contributors) == 0 {
-		return comment.NewErrorRewardComment(comment.ErrNoContributors)
+		return comment.NewErrorRewardComment(templates, comment.ErrNoContributors)
 	}
 
-	return comment.NewRewardComment(contributors, gH.famedConfig.Currency, event.Repo.Owner.Login, event.Repo.Name)
+	statuses := gH.recordPayouts(owner, repoName, issue, contributors, boardOptions.Currency)
+
+	return comment.NewRewardComment(templates, contributors, breakdown, boardOptions.Currency, gH.famedConfig.RepoBoardURL(owner, repoName), statuses)
+}
+
+// handleReopenedEvent removes a reopened issue from the stored closed issues
+// and switches its reward comment to the pending state until the issue is closed again.
+// Suggested payouts are kept, they are updated when the reward is recalculated on close.
+func (gH *githubHandler) handleReopenedEvent(ctx context.Context, event model.IssuesEvent) error {
+	gH.removeClosedIssue(ctx, event.Repo.Owner.Login, event.Repo.Name, event.Issue.Number)
+
+	comments, err := gH.githubInstallationClient.GetComments(ctx, event.Repo.Owner.Login, event.Repo.Name, event.Issue.Number)
+	if err != nil {
+		return err
+	}
+
+	// Issues without reward comment have not been rewarded, there is nothing to retract
+	if _, found := comment.Comments(comments).FindComment(gH.famedConfig.BotLogin, comment.RewardCommentType); !found {
+		return nil
+	}
+
+	_, err = gH.postOrUpdateComment(ctx, event.Repo.Owner.Login, event.Repo.Name, event.Issue.Number, comment.NewReopenedRewardComment(), comments)
+	return err
+}
+
+// handleFamedLabelRemovedEvent removes an issue that lost the famed label from the stored closed issues and refreshes the red team.
+// The payouts are kept, adding the label again restores the issue with its next change.
+func (gH *githubHandler) handleFamedLabelRemovedEvent(ctx context.Context, event model.IssuesEvent) {
+	gH.removeClosedIssue(ctx, event.Repo.Owner.Login, event.Repo.Name, event.Issue.Number)
+	if _, err := gH.refreshRedTeam(ctx, event.Repo.Owner.Login, event.Repo.Name); err != nil {
+		log.Error().Err(err).Msgf("[handleFamedLabelRemovedEvent] error while refreshing red team of %s/%s", event.Repo.Owner.Login, event.Repo.Name)
+	}
+}
+
+// handleDeletedEvent removes a deleted issue from the stored closed issues and retracts its unpaid payouts.
+func (gH *githubHandler) handleDeletedEvent(ctx context.Context, event model.IssuesEvent) {
+	gH.removeClosedIssue(ctx, event.Repo.Owner.Login, event.Repo.Name, event.Issue.Number)
+	gH.retractPayouts(event.Repo.Owner.Login, event.Repo.Name, event.Issue.Number)
+}
+
+// handleTransferredEvent moves the payouts and reward overrides of a transferred issue to its new location.
+// The issue is removed from the stored closed issues of its old repository
+// and, if closed and the new repository's owner has installed the app, added to the ones of its new repository.
+func (gH *githubHandler) handleTransferredEvent(ctx context.Context, event model.IssuesEvent) error {
+	if event.Transfer == nil {
+		return model.ErrEventMissingData
+	}
+	transfer := *event.Transfer
+
+	gH.removeClosedIssue(ctx, event.Repo.Owner.Login, event.Repo.Name, event.Issue.Number)
+	gH.movePayouts(event.Repo.Owner.Login, event.Repo.Name, event.Issue.Number, transfer)
+	gH.moveOverrides(event.Repo.Owner.Login, event.Repo.Name, event.Issue.Number, transfer)
+
+	if event.Issue.ClosedAt == nil || !gH.githubInstallationClient.CheckInstallation(transfer.Repo.Owner.Login) {
+		return nil
+	}
+
+	issue := event.Issue
+	issue.Number = transfer.Number
+	issue.HTMLURL = transfer.HTMLURL
+	enrichedIssue := gH.githubInstallationClient.EnrichIssue(ctx, transfer.Repo.Owner.Login, transfer.Repo.Name, issue)
+	gH.storeClosedIssue(ctx, transfer.Repo.Owner.Login, transfer.Repo.Name, enrichedIssue)
+
+	return nil
 }
 
 // handleUpdatedEvent returns an eligible comment if event and issue qualifies
@@ -99,7 +199,10 @@ func (gH *githubHandler) handleUpdatedEvent(ctx context.Context, event model.Iss
 		return nil, err
 	}
 
-	return comment.NewEligibleComment(event.Issue, pullRequest), nil
+	templates := gH.repoCommentTemplates(ctx, event.Repo.Owner.Login, event.Repo.Name)
+	rules := gH.repoEligibilityRules(ctx, event.Repo.Owner.Login, event.Repo.Name)
+
+	return comment.NewEligibleComment(templates, rules, event.Issue, pullRequest), nil
 }
 
 // postOrUpdateComment checks if a handleClosedEvent of a type is present,
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -18,6 +19,8 @@ import (
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers/providersfakes"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage/storagefakes"
 	"github.com/morphysm/famed-github-backend/pkg/pointer"
 )
 
@@ -29,7 +32,8 @@ func TestPostIssuesEvent(t *testing.T) {
 		Name            string
 		Event           *github.IssuesEvent
 		Events          []model.IssueEvent
-		PullRequest     *string
+		PullRequest     *model.PullRequest
+		RepoConfig      model.RepoConfig
 		ExpectedComment string
 		ExpectedErr     *echo.HTTPError
 	}{
@@ -60,8 +64,8 @@ func TestPostIssuesEvent(t *testing.T) {
 					Owner: &github.User{Login: pointer.String("test")},
 				},
 			},
-			PullRequest:     pointer.String("test"),
-			ExpectedComment: "<!--{\"type\":\"reward\",\"version\":\"TODO\"}-->\n### Famed could not generate a reward suggestion.\nReason: The issue is missing an assignee.",
+			PullRequest:     &model.PullRequest{URL: "test"},
+			ExpectedComment: "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n### Famed could not generate a reward suggestion.\nReason: The issue is missing an assignee.",
 		},
 		{
 			Name: "Close - No Label",
@@ -84,8 +88,31 @@ func TestPostIssuesEvent(t *testing.T) {
 					Owner: &github.User{Login: pointer.String("test")},
 				},
 			},
-			PullRequest:     pointer.String("test"),
-			ExpectedComment: "<!--{\"type\":\"reward\",\"version\":\"TODO\"}-->\n### Famed could not generate a reward suggestion.\nReason: The issue is missing a severity label.",
+			PullRequest:     &model.PullRequest{URL: "test"},
+			ExpectedComment: "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n### Famed could not generate a reward suggestion.\nReason: The issue is missing a severity label.",
+		},
+		{
+			Name: "Close - Pull Request Not Merged",
+			Event: &github.IssuesEvent{
+				Action: pointer.String("closed"),
+				Issue: &github.Issue{
+					ID:        pointer.Int64(0),
+					Title:     pointer.String("test"),
+					HTMLURL:   pointer.String("TestURL"),
+					Labels:    []*github.Label{{Name: pointer.String("famed")}, {Name: pointer.String("high")}},
+					Number:    pointer.Int(0),
+					Assignees: []*github.User{{Login: pointer.String("test")}},
+					CreatedAt: pointer.Time(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+					ClosedAt:  pointer.Time(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+				Repo: &github.Repository{
+					Name:  pointer.String("test"),
+					Owner: &github.User{Login: pointer.String("test")},
+				},
+			},
+			PullRequest:     &model.PullRequest{URL: "test"},
+			RepoConfig:      model.RepoConfig{EligibilityRules: []string{"assignee", "severity", "pullRequestMerged"}},
+			ExpectedComment: "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n### Famed could not generate a reward suggestion.\nReason: The pull request of the issue is not merged.",
 		},
 		{
 			Name: "Close - Multiple Labels",
@@ -107,8 +134,8 @@ func TestPostIssuesEvent(t *testing.T) {
 					Owner: &github.User{Login: pointer.String("test")},
 				},
 			},
-			PullRequest:     pointer.String("test"),
-			ExpectedComment: "<!--{\"type\":\"reward\",\"version\":\"TODO\"}-->\n### Famed could not generate a reward suggestion.\nReason: The issue has more than one severity label.",
+			PullRequest:     &model.PullRequest{URL: "test"},
+			ExpectedComment: "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n### Famed could not generate a reward suggestion.\nReason: The issue has more than one severity label.",
 		},
 		{
 			Name: "Close - No events",
@@ -130,8 +157,8 @@ func TestPostIssuesEvent(t *testing.T) {
 					Owner: &github.User{Login: pointer.String("test")},
 				},
 			},
-			PullRequest:     pointer.String("test"),
-			ExpectedComment: "<!--{\"type\":\"reward\",\"version\":\"TODO\"}-->\n### Famed could not generate a reward suggestion.\nReason: The data provided by GitHub is not sufficient to generate a reward suggestion.\nThis might be due to an assignment after the issue has been closed. Please assign assignees in the open state.",
+			PullRequest:     &model.PullRequest{URL: "test"},
+			ExpectedComment: "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n### Famed could not generate a reward suggestion.\nReason: The data provided by GitHub is not sufficient to generate a reward suggestion.\nThis might be due to an assignment after the issue has been closed. Please assign assignees in the open state.",
 		},
 		// Commented out for DevConnect
 		//{
@@ -175,7 +202,7 @@ func TestPostIssuesEvent(t *testing.T) {
 					Owner: &github.User{Login: pointer.String("test")},
 				},
 			},
-			PullRequest: pointer.String("test"),
+			PullRequest: &model.PullRequest{URL: "test"},
 			Events: []model.IssueEvent{
 				{
 					Event:     "assigned",
@@ -183,7 +210,7 @@ func TestPostIssuesEvent(t *testing.T) {
 					Assignee:  &model.User{Login: "test"},
 				},
 			},
-			ExpectedComment: "<!--{\"type\":\"reward\",\"version\":\"TODO\"}-->\n@test - you Got Famed! 💎 Check out your new score here: https://www.famed.morphysm.com/teams/test/test\n| Contributor | Time | Reward |\n| ----------- | ----------- | ----------- |\n|test|744h0m0s|674 POINTS|",
+			ExpectedComment: "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n@test - you Got Famed! 💎 Check out your new score here: https://www.famed.morphysm.com/teams/test/test\n| Contributor | Time | Share | Reward | Status |\n| ----------- | ----------- | ----------- | ----------- | ----------- |\n|test|31 days|100%|674 POINTS|suggested|\n\n- Severity: high (3,000 POINTS)\n- Time open: 31 days of 40 days to fix\n- Reopened (k): 0\n- Decay factor: 0.225\n\n<details>\n<summary>How the reward is calculated</summary>\n\n`reward = severity reward × max(0, (1 − days open / days to fix)^(k multiplier × k + 1))`\n\n`675 = 3,000 × max(0, (1 − 31 / 40)^(2 × 0 + 1))`\n\nThe reward is divided by the share of time each contributor was assigned to the issue.\n</details>",
 		},
 		{
 			Name: "Close - Valid - Migrated",
@@ -206,7 +233,7 @@ func TestPostIssuesEvent(t *testing.T) {
 					Owner: &github.User{Login: pointer.String("test")},
 				},
 			},
-			PullRequest: pointer.String("test"),
+			PullRequest: &model.PullRequest{URL: "test"},
 			Events: []model.IssueEvent{
 				{
 					Event:     "assigned",
@@ -214,7 +241,7 @@ func TestPostIssuesEvent(t *testing.T) {
 					Assignee:  &model.User{Login: "test"},
 				},
 			},
-			ExpectedComment: "<!--{\"type\":\"reward\",\"version\":\"TODO\"}-->\n@test - you Got Famed! 💎 Check out your new score here: https://www.famed.morphysm.com/teams/test/test\n| Contributor | Time | Reward |\n| ----------- | ----------- | ----------- |\n|test|0s|3000 POINTS|",
+			ExpectedComment: "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n@test - you Got Famed! 💎 Check out your new score here: https://www.famed.morphysm.com/teams/test/test\n| Contributor | Time | Share | Reward | Status |\n| ----------- | ----------- | ----------- | ----------- | ----------- |\n|test|0 minutes|100%|3,000 POINTS|suggested|\n\n- Severity: high (3,000 POINTS)\n- Time open: 0 minutes of 40 days to fix\n- Reopened (k): 0\n- Decay factor: 1\n\n<details>\n<summary>How the reward is calculated</summary>\n\n`reward = severity reward × max(0, (1 − days open / days to fix)^(k multiplier × k + 1))`\n\n`3,000 = 3,000 × max(0, (1 − 0 / 40)^(2 × 0 + 1))`\n\nThe reward is divided by the share of time each contributor was assigned to the issue.\n</details>",
 		},
 		{
 			Name: "Close - Valid - Multiple Assignees",
@@ -235,7 +262,7 @@ func TestPostIssuesEvent(t *testing.T) {
 					Owner: &github.User{Login: pointer.String("testOwner")},
 				},
 			},
-			PullRequest: pointer.String("test"),
+			PullRequest: &model.PullRequest{URL: "test"},
 			Events: []model.IssueEvent{
 				{
 					Event:     "assigned",
@@ -248,7 +275,7 @@ func TestPostIssuesEvent(t *testing.T) {
 					Assignee:  &model.User{Login: "test2"},
 				},
 			},
-			ExpectedComment: "<!--{\"type\":\"reward\",\"version\":\"TODO\"}-->\n@test1 @test2 - you Got Famed! 💎 Check out your new score here: https://www.famed.morphysm.com/teams/testOwner/test\n| Contributor | Time | Reward |\n| ----------- | ----------- | ----------- |\n|test1|744h0m0s|337 POINTS|\n|test2|744h0m0s|337 POINTS|",
+			ExpectedComment: "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n@test1 @test2 - you Got Famed! 💎 Check out your new score here: https://www.famed.morphysm.com/teams/testOwner/test\n| Contributor | Time | Share | Reward | Status |\n| ----------- | ----------- | ----------- | ----------- | ----------- |\n|test1|31 days|50%|337 POINTS|suggested|\n|test2|31 days|50%|337 POINTS|suggested|\n\n- Severity: high (3,000 POINTS)\n- Time open: 31 days of 40 days to fix\n- Reopened (k): 0\n- Decay factor: 0.225\n\n<details>\n<summary>How the reward is calculated</summary>\n\n`reward = severity reward × max(0, (1 − days open / days to fix)^(k multiplier × k + 1))`\n\n`675 = 3,000 × max(0, (1 − 31 / 40)^(2 × 0 + 1))`\n\nThe reward is divided by the share of time each contributor was assigned to the issue.\n</details>",
 		},
 		// Eligible comment
 		{
@@ -280,7 +307,7 @@ func TestPostIssuesEvent(t *testing.T) {
 					Owner: &github.User{Login: pointer.String("test")},
 				},
 			},
-			ExpectedComment: "<!--{\"type\":\"eligible\",\"version\":\"TODO\"}-->" +
+			ExpectedComment: "<!--{\"type\":\"eligible\",\"version\":\"0.0.0\"}-->" +
 				"\n🤖 Assignees for issue **Test #0** are now eligible to Get Famed." +
 				"\n\n❌ Add assignees to track contribution times of the issue \U0001F9B8\u200d♀️\U0001F9B9️" +
 				"\n❌ Add a single severity (CVSS) label to compute the score 🏷️️" +
@@ -307,7 +334,7 @@ func TestPostIssuesEvent(t *testing.T) {
 					Owner: &github.User{Login: pointer.String("test")},
 				},
 			},
-			ExpectedComment: "<!--{\"type\":\"eligible\",\"version\":\"TODO\"}-->" +
+			ExpectedComment: "<!--{\"type\":\"eligible\",\"version\":\"0.0.0\"}-->" +
 				"\n🤖 Assignees for issue **Test #0** are now eligible to Get Famed." +
 				"\n\n✅ Add assignees to track contribution times of the issue \U0001F9B8\u200d♀️\U0001F9B9️" +
 				"\n❌ Add a single severity (CVSS) label to compute the score 🏷️️" +
@@ -334,7 +361,7 @@ func TestPostIssuesEvent(t *testing.T) {
 					Owner: &github.User{Login: pointer.String("test")},
 				},
 			},
-			ExpectedComment: "<!--{\"type\":\"eligible\",\"version\":\"TODO\"}-->" +
+			ExpectedComment: "<!--{\"type\":\"eligible\",\"version\":\"0.0.0\"}-->" +
 				"\n🤖 Assignees for issue **Test #0** are now eligible to Get Famed." +
 				"\n\n✅ Add assignees to track contribution times of the issue \U0001F9B8\u200d♀️\U0001F9B9️" +
 				"\n✅ Add a single severity (CVSS) label to compute the score 🏷️️" +
@@ -361,8 +388,8 @@ func TestPostIssuesEvent(t *testing.T) {
 					Owner: &github.User{Login: pointer.String("test")},
 				},
 			},
-			PullRequest: pointer.String("test"),
-			ExpectedComment: "<!--{\"type\":\"eligible\",\"version\":\"TODO\"}-->" +
+			PullRequest: &model.PullRequest{URL: "test"},
+			ExpectedComment: "<!--{\"type\":\"eligible\",\"version\":\"0.0.0\"}-->" +
 				"\n🤖 Assignees for issue **Test #0** are now eligible to Get Famed." +
 				"\n\n✅ Add assignees to track contribution times of the issue \U0001F9B8\u200d♀️\U0001F9B9️" +
 				"\n❌ Add a single severity (CVSS) label to compute the score 🏷️️" +
@@ -389,14 +416,40 @@ func TestPostIssuesEvent(t *testing.T) {
 					Owner: &github.User{Login: pointer.String("test")},
 				},
 			},
-			PullRequest: pointer.String("test"),
-			ExpectedComment: "<!--{\"type\":\"eligible\",\"version\":\"TODO\"}-->" +
+			PullRequest: &model.PullRequest{URL: "test"},
+			ExpectedComment: "<!--{\"type\":\"eligible\",\"version\":\"0.0.0\"}-->" +
 				"\n🤖 Assignees for issue **Test #0** are now eligible to Get Famed." +
 				"\n\n✅ Add assignees to track contribution times of the issue \U0001F9B8\u200d♀️\U0001F9B9️" +
 				"\n✅ Add a single severity (CVSS) label to compute the score 🏷️️" +
 				//"\n✅ Link a PR when closing the issue ♻️ \U0001F9B8\u200d♀️\U0001F9B9" +
 				"\n\nHappy hacking! \U0001F9BE💙❤️️",
 		},
+		{
+			Name: "Edited - Valid - Title changed",
+			Event: &github.IssuesEvent{
+				Action: pointer.String("edited"),
+				Issue: &github.Issue{
+					ID:        pointer.Int64(0),
+					Number:    pointer.Int(0),
+					Title:     pointer.String("Renamed"),
+					HTMLURL:   pointer.String("TestURL"),
+					CreatedAt: pointer.Time(time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)),
+					Labels:    []*github.Label{{Name: pointer.String("famed")}},
+					Assignees: []*github.User{{Login: pointer.String("test")}},
+				},
+				Changes: &github.EditChange{Title: &github.EditTitle{From: pointer.String("Test")}},
+				Repo: &github.Repository{
+					Name:  pointer.String("test"),
+					Owner: &github.User{Login: pointer.String("test")},
+				},
+			},
+			ExpectedComment: "<!--{\"type\":\"eligible\",\"version\":\"0.0.0\"}-->" +
+				"\n🤖 Assignees for issue **Renamed #0** are now eligible to Get Famed." +
+				"\n\n✅ Add assignees to track contribution times of the issue \U0001F9B8\u200d♀️\U0001F9B9️" +
+				"\n❌ Add a single severity (CVSS) label to compute the score 🏷️️" +
+				"\n" +
+				"\nHappy hacking! \U0001F9BE💙❤️️",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -421,8 +474,9 @@ func TestPostIssuesEvent(t *testing.T) {
 			}
 			cl, _ := providers.NewInstallationClient("", nil, nil, "", "famed", nil)
 			fakeInstallationClient.ValidateWebHookEventStub = cl.ValidateWebHookEvent
+			fakeInstallationClient.GetRepoConfigReturns(testCase.RepoConfig, nil)
 
-			githubHandler := famed.NewHandler(nil, fakeInstallationClient, famedConfig, Now)
+			githubHandler := famed.NewHandler(nil, fakeInstallationClient, &storagefakes.FakeStore{}, famedConfig, Now)
 
 			// WHEN
 			err = githubHandler.PostEvent(ctx)
@@ -444,3 +498,228 @@ func TestPostIssuesEvent(t *testing.T) {
 		})
 	}
 }
+
+//nolint:funlen
+func TestPostIssuesEventLifecycle(t *testing.T) {
+	t.Parallel()
+
+	newEvent := func(action string) map[string]interface{} {
+		event := &github.IssuesEvent{
+			Action: pointer.String(action),
+			Issue: &github.Issue{
+				ID:        pointer.Int64(0),
+				Title:     pointer.String("test"),
+				HTMLURL:   pointer.String("TestURL"),
+				Labels:    []*github.Label{{Name: pointer.String("famed")}, {Name: pointer.String("high")}},
+				Number:    pointer.Int(1),
+				Assignees: []*github.User{{Login: pointer.String("test")}},
+				CreatedAt: pointer.Time(time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)),
+				ClosedAt:  pointer.Time(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			Repo: &github.Repository{
+				Name:  pointer.String("test"),
+				Owner: &github.User{Login: pointer.String("test")},
+			},
+		}
+
+		// The new location of transferred issues is not mapped by the GitHub client library
+		b, _ := json.Marshal(event)
+		var payload map[string]interface{}
+		_ = json.Unmarshal(b, &payload)
+		return payload
+	}
+
+	famedConfig := NewTestConfig()
+	entry := model2.NewLedgerEntry("test", "test", 1, "TestURL", "test", 674, "POINTS", Now())
+	rewardComment := model.IssueComment{
+		ID:   2,
+		User: model.User{Login: famedConfig.BotLogin},
+		Body: "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n@test - you Got Famed! 💎 Check out your new score here: https://www.famed.morphysm.com/teams/test/test\n| Contributor | Time | Reward | Status |\n| ----------- | ----------- | ----------- | ----------- |\n|test|744h0m0s|674 POINTS|suggested|",
+	}
+
+	t.Run("Reopened", func(t *testing.T) {
+		t.Parallel()
+		// GIVEN
+		fakeInstallationClient, fakeStore, ctx := newIssuesEventTest(t, newEvent("reopened"))
+		fakeInstallationClient.GetCommentsReturns([]model.IssueComment{rewardComment}, nil)
+		githubHandler := famed.NewHandler(nil, fakeInstallationClient, fakeStore, famedConfig, Now)
+
+		// WHEN
+		err := githubHandler.PostEvent(ctx)
+
+		// THEN
+		assert.NoError(t, err)
+		assert.Equal(t, 1, fakeStore.DeleteIssueCallCount())
+		assert.Equal(t, 0, fakeStore.PutLedgerEntryCallCount())
+		assert.Equal(t, 0, fakeInstallationClient.PostCommentCallCount())
+		assert.Equal(t, 1, fakeInstallationClient.UpdateCommentCallCount())
+		_, _, _, commentID, comment := fakeInstallationClient.UpdateCommentArgsForCall(0)
+		assert.Equal(t, int64(2), commentID)
+		assert.Equal(t, "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n### Issue reopened — reward pending\nThe issue has been reopened. The reward will be recalculated once the issue is closed again.", comment)
+	})
+
+	t.Run("Reopened - Not rewarded", func(t *testing.T) {
+		t.Parallel()
+		// GIVEN
+		fakeInstallationClient, fakeStore, ctx := newIssuesEventTest(t, newEvent("reopened"))
+		githubHandler := famed.NewHandler(nil, fakeInstallationClient, fakeStore, famedConfig, Now)
+
+		// WHEN
+		err := githubHandler.PostEvent(ctx)
+
+		// THEN
+		assert.NoError(t, err)
+		assert.Equal(t, 0, fakeInstallationClient.PostCommentCallCount())
+		assert.Equal(t, 0, fakeInstallationClient.UpdateCommentCallCount())
+	})
+
+	t.Run("Deleted", func(t *testing.T) {
+		t.Parallel()
+		// GIVEN
+		fakeInstallationClient, fakeStore, ctx := newIssuesEventTest(t, newEvent("deleted"))
+		paidEntry := model2.NewLedgerEntry("test", "test", 1, "TestURL", "paid", 100, "POINTS", Now())
+		paidEntry.Status = model2.Paid
+		fakeStore.GetIssueLedgerEntriesReturns(map[string]model2.LedgerEntry{"test": entry, "paid": paidEntry}, nil)
+		githubHandler := famed.NewHandler(nil, fakeInstallationClient, fakeStore, famedConfig, Now)
+
+		// WHEN
+		err := githubHandler.PostEvent(ctx)
+
+		// THEN
+		assert.NoError(t, err)
+		assert.Equal(t, 1, fakeStore.DeleteIssueCallCount())
+		assert.Equal(t, 1, fakeStore.PutLedgerEntryCallCount())
+		assert.Equal(t, model2.Void, fakeStore.PutLedgerEntryArgsForCall(0).Status)
+		assert.Equal(t, "test", fakeStore.PutLedgerEntryArgsForCall(0).Login)
+		assert.Equal(t, 0, fakeInstallationClient.PostCommentCallCount())
+	})
+
+	t.Run("Famed label removed", func(t *testing.T) {
+		t.Parallel()
+		// GIVEN
+		event := newEvent("unlabeled")
+		event["label"] = map[string]interface{}{"name": "famed"}
+		event["issue"].(map[string]interface{})["labels"] = []interface{}{map[string]interface{}{"name": "high"}}
+		fakeInstallationClient, _, ctx := newIssuesEventTest(t, event)
+		cl, _ := providers.NewInstallationClient("", nil, nil, "", "famed", nil)
+		fakeInstallationClient.ParseWebHookEventStub = cl.ParseWebHookEvent
+
+		store, err := storage.NewBoltStore(filepath.Join(t.TempDir(), "famed.db"))
+		assert.NoError(t, err)
+		t.Cleanup(func() { _ = store.Close() })
+		closed := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		issue := model.EnrichedIssue{
+			Issue: model.Issue{
+				Number:     1,
+				HTMLURL:    "TestURL",
+				Title:      "test",
+				CreatedAt:  time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC),
+				ClosedAt:   &closed,
+				Assignees:  []model.User{{Login: "test"}},
+				Severities: []model.IssueSeverity{model.High},
+			},
+			Events: []model.IssueEvent{{Event: "assigned", CreatedAt: time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC), Assignee: &model.User{Login: "test"}}},
+		}
+		assert.NoError(t, store.PutIssue("test", "test", issue))
+		assert.NoError(t, store.PutBoard("test", "test", storage.BlueTeam, storage.Board{Contributors: []*model2.Contributor{{Login: "test"}}}))
+		githubHandler := famed.NewHandler(nil, fakeInstallationClient, store, famedConfig, Now)
+
+		// WHEN
+		err = githubHandler.PostEvent(ctx)
+
+		// THEN
+		assert.NoError(t, err)
+		issues, err := store.GetIssues("test", "test")
+		assert.NoError(t, err)
+		assert.Empty(t, issues)
+		board, found, err := store.GetBoard("test", "test", storage.BlueTeam)
+		assert.NoError(t, err)
+		assert.True(t, found)
+		assert.Empty(t, board.Contributors)
+		// The red team is refreshed from the issues still carrying the famed label
+		assert.Equal(t, 1, fakeInstallationClient.GetIssuesByRepoCallCount())
+		assert.Equal(t, 0, fakeInstallationClient.PostCommentCallCount())
+	})
+
+	t.Run("Transferred", func(t *testing.T) {
+		t.Parallel()
+		// GIVEN
+		event := newEvent("transferred")
+		event["changes"] = map[string]interface{}{
+			"new_issue":      map[string]interface{}{"number": 7, "html_url": "NewURL"},
+			"new_repository": map[string]interface{}{"name": "new", "owner": map[string]interface{}{"login": "newOwner"}},
+		}
+		fakeInstallationClient, fakeStore, ctx := newIssuesEventTest(t, event)
+		fakeInstallationClient.CheckInstallationReturns(true)
+		fakeStore.GetIssueLedgerEntriesReturns(map[string]model2.LedgerEntry{"test": entry}, nil)
+		fakeStore.GetOverridesReturns(map[int]model2.RewardOverrides{1: {Exclude: []string{"bot"}}}, nil)
+		githubHandler := famed.NewHandler(nil, fakeInstallationClient, fakeStore, famedConfig, Now)
+
+		// WHEN
+		err := githubHandler.PostEvent(ctx)
+
+		// THEN
+		assert.NoError(t, err)
+		assert.Equal(t, 1, fakeStore.DeleteIssueCallCount())
+
+		assert.Equal(t, 1, fakeStore.PutLedgerEntryCallCount())
+		movedEntry := fakeStore.PutLedgerEntryArgsForCall(0)
+		assert.Equal(t, "newOwner", movedEntry.Owner)
+		assert.Equal(t, "new", movedEntry.RepoName)
+		assert.Equal(t, 7, movedEntry.IssueNumber)
+		assert.Equal(t, "NewURL", movedEntry.IssueURL)
+		assert.Equal(t, model2.Suggested, movedEntry.Status)
+		assert.Equal(t, 1, fakeStore.DeleteLedgerEntryCallCount())
+
+		assert.Equal(t, 1, fakeStore.PutOverridesCallCount())
+		owner, repoName, issueNumber, overrides := fakeStore.PutOverridesArgsForCall(0)
+		assert.Equal(t, "newOwner", owner)
+		assert.Equal(t, "new", repoName)
+		assert.Equal(t, 7, issueNumber)
+		assert.Equal(t, []string{"bot"}, overrides.Exclude)
+		assert.Equal(t, 1, fakeStore.DeleteOverridesCallCount())
+
+		assert.Equal(t, 1, fakeStore.PutIssueCallCount())
+		owner, repoName, issue := fakeStore.PutIssueArgsForCall(0)
+		assert.Equal(t, "newOwner", owner)
+		assert.Equal(t, "new", repoName)
+		assert.Equal(t, 7, issue.Number)
+	})
+
+	t.Run("Transferred - Missing new location", func(t *testing.T) {
+		t.Parallel()
+		// GIVEN
+		fakeInstallationClient, fakeStore, ctx := newIssuesEventTest(t, newEvent("transferred"))
+		githubHandler := famed.NewHandler(nil, fakeInstallationClient, fakeStore, famedConfig, Now)
+
+		// WHEN
+		err := githubHandler.PostEvent(ctx)
+
+		// THEN
+		echoErr, ok := err.(*echo.HTTPError)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, echoErr.Code)
+		assert.Equal(t, 0, fakeStore.DeleteIssueCallCount())
+	})
+}
+
+func newIssuesEventTest(t *testing.T, event map[string]interface{}) (*providersfakes.FakeInstallationClient, *storagefakes.FakeStore, echo.Context) {
+	t.Helper()
+
+	b := new(bytes.Buffer)
+	assert.NoError(t, json.NewEncoder(b).Encode(event))
+
+	req := httptest.NewRequest(http.MethodPost, "/github/webhooks/event", b)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(github.EventTypeHeader, "issues")
+	ctx := echo.New().NewContext(req, httptest.NewRecorder())
+
+	fakeInstallationClient := &providersfakes.FakeInstallationClient{}
+	fakeInstallationClient.EnrichIssueStub = func(ctx context.Context, owner string, repoName string, issue model.Issue) model.EnrichedIssue {
+		return model.NewEnrichIssue(issue, nil, nil)
+	}
+	cl, _ := providers.NewInstallationClient("", nil, nil, "", "famed", nil)
+	fakeInstallationClient.ValidateWebHookEventStub = cl.ValidateWebHookEvent
+
+	return fakeInstallationClient, &storagefakes.FakeStore{}, ctx
+}
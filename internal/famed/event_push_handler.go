@@ -0,0 +1,23 @@
+package famed
+
+import (
+	"context"
+
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+// handlePushEvent invalidates the cached config of a repository if its default branch was pushed to.
+// If the push changed the config, the boards of the repository are recomputed with the new config.
+func (gH *githubHandler) handlePushEvent(ctx context.Context, event model.PushEvent) error {
+	if !event.DefaultBranch {
+		return nil
+	}
+
+	gH.githubInstallationClient.InvalidateRepoConfig(event.Repo.Owner.Login, event.Repo.Name)
+
+	if event.RepoConfigChanged {
+		gH.refreshBoards(ctx, event.Repo.Owner.Login, event.Repo.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package famed_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-github/v41/github"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/internal/famed"
+	"github.com/morphysm/famed-github-backend/internal/famed/model"
+	githubModel "github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers/providersfakes"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage/storagefakes"
+	"github.com/morphysm/famed-github-backend/pkg/pointer"
+)
+
+func TestPostPushEvent(t *testing.T) {
+	t.Parallel()
+
+	repo := &github.PushEventRepository{
+		Name:          pointer.String("TestRepo"),
+		Owner:         &github.User{Login: pointer.String("TestUser")},
+		DefaultBranch: pointer.String("main"),
+	}
+
+	testCases := []struct {
+		Name               string
+		Event              *github.PushEvent
+		ExpectedInvalidate int
+		ExpectedRefresh    int
+		ExpectedErr        *echo.HTTPError
+	}{
+		{
+			Name:        "Empty github push event",
+			Event:       &github.PushEvent{},
+			ExpectedErr: &echo.HTTPError{Code: 400, Message: model.ErrEventMissingData.Error()},
+		},
+		{
+			Name: "Push to other branch",
+			Event: &github.PushEvent{
+				Ref:     pointer.String("refs/heads/feature"),
+				Repo:    repo,
+				Commits: []*github.HeadCommit{{Modified: []string{githubModel.RepoConfigPath}}},
+			},
+		},
+		{
+			Name: "Push to default branch",
+			Event: &github.PushEvent{
+				Ref:     pointer.String("refs/heads/main"),
+				Repo:    repo,
+				Commits: []*github.HeadCommit{{Modified: []string{"README.md"}}},
+			},
+			ExpectedInvalidate: 1,
+		},
+		{
+			Name: "Push to default branch changing config",
+			Event: &github.PushEvent{
+				Ref:     pointer.String("refs/heads/main"),
+				Repo:    repo,
+				Commits: []*github.HeadCommit{{Modified: []string{githubModel.RepoConfigPath}}},
+			},
+			ExpectedInvalidate: 1,
+			ExpectedRefresh:    1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// GIVEN
+			e := echo.New()
+			b := new(bytes.Buffer)
+			err := json.NewEncoder(b).Encode(testCase.Event)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/github/webhooks/event", b)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(github.EventTypeHeader, "push")
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			fakeInstallationClient := &providersfakes.FakeInstallationClient{}
+			cl, _ := providers.NewInstallationClient("", nil, nil, "", "famed", nil)
+			fakeInstallationClient.ValidateWebHookEventStub = cl.ValidateWebHookEvent
+
+			githubHandler := famed.NewHandler(nil, fakeInstallationClient, &storagefakes.FakeStore{}, NewTestConfig(), Now)
+
+			// WHEN
+			err = githubHandler.PostEvent(ctx)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				assert.Equal(t, testCase.ExpectedErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, testCase.ExpectedInvalidate, fakeInstallationClient.InvalidateRepoConfigCallCount())
+			assert.Equal(t, testCase.ExpectedRefresh, fakeInstallationClient.GetEnrichedIssuesCallCount())
+			if fakeInstallationClient.InvalidateRepoConfigCallCount() == 1 {
+				owner, repoName := fakeInstallationClient.InvalidateRepoConfigArgsForCall(0)
+				assert.Equal(t, "TestUser", owner)
+				assert.Equal(t, "TestRepo", repoName)
+			}
+		})
+	}
+}
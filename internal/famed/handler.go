@@ -1,13 +1,16 @@
 package famed
 
 import (
+	"context"
 	"time"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/morphysm/famed-github-backend/internal/famed/model"
+	"github.com/morphysm/famed-github-backend/internal/famed/model/comment"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers"
-	"github.com/morphysm/famed-github-backend/pkg/sync"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage"
+	libSync "github.com/morphysm/famed-github-backend/pkg/sync"
 )
 
 type HTTPHandler interface {
@@ -16,34 +19,56 @@ type HTTPHandler interface {
 
 	GetBlueTeam(c echo.Context) error
 	GetRedTeam(c echo.Context) error
+	GetOwnerBlueTeam(c echo.Context) error
+	GetOwnerRedTeam(c echo.Context) error
 
 	PostEvent(c echo.Context) error
+	GetEventQueue(c echo.Context) error
+	GetWebhooks(c echo.Context) error
+	GetWebhook(c echo.Context) error
+	PostReplayWebhook(c echo.Context) error
 
 	GetUpdateComments(c echo.Context) error
+	PostReconcileLabels(c echo.Context) error
+
+	GetLedger(c echo.Context) error
+	PostApproveLedgerEntry(c echo.Context) error
+	PostPayLedgerEntry(c echo.Context) error
+	PostVoidLedgerEntry(c echo.Context) error
 
 	CleanState()
+	GetCleanStateRuns(c echo.Context) error
+	StartEventQueue(ctx context.Context, maxAttempts int, backoff time.Duration)
+	WaitEventQueue()
 }
 
 // githubHandler represents the handler for the GitHub endpoints.
 type githubHandler struct {
 	githubAppClient          providers.AppClient
 	githubInstallationClient providers.InstallationClient
+	store                    storage.Store
 	famedConfig              model.Config
 	// now returns the current time
 	// the time.Now function is not directly called to allow for testing
 	now func() time.Time
+	// templates caches the parsed comment templates of the repositories
+	templates *comment.TemplatesCache
+	// budget bounds the issues and repositories processed concurrently across all executors of the handler
+	budget *libSync.Budget
 
-	// TODO: investigate if this should be replace with a queue with a queue worker to avoid multiple blocked goroutines.
-	issuesEventWG *sync.WaitGroups
+	// queue processes the webhook events, it is nil until the queue is started
+	queue *eventQueue
 }
 
 // NewHandler returns a pointer to the GitHub handler.
-func NewHandler(githubAppClient providers.AppClient, githubInstallationClient providers.InstallationClient, famedConfig model.Config, now func() time.Time) HTTPHandler {
+func NewHandler(githubAppClient providers.AppClient, githubInstallationClient providers.InstallationClient, store storage.Store, famedConfig model.Config, now func() time.Time) HTTPHandler {
 	return &githubHandler{
 		githubAppClient:          githubAppClient,
 		githubInstallationClient: githubInstallationClient,
+		store:                    store,
 		famedConfig:              famedConfig,
 		now:                      now,
-		issuesEventWG:            sync.NewWaitGroups(),
+		templates:                comment.NewTemplatesCache(),
+		budget:                   libSync.NewBudget(famedConfig.Concurrency),
 	}
 }
@@ -0,0 +1,66 @@
+package famed
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/phuslu/log"
+
+	"github.com/morphysm/famed-github-backend/internal/famed/model"
+	githubModel "github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+// reconcileLabels creates the configured labels missing in a repository and updates the ones whose color or description drifted.
+// The repository's config may override the configured labels.
+func (gH *githubHandler) reconcileLabels(ctx context.Context, owner string, repoName string) model.LabelReport {
+	report := model.NewLabelReport(owner, repoName)
+
+	existing, err := gH.githubInstallationClient.GetLabels(ctx, owner, repoName)
+	if err != nil {
+		report.Errors = append(report.Errors, err.Error())
+		return report
+	}
+
+	diff := githubModel.DiffLabels(gH.repoConfig(ctx, owner, repoName).Labels, existing)
+
+	for _, label := range diff.Missing {
+		if err := gH.githubInstallationClient.PostLabel(ctx, owner, repoName, label); err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("%s: %s", label.Name, err))
+			continue
+		}
+		report.Created = append(report.Created, label.Name)
+	}
+
+	for _, label := range diff.Drifted {
+		if err := gH.githubInstallationClient.UpdateLabel(ctx, owner, repoName, label); err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("%s: %s", label.Name, err))
+			continue
+		}
+		report.Updated = append(report.Updated, label.Name)
+	}
+
+	for _, label := range diff.Unchanged {
+		report.Unchanged = append(report.Unchanged, label.Name)
+	}
+
+	for _, reportErr := range report.Errors {
+		log.Error().Msgf("[reconcileLabels] error while reconciling labels of %s/%s: %s", owner, repoName, reportErr)
+	}
+
+	return report
+}
+
+// reconcileOwnerLabels reconciles the labels of all repositories of an owner.
+func (gH *githubHandler) reconcileOwnerLabels(ctx context.Context, owner string) ([]model.LabelReport, error) {
+	repos, err := gH.githubInstallationClient.GetRepos(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]model.LabelReport, len(repos))
+	for i, repoName := range repos {
+		reports[i] = gH.reconcileLabels(ctx, owner, repoName)
+	}
+
+	return reports, nil
+}
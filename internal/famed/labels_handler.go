@@ -0,0 +1,60 @@
+package famed
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/phuslu/log"
+
+	"github.com/morphysm/famed-github-backend/internal/famed/model"
+)
+
+// PostReconcileLabels reconciles the labels of all repositories of the installations with the configured labels
+// and returns a report per repository.
+// The optional owner query parameter limits the reconciliation to the repositories of an owner.
+// Without owner, an installation whose repositories cannot be retrieved is reported as failed and the others are reconciled.
+func (gH *githubHandler) PostReconcileLabels(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	if owner := c.QueryParam("owner"); owner != "" {
+		if ok := gH.githubInstallationClient.CheckInstallation(owner); !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, model.ErrAppNotInstalled.Error())
+		}
+
+		reports, err := gH.reconcileOwnerLabels(ctx, owner)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadGateway, err.Error())
+		}
+
+		return c.JSON(http.StatusOK, reports)
+	}
+
+	installations, err := gH.githubAppClient.GetInstallations(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadGateway, err.Error())
+	}
+
+	reports := []model.LabelReport{}
+	for _, installation := range installations {
+		if installation.SuspendedAt != nil {
+			continue
+		}
+
+		owner := installation.Account.Login
+		if ok := gH.githubInstallationClient.CheckInstallation(owner); !ok {
+			log.Error().Msgf("[PostReconcileLabels] skipping labels of %s: %v", owner, model.ErrAppNotInstalled)
+			reports = append(reports, model.NewFailedLabelReport(owner, model.ErrAppNotInstalled))
+			continue
+		}
+
+		ownerReports, err := gH.reconcileOwnerLabels(ctx, owner)
+		if err != nil {
+			log.Error().Err(err).Msgf("[PostReconcileLabels] error while reconciling labels of %s", owner)
+			reports = append(reports, model.NewFailedLabelReport(owner, err))
+			continue
+		}
+		reports = append(reports, ownerReports...)
+	}
+
+	return c.JSON(http.StatusOK, reports)
+}
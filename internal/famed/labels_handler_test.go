@@ -0,0 +1,144 @@
+package famed_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/internal/famed"
+	model2 "github.com/morphysm/famed-github-backend/internal/famed/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers/providersfakes"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage/storagefakes"
+)
+
+func TestPostReconcileLabels(t *testing.T) {
+	t.Parallel()
+
+	famedConfig := model2.Config{
+		Labels: map[string]model.Label{
+			"famed": {Name: "famed", Color: "566FDB", Description: "Famed issue"},
+			"high":  {Name: "high", Color: "ff8c00", Description: "High severity"},
+			"low":   {Name: "low", Color: "00ff00", Description: "Low severity"},
+		},
+	}
+
+	testCases := []struct {
+		Name             string
+		AppInstalled     bool
+		Existing         []model.Label
+		ExpectedCreated  []string
+		ExpectedUpdated  []string
+		ExpectedResponse string
+		ExpectedErr      error
+	}{
+		{
+			Name:        "App not installed",
+			ExpectedErr: echo.NewHTTPError(http.StatusBadRequest, model2.ErrAppNotInstalled.Error()),
+		},
+		{
+			Name:         "Valid",
+			AppInstalled: true,
+			Existing: []model.Label{
+				{Name: "famed", Color: "566fdb", Description: "Famed issue"},
+				{Name: "high", Color: "ffffff", Description: "High severity"},
+			},
+			ExpectedCreated:  []string{"low"},
+			ExpectedUpdated:  []string{"high"},
+			ExpectedResponse: "[{\"owner\":\"testOwner\",\"repoName\":\"testRepo\",\"created\":[\"low\"],\"updated\":[\"high\"],\"unchanged\":[\"famed\"]}]\n",
+		},
+		{
+			Name:         "Capitalization drifted",
+			AppInstalled: true,
+			Existing: []model.Label{
+				{Name: "Famed", Color: "566FDB", Description: "Famed issue"},
+				{Name: "high", Color: "ff8c00", Description: "High severity"},
+				{Name: "low", Color: "00ff00", Description: "Low severity"},
+			},
+			ExpectedUpdated:  []string{"famed"},
+			ExpectedResponse: "[{\"owner\":\"testOwner\",\"repoName\":\"testRepo\",\"created\":[],\"updated\":[\"famed\"],\"unchanged\":[\"high\",\"low\"]}]\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// GIVEN
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/admin/labels/reconcile?owner=testOwner", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			fakeInstallationClient := &providersfakes.FakeInstallationClient{}
+			fakeInstallationClient.CheckInstallationReturns(testCase.AppInstalled)
+			fakeInstallationClient.GetReposReturns([]string{"testRepo"}, nil)
+			fakeInstallationClient.GetLabelsReturns(testCase.Existing, nil)
+
+			githubHandler := famed.NewHandler(nil, fakeInstallationClient, &storagefakes.FakeStore{}, famedConfig, Now)
+
+			// WHEN
+			err := githubHandler.PostReconcileLabels(ctx)
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedErr, err)
+			if testCase.ExpectedErr != nil {
+				return
+			}
+
+			assert.Equal(t, len(testCase.ExpectedCreated), fakeInstallationClient.PostLabelCallCount())
+			for i, expectedLabel := range testCase.ExpectedCreated {
+				_, _, _, label := fakeInstallationClient.PostLabelArgsForCall(i)
+				assert.Equal(t, famedConfig.Labels[expectedLabel], label)
+			}
+			assert.Equal(t, len(testCase.ExpectedUpdated), fakeInstallationClient.UpdateLabelCallCount())
+			for i, expectedLabel := range testCase.ExpectedUpdated {
+				_, _, _, label := fakeInstallationClient.UpdateLabelArgsForCall(i)
+				assert.Equal(t, famedConfig.Labels[expectedLabel], label)
+			}
+			assert.Equal(t, testCase.ExpectedResponse, rec.Body.String())
+		})
+	}
+}
+
+func TestPostReconcileLabelsAllInstallations(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	famedConfig := model2.Config{
+		Labels: map[string]model.Label{
+			"famed": {Name: "famed", Color: "566FDB", Description: "Famed issue"},
+		},
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/admin/labels/reconcile", nil)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	fakeAppClient := &providersfakes.FakeAppClient{}
+	fakeAppClient.GetInstallationsReturns([]model.Installation{
+		{ID: 1, Account: model.User{Login: "missingOwner"}},
+		{ID: 2, Account: model.User{Login: "testOwner"}},
+	}, nil)
+	fakeInstallationClient := &providersfakes.FakeInstallationClient{}
+	fakeInstallationClient.CheckInstallationStub = func(owner string) bool {
+		return owner == "testOwner"
+	}
+	fakeInstallationClient.GetReposReturns([]string{"testRepo"}, nil)
+
+	githubHandler := famed.NewHandler(fakeAppClient, fakeInstallationClient, &storagefakes.FakeStore{}, famedConfig, Now)
+
+	// WHEN
+	err := githubHandler.PostReconcileLabels(ctx)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, 1, fakeInstallationClient.GetReposCallCount())
+	assert.Equal(t, 1, fakeInstallationClient.PostLabelCallCount())
+	assert.Equal(t, "[{\"owner\":\"missingOwner\",\"repoName\":\"\",\"created\":[],\"updated\":[],\"unchanged\":[],\"errors\":[\""+model2.ErrAppNotInstalled.Error()+"\"]},"+
+		"{\"owner\":\"testOwner\",\"repoName\":\"testRepo\",\"created\":[\"famed\"],\"updated\":[],\"unchanged\":[]}]\n", rec.Body.String())
+}
@@ -0,0 +1,134 @@
+package famed
+
+import (
+	"github.com/phuslu/log"
+
+	"github.com/morphysm/famed-github-backend/internal/famed/model"
+	githubModel "github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+// recordPayouts adds a suggested ledger entry for each rewarded contributor of an issue and returns the payout statuses by login.
+// Suggested entries are updated to the recomputed reward, suggested entries of contributors no longer rewarded are voided.
+// Approved, paid and void entries are left untouched.
+func (gH *githubHandler) recordPayouts(owner string, repoName string, issue githubModel.EnrichedIssue, contributors []*model.Contributor, currency string) map[string]model.PayoutStatus {
+	entries, err := gH.store.GetIssueLedgerEntries(owner, repoName, issue.Number)
+	if err != nil {
+		log.Error().Err(err).Msgf("[recordPayouts] error while reading ledger of %s/%s#%d", owner, repoName, issue.Number)
+		return nil
+	}
+	if entries == nil {
+		entries = make(map[string]model.LedgerEntry)
+	}
+
+	now := gH.now()
+	rewarded := make(map[string]bool, len(contributors))
+	for _, contributor := range contributors {
+		rewarded[contributor.Login] = true
+
+		entry, found := entries[contributor.Login]
+		if found && entry.Status != model.Suggested {
+			continue
+		}
+
+		newEntry := model.NewLedgerEntry(owner, repoName, issue.Number, issue.HTMLURL, contributor.Login, contributor.RewardSum, currency, now)
+		if found {
+			if entry.Amount == newEntry.Amount && entry.Currency == newEntry.Currency {
+				continue
+			}
+			newEntry.CreatedAt = entry.CreatedAt
+		}
+
+		gH.putLedgerEntry(newEntry)
+		entries[contributor.Login] = newEntry
+	}
+
+	for login, entry := range entries {
+		if rewarded[login] || entry.Status != model.Suggested {
+			continue
+		}
+
+		voidEntry, err := entry.Transition(model.Void, "", now)
+		if err != nil {
+			continue
+		}
+
+		gH.putLedgerEntry(voidEntry)
+		entries[login] = voidEntry
+	}
+
+	return payoutStatuses(entries)
+}
+
+// retractPayouts voids the suggested and approved ledger entries of an issue, paid entries are left untouched.
+func (gH *githubHandler) retractPayouts(owner string, repoName string, issueNumber int) {
+	entries, err := gH.store.GetIssueLedgerEntries(owner, repoName, issueNumber)
+	if err != nil {
+		log.Error().Err(err).Msgf("[retractPayouts] error while reading ledger of %s/%s#%d", owner, repoName, issueNumber)
+		return
+	}
+
+	now := gH.now()
+	for _, entry := range entries {
+		voidEntry, err := entry.Transition(model.Void, "", now)
+		if err != nil {
+			continue
+		}
+
+		gH.putLedgerEntry(voidEntry)
+	}
+}
+
+// movePayouts moves the ledger entries of a transferred issue to the issue's new location.
+func (gH *githubHandler) movePayouts(owner string, repoName string, issueNumber int, transfer githubModel.IssueTransfer) {
+	entries, err := gH.store.GetIssueLedgerEntries(owner, repoName, issueNumber)
+	if err != nil {
+		log.Error().Err(err).Msgf("[movePayouts] error while reading ledger of %s/%s#%d", owner, repoName, issueNumber)
+		return
+	}
+
+	now := gH.now()
+	for _, entry := range entries {
+		movedEntry := entry
+		movedEntry.Owner = transfer.Repo.Owner.Login
+		movedEntry.RepoName = transfer.Repo.Name
+		movedEntry.IssueNumber = transfer.Number
+		movedEntry.IssueURL = transfer.HTMLURL
+		movedEntry.UpdatedAt = now
+
+		if err := gH.store.PutLedgerEntry(movedEntry); err != nil {
+			log.Error().Err(err).Msgf("[movePayouts] error while storing ledger entry of %s for %s/%s#%d", movedEntry.Login, movedEntry.Owner, movedEntry.RepoName, movedEntry.IssueNumber)
+			continue
+		}
+
+		if err := gH.store.DeleteLedgerEntry(owner, repoName, issueNumber, entry.Login); err != nil {
+			log.Error().Err(err).Msgf("[movePayouts] error while removing ledger entry of %s for %s/%s#%d", entry.Login, owner, repoName, issueNumber)
+		}
+	}
+}
+
+// issuePayoutStatuses returns the payout statuses of an issue's contributors by login.
+func (gH *githubHandler) issuePayoutStatuses(owner string, repoName string, issueNumber int) map[string]model.PayoutStatus {
+	entries, err := gH.store.GetIssueLedgerEntries(owner, repoName, issueNumber)
+	if err != nil {
+		log.Error().Err(err).Msgf("[issuePayoutStatuses] error while reading ledger of %s/%s#%d", owner, repoName, issueNumber)
+		return nil
+	}
+
+	return payoutStatuses(entries)
+}
+
+// putLedgerEntry stores a ledger entry, errors are logged since the entry is recreated on the next reward comment update.
+func (gH *githubHandler) putLedgerEntry(entry model.LedgerEntry) {
+	if err := gH.store.PutLedgerEntry(entry); err != nil {
+		log.Error().Err(err).Msgf("[putLedgerEntry] error while storing ledger entry of %s for %s/%s#%d", entry.Login, entry.Owner, entry.RepoName, entry.IssueNumber)
+	}
+}
+
+func payoutStatuses(entries map[string]model.LedgerEntry) map[string]model.PayoutStatus {
+	statuses := make(map[string]model.PayoutStatus, len(entries))
+	for login, entry := range entries {
+		statuses[login] = entry.Status
+	}
+
+	return statuses
+}
@@ -0,0 +1,173 @@
+package famed
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/phuslu/log"
+
+	"github.com/morphysm/famed-github-backend/internal/famed/model"
+)
+
+var ledgerCSVHeader = []string{"owner", "repoName", "issueNumber", "issueUrl", "login", "amount", "currency", "status", "reference", "createdAt", "updatedAt"}
+
+type paymentReference struct {
+	Reference string `json:"reference"`
+}
+
+// GetLedger exports the ledger entries as JSON or, if the format query parameter is csv, as CSV.
+// The entries can be filtered by the owner and status query parameters.
+func (gH *githubHandler) GetLedger(c echo.Context) error {
+	owner := c.QueryParam("owner")
+
+	status := model.PayoutStatus(c.QueryParam("status"))
+	if status != "" && !status.IsValid() {
+		return echo.NewHTTPError(http.StatusBadRequest, model.ErrInvalidStatusQueryParameter.Error())
+	}
+
+	format := c.QueryParam("format")
+	if format != "" && format != "json" && format != "csv" {
+		return echo.NewHTTPError(http.StatusBadRequest, model.ErrInvalidFormatQueryParameter.Error())
+	}
+
+	entries, err := gH.store.GetLedgerEntries()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	filtered := []model.LedgerEntry{}
+	for _, entry := range entries {
+		if (owner == "" || entry.Owner == owner) && (status == "" || entry.Status == status) {
+			filtered = append(filtered, entry)
+		}
+	}
+
+	if format != "csv" {
+		return c.JSON(http.StatusOK, filtered)
+	}
+
+	var b bytes.Buffer
+	writer := csv.NewWriter(&b)
+	records := [][]string{ledgerCSVHeader}
+	for _, entry := range filtered {
+		records = append(records, []string{
+			entry.Owner,
+			entry.RepoName,
+			strconv.Itoa(entry.IssueNumber),
+			entry.IssueURL,
+			entry.Login,
+			strconv.FormatFloat(entry.Amount, 'f', -1, 64),
+			entry.Currency,
+			string(entry.Status),
+			entry.Reference,
+			entry.CreatedAt.Format(time.RFC3339),
+			entry.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+	if err := writer.WriteAll(records); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="ledger.csv"`)
+	return c.Blob(http.StatusOK, "text/csv", b.Bytes())
+}
+
+// PostApproveLedgerEntry approves a suggested ledger entry.
+func (gH *githubHandler) PostApproveLedgerEntry(c echo.Context) error {
+	return gH.transitionLedgerEntry(c, model.Approved, "")
+}
+
+// PostPayLedgerEntry marks an approved ledger entry as paid.
+// The payment reference is read from the reference field of the JSON body.
+func (gH *githubHandler) PostPayLedgerEntry(c echo.Context) error {
+	var body paymentReference
+	if err := c.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return gH.transitionLedgerEntry(c, model.Paid, body.Reference)
+}
+
+// PostVoidLedgerEntry voids a ledger entry that is not paid yet.
+func (gH *githubHandler) PostVoidLedgerEntry(c echo.Context) error {
+	return gH.transitionLedgerEntry(c, model.Void, "")
+}
+
+// transitionLedgerEntry moves the ledger entry identified by the path parameters to the given status
+// and updates the reward comment of the issue to show the new status.
+func (gH *githubHandler) transitionLedgerEntry(c echo.Context, status model.PayoutStatus, reference string) error {
+	owner := c.Param("owner")
+	if owner == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, model.ErrMissingOwnerPathParameter.Error())
+	}
+
+	repoName := c.Param("repo_name")
+	if repoName == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, model.ErrMissingRepoPathParameter.Error())
+	}
+
+	issueNumber, err := strconv.Atoi(c.Param("issue_number"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, model.ErrMissingIssueNumberPathParameter.Error())
+	}
+
+	login := c.Param("login")
+	if login == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, model.ErrMissingLoginPathParameter.Error())
+	}
+
+	entry, found, err := gH.store.GetLedgerEntry(owner, repoName, issueNumber, login)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if !found {
+		return echo.NewHTTPError(http.StatusNotFound, model.ErrLedgerEntryNotFound.Error())
+	}
+
+	entry, err = entry.Transition(status, reference, gH.now())
+	if err != nil {
+		if err == model.ErrMissingPaymentReference {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	}
+
+	if err := gH.store.PutLedgerEntry(entry); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	gH.updateLedgerComment(c.Request().Context(), entry.Owner, entry.RepoName, entry.IssueNumber)
+
+	return c.JSON(http.StatusOK, entry)
+}
+
+// updateLedgerComment updates the reward comment of a stored issue to show the current payout statuses.
+// Errors are logged since the comment is updated again by the next comment update run.
+func (gH *githubHandler) updateLedgerComment(ctx context.Context, owner string, repoName string, issueNumber int) {
+	issues, err := gH.store.GetIssues(owner, repoName)
+	if err != nil {
+		log.Error().Err(err).Msgf("[updateLedgerComment] error while reading issues of %s/%s from store", owner, repoName)
+		return
+	}
+
+	issue, ok := issues[issueNumber]
+	if !ok {
+		log.Warn().Msgf("[updateLedgerComment] issue %s/%s#%d not found in store", owner, repoName, issueNumber)
+		return
+	}
+
+	comments, err := gH.githubInstallationClient.GetComments(ctx, owner, repoName, issueNumber)
+	if err != nil {
+		log.Error().Err(err).Msgf("[updateLedgerComment] error while retrieving comments of %s/%s#%d", owner, repoName, issueNumber)
+		return
+	}
+
+	if _, err := gH.updateRewardComment(ctx, owner, repoName, issue, comments); err != nil {
+		log.Error().Err(err).Msgf("[updateLedgerComment] error while updating reward comment of %s/%s#%d", owner, repoName, issueNumber)
+	}
+}
@@ -0,0 +1,134 @@
+package famed_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/internal/famed"
+	"github.com/morphysm/famed-github-backend/internal/famed/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers/providersfakes"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage/storagefakes"
+)
+
+func TestPostLedgerEntry(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		Action         string
+		Body           string
+		Status         model.PayoutStatus
+		Found          bool
+		ExpectedStatus model.PayoutStatus
+		ExpectedErr    *echo.HTTPError
+	}{
+		{
+			Name:           "Approve",
+			Action:         "approve",
+			Status:         model.Suggested,
+			Found:          true,
+			ExpectedStatus: model.Approved,
+		},
+		{
+			Name:           "Pay",
+			Action:         "paid",
+			Body:           `{"reference":"TX-1"}`,
+			Status:         model.Approved,
+			Found:          true,
+			ExpectedStatus: model.Paid,
+		},
+		{
+			Name:        "Pay without reference",
+			Action:      "paid",
+			Body:        `{}`,
+			Status:      model.Approved,
+			Found:       true,
+			ExpectedErr: &echo.HTTPError{Code: http.StatusBadRequest, Message: model.ErrMissingPaymentReference.Error()},
+		},
+		{
+			Name:        "Void paid",
+			Action:      "void",
+			Status:      model.Paid,
+			Found:       true,
+			ExpectedErr: &echo.HTTPError{Code: http.StatusConflict, Message: model.ErrInvalidPayoutTransition.Error()},
+		},
+		{
+			Name:        "Not found",
+			Action:      "approve",
+			ExpectedErr: &echo.HTTPError{Code: http.StatusNotFound, Message: model.ErrLedgerEntryNotFound.Error()},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// GIVEN
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/admin/ledger", strings.NewReader(testCase.Body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("owner", "repo_name", "issue_number", "login")
+			ctx.SetParamValues("testOwner", "testRepo", "1", "testUser")
+
+			fakeStore := &storagefakes.FakeStore{}
+			entry := model.NewLedgerEntry("testOwner", "testRepo", 1, "TestURL", "testUser", 100, "POINTS", Now())
+			entry.Status = testCase.Status
+			fakeStore.GetLedgerEntryReturns(entry, testCase.Found, nil)
+
+			githubHandler := famed.NewHandler(nil, &providersfakes.FakeInstallationClient{}, fakeStore, NewTestConfig(), Now)
+			handlers := map[string]echo.HandlerFunc{
+				"approve": githubHandler.PostApproveLedgerEntry,
+				"paid":    githubHandler.PostPayLedgerEntry,
+				"void":    githubHandler.PostVoidLedgerEntry,
+			}
+
+			// WHEN
+			err := handlers[testCase.Action](ctx)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				assert.Equal(t, testCase.ExpectedErr, err)
+				assert.Equal(t, 0, fakeStore.PutLedgerEntryCallCount())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, 1, fakeStore.PutLedgerEntryCallCount())
+			assert.Equal(t, testCase.ExpectedStatus, fakeStore.PutLedgerEntryArgsForCall(0).Status)
+		})
+	}
+}
+
+func TestGetLedger(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/admin/ledger?status=approved&format=csv", nil)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	approved := model.NewLedgerEntry("testOwner", "testRepo", 1, "TestURL", "testUser", 100, "POINTS", Now())
+	approved.Status = model.Approved
+	suggested := model.NewLedgerEntry("testOwner", "testRepo", 2, "TestURL", "testUser", 200, "POINTS", Now())
+	fakeStore := &storagefakes.FakeStore{}
+	fakeStore.GetLedgerEntriesReturns([]model.LedgerEntry{approved, suggested}, nil)
+
+	githubHandler := famed.NewHandler(nil, &providersfakes.FakeInstallationClient{}, fakeStore, NewTestConfig(), Now)
+
+	// WHEN
+	err := githubHandler.GetLedger(ctx)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "owner,repoName,issueNumber,issueUrl,login,amount,currency,status,reference,createdAt,updatedAt\n"+
+		"testOwner,testRepo,1,TestURL,testUser,100,POINTS,approved,,2022-04-20T00:00:00Z,2022-04-20T00:00:00Z\n", rec.Body.String())
+}
@@ -22,25 +22,25 @@ func NewBlueTeamFromIssues(issues map[int]model.EnrichedIssue, options BoardOpti
 }
 
 // NewBlueTeamFromIssue returns a contributors map generated from the repo's internal issue with issueID
-// and its corresponding events.
-func NewBlueTeamFromIssue(issue model.EnrichedIssue, options BoardOptions) ([]*Contributor, error) {
+// and its corresponding events together with how the reward of the issue was derived.
+func NewBlueTeamFromIssue(issue model.EnrichedIssue, options BoardOptions) ([]*Contributor, RewardBreakdown, error) {
 	contributors := Contributors{}
 	// Map issue to contributors
-	err := contributors.mapBlueTeamIssue(issue, options)
+	breakdown, err := contributors.mapBlueTeamIssue(issue, options)
 	if err != nil {
 		log.Error().Err(err).Msgf("[contributors] error while mapping issue with ID: %d", issue.ID)
-		return nil, err
+		return nil, RewardBreakdown{}, err
 	}
 	// Transformation of contributors map to contributors array
 	contributorsArray := contributors.toSortedSlice()
-	return contributorsArray, nil
+	return contributorsArray, breakdown, nil
 }
 
 func issuesToBlueTeam(issues map[int]model.EnrichedIssue, options BoardOptions) Contributors {
 	contributors := Contributors{}
 	for issueID, issue := range issues {
 		// Map issue to contributors
-		err := contributors.mapBlueTeamIssue(issue, options)
+		_, err := contributors.mapBlueTeamIssue(issue, options)
 		if err != nil {
 			log.Error().Err(err).Msgf("[issuesToBlueTeam] error while mapping issue with ID: %d", issueID)
 			issues[issueID] = issue
@@ -50,29 +50,40 @@ func issuesToBlueTeam(issues map[int]model.EnrichedIssue, options BoardOptions)
 	return contributors
 }
 
-// mapBlueTeamIssue updates the contributors map based on a set of events and an issue.
-func (cs Contributors) mapBlueTeamIssue(issue model.EnrichedIssue, boardOptions BoardOptions) error {
+// mapBlueTeamIssue updates the contributors map based on a set of events and an issue and returns how the reward of the issue was derived.
+func (cs Contributors) mapBlueTeamIssue(issue model.EnrichedIssue, boardOptions BoardOptions) (RewardBreakdown, error) {
 	// Check if issue has closed at timestamp
 	if issue.ClosedAt == nil {
-		return ErrIssueMissingClosedAt
+		return RewardBreakdown{}, ErrIssueMissingClosedAt
 	}
 	issueClosedAt := *issue.ClosedAt
+	// Skip issues closed outside the board's time window
+	if !boardOptions.Window.Contains(issueClosedAt) {
+		return RewardBreakdown{}, nil
+	}
 	timeToDisclosure := issueClosedAt.Sub(issue.CreatedAt).Minutes()
+	overrides := boardOptions.overrides(issue.Number)
 
 	severity, err := issue.Severity()
+	if overrides.Severity != nil {
+		severity, err = *overrides.Severity, nil
+	}
 	if err != nil {
 		log.Error().Err(err).Msgf("[mapBlueTeamIssue] error while reading severity from with id: %d", issue.ID)
-		return err
+		return RewardBreakdown{}, err
 	}
 
 	var workLogs WorkLogs
 	var reopenCount int
 	if !issue.Migrated {
-		workLogs, reopenCount = cs.mapBlueTeamEvents(issue.Events, issueClosedAt, severity, timeToDisclosure, boardOptions.Currency, boardOptions.Now)
+		workLogs, reopenCount = cs.mapBlueTeamEvents(issue.Events, issueClosedAt, severity, timeToDisclosure, overrides, boardOptions)
 	}
 	if issue.Migrated {
 		for _, assignee := range issue.Assignees {
-			cs.mapAssigneeIfMissing(assignee, boardOptions.Currency, boardOptions.Now)
+			if overrides.IsExcluded(assignee.Login) {
+				continue
+			}
+			cs.mapAssigneeIfMissing(assignee, boardOptions)
 			workLogs = WorkLogs{}
 			workLogs.Add(assignee.Login, WorkLog{issue.CreatedAt, issueClosedAt})
 			cs.incrementFixCounters(assignee.Login, timeToDisclosure, severity)
@@ -80,13 +91,11 @@ func (cs Contributors) mapBlueTeamIssue(issue model.EnrichedIssue, boardOptions
 	}
 
 	// Calculate the reward
-	cs.UpdateRewards(issue.HTMLURL, workLogs, issue.CreatedAt, issueClosedAt, reopenCount, severity, boardOptions)
-
-	return nil
+	return cs.UpdateRewards(issue.HTMLURL, workLogs, issue.CreatedAt, issueClosedAt, reopenCount, severity, overrides, boardOptions), nil
 }
 
-// mapBlueTeamEvents maps issue events to the contributors
-func (cs Contributors) mapBlueTeamEvents(events []model.IssueEvent, issueClosedAt time.Time, severity model.IssueSeverity, timeToDisclosure float64, currency string, now time.Time) (WorkLogs, int) {
+// mapBlueTeamEvents maps issue events to the contributors, events of excluded contributors are skipped.
+func (cs Contributors) mapBlueTeamEvents(events []model.IssueEvent, issueClosedAt time.Time, severity model.IssueSeverity, timeToDisclosure float64, overrides RewardOverrides, boardOptions BoardOptions) (WorkLogs, int) {
 	// areIncremented tracks contributors that have had their fix counters incremented
 	var (
 		workLogs       = WorkLogs{}
@@ -102,11 +111,11 @@ func (cs Contributors) mapBlueTeamEvents(events []model.IssueEvent, issueClosedA
 				log.Warn().Msgf("[mapBlueTeamIssue] event assigned is missing for event with ID: %d", event.ID)
 				continue
 			}
-			if event.CreatedAt.After(issueClosedAt) {
+			if event.CreatedAt.After(issueClosedAt) || overrides.IsExcluded(event.Assignee.Login) {
 				continue
 			}
 
-			cs.mapEventAssigned(event, issueClosedAt, workLogs, currency, now)
+			cs.mapEventAssigned(event, issueClosedAt, workLogs, boardOptions)
 
 			// Increment fix count if not yet done
 			if isIncremented := areIncremented[event.Assignee.Login]; !isIncremented {
@@ -114,6 +123,9 @@ func (cs Contributors) mapBlueTeamEvents(events []model.IssueEvent, issueClosedA
 				areIncremented[event.Assignee.Login] = true
 			}
 		case string(model.IssueEventActionUnassigned):
+			if event.Assignee != nil && overrides.IsExcluded(event.Assignee.Login) {
+				continue
+			}
 			mapEventUnassigned(event, workLogs)
 		case string(model.IssueEventActionReopened):
 			reopenCount++
@@ -124,8 +136,8 @@ func (cs Contributors) mapBlueTeamEvents(events []model.IssueEvent, issueClosedA
 }
 
 // mapEventAssigned handles an assigned event, updating the contributor map.
-func (cs Contributors) mapEventAssigned(event model.IssueEvent, issueClosedAt time.Time, workLogs WorkLogs, currency string, now time.Time) {
-	cs.mapAssigneeIfMissing(*event.Assignee, currency, now)
+func (cs Contributors) mapEventAssigned(event model.IssueEvent, issueClosedAt time.Time, workLogs WorkLogs, boardOptions BoardOptions) {
+	cs.mapAssigneeIfMissing(*event.Assignee, boardOptions)
 
 	// Append work log
 	workLogs.Add(event.Assignee.Login, WorkLog{event.CreatedAt, issueClosedAt})
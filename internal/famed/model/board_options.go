@@ -5,13 +5,77 @@ import "time"
 type BoardOptions struct {
 	Currency        string
 	RewardStructure RewardStructure
+	Granularity     Granularity
+	Window          Window
 	Now             time.Time
+	// Overrides maps issue numbers to the reward overrides set by maintainers
+	Overrides map[int]RewardOverrides
 }
 
-func NewBoardOptions(currency string, rewardStructure RewardStructure, now time.Time) BoardOptions {
+// Window limits a board to the issues closed in between Since (inclusive) and Until (exclusive).
+// A nil bound leaves the window open on that side.
+type Window struct {
+	Since *time.Time
+	Until *time.Time
+}
+
+func NewBoardOptions(currency string, rewardStructure RewardStructure, granularity Granularity, window Window, now time.Time) BoardOptions {
 	return BoardOptions{
 		Currency:        currency,
 		RewardStructure: rewardStructure,
+		Granularity:     granularity,
+		Window:          window,
 		Now:             now,
 	}
 }
+
+// IsZero returns true if the window is open on both sides.
+func (w Window) IsZero() bool {
+	return w.Since == nil && w.Until == nil
+}
+
+// Contains returns true if t lies within the window.
+func (w Window) Contains(t time.Time) bool {
+	if w.Since != nil && t.Before(*w.Since) {
+		return false
+	}
+
+	return w.Until == nil || t.Before(*w.Until)
+}
+
+// ExceedsMaxBuckets returns true if the rewards series of a board with the given options has more than MaxRewardsSeriesBuckets buckets.
+func (o BoardOptions) ExceedsMaxBuckets() bool {
+	return seriesBuckets(o.Granularity, o.Window.Since, o.seriesEnd()) > MaxRewardsSeriesBuckets
+}
+
+// seriesEnd returns the time the rewards series of a board ends at.
+// This is the last instant of the window or now if the window is open.
+func (o BoardOptions) seriesEnd() time.Time {
+	if o.Window.Until != nil {
+		return o.Window.Until.Add(-time.Nanosecond)
+	}
+
+	return o.Now
+}
+
+// newRewardsSeries returns an empty rewards series for a contributor of the board.
+func (o BoardOptions) newRewardsSeries() RewardsLastYear {
+	return NewRewardsSeries(o.Granularity, o.Window.Since, o.seriesEnd())
+}
+
+// WithOverrides returns a copy of the options with the reward overrides of an issue replaced.
+func (o BoardOptions) WithOverrides(issueNumber int, overrides RewardOverrides) BoardOptions {
+	allOverrides := make(map[int]RewardOverrides, len(o.Overrides)+1)
+	for number, issueOverrides := range o.Overrides {
+		allOverrides[number] = issueOverrides
+	}
+	allOverrides[issueNumber] = overrides
+	o.Overrides = allOverrides
+
+	return o
+}
+
+// overrides returns the reward overrides of an issue.
+func (o BoardOptions) overrides(issueNumber int) RewardOverrides {
+	return o.Overrides[issueNumber]
+}
@@ -0,0 +1,136 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+// commandPrefix starts every line of an issue comment holding a Famed command.
+const commandPrefix = "/famed"
+
+// CommandName is the name of a command maintainers can issue through issue comments.
+type CommandName string
+
+const (
+	// SplitCommand splits the reward between contributors by the given shares, e.g. "/famed split @alice 60 @bob 40".
+	SplitCommand CommandName = "split"
+	// RecalcCommand recalculates the reward, e.g. "/famed recalc".
+	RecalcCommand CommandName = "recalc"
+	// ExcludeCommand excludes contributors from the reward, e.g. "/famed exclude @bot".
+	ExcludeCommand CommandName = "exclude"
+	// SeverityCommand overrides the severity of the issue, e.g. "/famed severity high".
+	SeverityCommand CommandName = "severity"
+)
+
+// Command is a parsed Famed command.
+type Command struct {
+	Name     CommandName
+	Split    map[string]float64
+	Logins   []string
+	Severity model.IssueSeverity
+}
+
+// HasCommands returns true if a line of the issue comment body starts with "/famed".
+func HasCommands(body string) bool {
+	for _, line := range strings.Split(body, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 0 && fields[0] == commandPrefix {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseCommands returns the commands found in an issue comment body, one command per line starting with "/famed".
+// Lines not starting with "/famed" are ignored, an invalid command returns an error.
+func ParseCommands(body string) ([]Command, error) {
+	var commands []Command
+	for _, line := range strings.Split(body, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[0] != commandPrefix {
+			continue
+		}
+
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownCommand, strings.TrimSpace(line))
+		}
+
+		command, err := parseCommand(CommandName(strings.ToLower(fields[1])), fields[2:])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(line))
+		}
+
+		commands = append(commands, command)
+	}
+
+	return commands, nil
+}
+
+func parseCommand(name CommandName, args []string) (Command, error) {
+	command := Command{Name: name}
+
+	switch name {
+	case SplitCommand:
+		if len(args) == 0 || len(args)%2 != 0 {
+			return Command{}, ErrInvalidCommandArguments
+		}
+
+		command.Split = make(map[string]float64, len(args)/2)
+		for i := 0; i < len(args); i += 2 {
+			login, ok := parseLogin(args[i])
+			if !ok {
+				return Command{}, ErrInvalidCommandArguments
+			}
+
+			share, err := strconv.ParseFloat(strings.TrimSuffix(args[i+1], "%"), 64)
+			if err != nil || share <= 0 {
+				return Command{}, ErrInvalidCommandArguments
+			}
+
+			command.Split[login] = share
+		}
+	case RecalcCommand:
+		if len(args) != 0 {
+			return Command{}, ErrInvalidCommandArguments
+		}
+	case ExcludeCommand:
+		if len(args) == 0 {
+			return Command{}, ErrInvalidCommandArguments
+		}
+
+		for _, arg := range args {
+			login, ok := parseLogin(arg)
+			if !ok {
+				return Command{}, ErrInvalidCommandArguments
+			}
+
+			command.Logins = append(command.Logins, login)
+		}
+	case SeverityCommand:
+		if len(args) != 1 {
+			return Command{}, ErrInvalidCommandArguments
+		}
+
+		severity := model.IssueSeverity(strings.ToLower(args[0]))
+		switch severity {
+		case model.Info, model.Low, model.Medium, model.High, model.Critical:
+			command.Severity = severity
+		default:
+			return Command{}, ErrInvalidCommandArguments
+		}
+	default:
+		return Command{}, ErrUnknownCommand
+	}
+
+	return command, nil
+}
+
+// parseLogin returns the login of a GitHub mention such as "@alice".
+func parseLogin(mention string) (string, bool) {
+	login := strings.TrimPrefix(mention, "@")
+	return login, login != "" && login != mention
+}
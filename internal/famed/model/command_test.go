@@ -0,0 +1,90 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/internal/famed/model"
+	model2 "github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+func TestParseCommands(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		Body        string
+		Expected    []model.Command
+		ExpectedErr error
+	}{
+		{
+			Name: "No command",
+			Body: "Thanks for the fix!",
+		},
+		{
+			Name: "Split",
+			Body: "/famed split @alice 60 @bob 40%",
+			Expected: []model.Command{{
+				Name:  model.SplitCommand,
+				Split: map[string]float64{"alice": 60, "bob": 40},
+			}},
+		},
+		{
+			Name: "Multiple commands",
+			Body: "Overriding the reward:\n/famed exclude @bot @dependabot\n/famed severity High\n/famed recalc",
+			Expected: []model.Command{
+				{Name: model.ExcludeCommand, Logins: []string{"bot", "dependabot"}},
+				{Name: model.SeverityCommand, Severity: model2.High},
+				{Name: model.RecalcCommand},
+			},
+		},
+		{
+			Name:        "Unknown command",
+			Body:        "/famed pay @alice",
+			ExpectedErr: model.ErrUnknownCommand,
+		},
+		{
+			Name:        "Missing command",
+			Body:        "/famed",
+			ExpectedErr: model.ErrUnknownCommand,
+		},
+		{
+			Name:        "Split without share",
+			Body:        "/famed split @alice 60 @bob",
+			ExpectedErr: model.ErrInvalidCommandArguments,
+		},
+		{
+			Name:        "Split without mention",
+			Body:        "/famed split alice 100",
+			ExpectedErr: model.ErrInvalidCommandArguments,
+		},
+		{
+			Name:        "Invalid severity",
+			Body:        "/famed severity urgent",
+			ExpectedErr: model.ErrInvalidCommandArguments,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// WHEN
+			commands, err := model.ParseCommands(testCase.Body)
+
+			// THEN
+			assert.ErrorIs(t, err, testCase.ExpectedErr)
+			assert.Equal(t, testCase.Expected, commands)
+		})
+	}
+}
+
+func TestHasCommands(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, model.HasCommands("Thanks!\n  /famed recalc"))
+	assert.True(t, model.HasCommands("/famed pay @alice"))
+	assert.False(t, model.HasCommands("Thanks for the /famed fix!"))
+	assert.False(t, model.HasCommands(""))
+}
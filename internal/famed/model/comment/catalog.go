@@ -0,0 +1,240 @@
+package comment
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+	"golang.org/x/text/number"
+)
+
+// ErrUnsupportedLanguage is returned for languages the comments are not translated to.
+var ErrUnsupportedLanguage = fmt.Errorf("unsupported language, expected one of %s", strings.Join(supportedLanguageNames(), ", "))
+
+// supportedLanguages are the languages the comments are translated to, the first language is the default language.
+var supportedLanguages = []language.Tag{language.English, language.Spanish, language.Japanese}
+
+// translations holds the messages by language.
+// The English messages are the message keys and only need to be added to the catalog if they contain plurals.
+var translations = map[language.Tag]map[string]string{
+	language.Spanish: {
+		"Assignees for issue **%s #%s** are now eligible to Get Famed.": "Los responsables de la issue **%s #%s** ahora pueden Get Famed.",
+		"Add assignees to track contribution times of the issue":        "Añade responsables para registrar el tiempo de contribución de la issue",
+		"Add a single severity (CVSS) label to compute the score":       "Añade una sola etiqueta de severidad (CVSS) para calcular la puntuación",
+		"Link a pull request fixing the issue":                          "Vincula una pull request que corrija la issue",
+		"Merge the pull request fixing the issue":                       "Fusiona la pull request que corrige la issue",
+		"Add a CVSS vector to the issue description or as label":        "Añade un vector CVSS a la descripción de la issue o como etiqueta",
+		"Add the issue to a milestone":                                  "Añade la issue a un hito",
+		"Happy hacking!":                                                "¡Feliz hacking!",
+		"you Got Famed! 💎 Check out your new score here: %s":            "¡conseguiste Famed! 💎 Consulta tu nueva puntuación aquí: %s",
+		"Contributor":                  "Contribuidor",
+		"Time":                         "Tiempo",
+		"Reward":                       "Recompensa",
+		"Status":                       "Estado",
+		"suggested":                    "sugerida",
+		"approved":                     "aprobada",
+		"paid":                         "pagada",
+		"void":                         "anulada",
+		"Share":                        "Participación",
+		"Severity":                     "Severidad",
+		"Time open":                    "Tiempo abierta",
+		"of %s to fix":                 "de %s para corregir",
+		"Reopened (k)":                 "Reabierta (k)",
+		"Decay factor":                 "Factor de decaimiento",
+		"How the reward is calculated": "Cómo se calcula la recompensa",
+		"The issue was fixed within the deadline of %s.":                                         "La issue se corrigió dentro del plazo de %s.",
+		"The issue was not fixed within any deadline.":                                           "La issue no se corrigió dentro de ningún plazo.",
+		"The reward is divided by the split set by the maintainers.":                             "La recompensa se divide según el reparto fijado por los mantenedores.",
+		"The reward is divided by the share of time each contributor was assigned to the issue.": "La recompensa se divide según el tiempo que cada contribuidor estuvo asignado a la issue.",
+		"Famed could not generate a reward suggestion.":                                          "Famed no pudo generar una sugerencia de recompensa.",
+		"Reason: %s":                 "Motivo: %s",
+		reasonMissingPullRequest:     "A la issue le falta una pull request.",
+		reasonMissingAssignee:        "A la issue le falta un responsable.",
+		reasonMissingSeverityLabel:   "A la issue le falta una etiqueta de severidad.",
+		reasonMultipleSeverityLabels: "La issue tiene más de una etiqueta de severidad.",
+		reasonPullRequestNotMerged:   "La pull request de la issue no está fusionada.",
+		reasonMissingCVSSVector:      "A la issue le falta un vector CVSS.",
+		reasonMissingMilestone:       "A la issue le falta un hito.",
+		reasonNoContributors: "Los datos proporcionados por GitHub no son suficientes para generar una sugerencia de recompensa." +
+			"\nPuede deberse a una asignación después de cerrar la issue. Asigna responsables mientras la issue está abierta.",
+		reasonUnknown: "Desconocido.",
+	},
+	language.Japanese: {
+		"Assignees for issue **%s #%s** are now eligible to Get Famed.": "Issue **%s #%s** の担当者は Get Famed の対象になりました。",
+		"Add assignees to track contribution times of the issue":        "担当者を追加して Issue への貢献時間を記録しましょう",
+		"Add a single severity (CVSS) label to compute the score":       "スコアを計算するために深刻度 (CVSS) ラベルを 1 つ追加してください",
+		"Link a pull request fixing the issue":                          "Issue を修正するプルリクエストをリンクしてください",
+		"Merge the pull request fixing the issue":                       "Issue を修正するプルリクエストをマージしてください",
+		"Add a CVSS vector to the issue description or as label":        "Issue の説明またはラベルに CVSS ベクターを追加してください",
+		"Add the issue to a milestone":                                  "Issue をマイルストーンに追加してください",
+		"Happy hacking!":                                                "ハッピーハッキング！",
+		"you Got Famed! 💎 Check out your new score here: %s":            "Famed を獲得しました！💎 新しいスコアはこちら: %s",
+		"Contributor":                  "貢献者",
+		"Time":                         "時間",
+		"Reward":                       "報酬",
+		"Status":                       "ステータス",
+		"suggested":                    "提案済み",
+		"approved":                     "承認済み",
+		"paid":                         "支払済み",
+		"void":                         "無効",
+		"Share":                        "割合",
+		"Severity":                     "深刻度",
+		"Time open":                    "オープン期間",
+		"of %s to fix":                 "（修正期限 %s）",
+		"Reopened (k)":                 "再オープン回数 (k)",
+		"Decay factor":                 "減衰係数",
+		"How the reward is calculated": "報酬の計算方法",
+		"The issue was fixed within the deadline of %s.":                                         "Issue は %s以内に修正されました。",
+		"The issue was not fixed within any deadline.":                                           "Issue はどの期限内にも修正されませんでした。",
+		"The reward is divided by the split set by the maintainers.":                             "報酬はメンテナーが設定した配分で分けられます。",
+		"The reward is divided by the share of time each contributor was assigned to the issue.": "報酬は各貢献者が Issue に割り当てられていた時間の割合で分けられます。",
+		"Famed could not generate a reward suggestion.":                                          "Famed は報酬の提案を生成できませんでした。",
+		"Reason: %s":                 "理由: %s",
+		reasonMissingPullRequest:     "Issue にプルリクエストがありません。",
+		reasonMissingAssignee:        "Issue に担当者がいません。",
+		reasonMissingSeverityLabel:   "Issue に深刻度ラベルがありません。",
+		reasonMultipleSeverityLabels: "Issue に複数の深刻度ラベルがあります。",
+		reasonPullRequestNotMerged:   "Issue のプルリクエストがマージされていません。",
+		reasonMissingCVSSVector:      "Issue に CVSS ベクターがありません。",
+		reasonMissingMilestone:       "Issue にマイルストーンがありません。",
+		reasonNoContributors: "GitHub から提供されたデータでは報酬の提案を生成できません。" +
+			"\nIssue がクローズされた後に担当者が割り当てられた可能性があります。オープンの状態で担当者を割り当ててください。",
+		reasonUnknown: "不明。",
+		// Japanese does not separate the units of durations
+		"%s %s": "%s%s",
+	},
+}
+
+const (
+	daysMessage    = "%d days"
+	hoursMessage   = "%d hours"
+	minutesMessage = "%d minutes"
+)
+
+// pluralTranslations holds the messages depending on a count by language, each as the singular and plural form.
+var pluralTranslations = map[language.Tag]map[string][2]string{
+	language.English: {
+		daysMessage:    {"%d day", "%d days"},
+		hoursMessage:   {"%d hour", "%d hours"},
+		minutesMessage: {"%d minute", "%d minutes"},
+	},
+	language.Spanish: {
+		daysMessage:    {"%d día", "%d días"},
+		hoursMessage:   {"%d hora", "%d horas"},
+		minutesMessage: {"%d minuto", "%d minutos"},
+	},
+	language.Japanese: {
+		daysMessage:    {"%d日", "%d日"},
+		hoursMessage:   {"%d時間", "%d時間"},
+		minutesMessage: {"%d分", "%d分"},
+	},
+}
+
+var messageCatalog = newMessageCatalog()
+
+func newMessageCatalog() catalog.Catalog {
+	builder := catalog.NewBuilder(catalog.Fallback(supportedLanguages[0]))
+	for tag, messages := range translations {
+		for key, msg := range messages {
+			if err := builder.SetString(tag, key, msg); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	for tag, messages := range pluralTranslations {
+		for key, forms := range messages {
+			if err := builder.Set(tag, key, plural.Selectf(1, "%d", "=1", forms[0], "other", forms[1])); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	return builder
+}
+
+// parseLanguage returns the supported language of a BCP 47 language tag, an empty tag returns the default language.
+func parseLanguage(lang string) (language.Tag, error) {
+	if lang == "" {
+		return supportedLanguages[0], nil
+	}
+
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return language.Tag{}, ErrUnsupportedLanguage
+	}
+
+	_, index, confidence := language.NewMatcher(supportedLanguages).Match(tag)
+	if confidence < language.High {
+		return language.Tag{}, ErrUnsupportedLanguage
+	}
+
+	return supportedLanguages[index], nil
+}
+
+func supportedLanguageNames() []string {
+	names := make([]string, len(supportedLanguages))
+	for i, tag := range supportedLanguages {
+		names[i] = tag.String()
+	}
+
+	return names
+}
+
+// templateFuncs returns the functions available to comment templates, localized to the given language:
+//   - msg translates a message, formatting the arguments as by fmt.Sprintf
+//   - number formats a number with the language's separators
+//   - decimal formats a number with up to three fraction digits
+//   - percent formats a share between 0 and 1 as percentage with up to one fraction digit
+//   - duration formats a duration in days, hours and minutes
+func templateFuncs(tag language.Tag) template.FuncMap {
+	printer := message.NewPrinter(tag, message.Catalog(messageCatalog))
+
+	return template.FuncMap{
+		"msg": func(key string, args ...interface{}) string {
+			return printer.Sprintf(key, args...)
+		},
+		"number": func(n interface{}) string {
+			return printer.Sprintf("%v", n)
+		},
+		"decimal": func(f float64) string {
+			return printer.Sprint(number.Decimal(f, number.MaxFractionDigits(3)))
+		},
+		"percent": func(f float64) string {
+			return printer.Sprint(number.Percent(f, number.MaxFractionDigits(1)))
+		},
+		"duration": func(d time.Duration) string {
+			return formatDuration(printer, d)
+		},
+	}
+}
+
+// formatDuration formats a duration in days, hours and minutes, omitting units that are zero.
+// Durations below a minute are formatted as zero minutes.
+func formatDuration(printer *message.Printer, d time.Duration) string {
+	minutes := int(d / time.Minute)
+	days, hours, minutes := minutes/(24*60), minutes/60%24, minutes%60
+
+	var parts []string
+	if days > 0 {
+		parts = append(parts, printer.Sprintf(daysMessage, days))
+	}
+	if hours > 0 {
+		parts = append(parts, printer.Sprintf(hoursMessage, hours))
+	}
+	if minutes > 0 || len(parts) == 0 {
+		parts = append(parts, printer.Sprintf(minutesMessage, minutes))
+	}
+
+	formatted := parts[0]
+	for _, part := range parts[1:] {
+		formatted = printer.Sprintf("%s %s", formatted, part)
+	}
+
+	return formatted
+}
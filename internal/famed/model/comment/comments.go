@@ -1,8 +1,7 @@
 package comment
 
 import (
-	"strings"
-
+	model2 "github.com/morphysm/famed-github-backend/internal/famed/model"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 )
 
@@ -19,6 +18,21 @@ func (cs Comments) FindComment(botLogin string, commentType Type) (model.IssueCo
 	return model.IssueComment{}, false
 }
 
+// FindOverrides returns the reward overrides stored in the overrides comment posted by the user with a login equal to botLogin.
+func (cs Comments) FindOverrides(botLogin string) (model2.RewardOverrides, bool) {
+	overridesComment, found := cs.FindComment(botLogin, OverridesCommentType)
+	if !found {
+		return model2.RewardOverrides{}, false
+	}
+
+	identifier, ok := ParseIdentifier(overridesComment.Body)
+	if !ok || identifier.Overrides == nil {
+		return model2.RewardOverrides{}, false
+	}
+
+	return *identifier.Overrides, true
+}
+
 // VerifyComment return true if the given comment is of the given comment type and was authored by a user with the given login.
 func VerifyComment(comment model.IssueComment, login string, commentType Type) bool {
 	if comment.User.Login == login &&
@@ -28,24 +42,8 @@ func VerifyComment(comment model.IssueComment, login string, commentType Type) b
 	return false
 }
 
-// verifyCommentType checks if a given string is of a given commentType
-// TODO: add detection by matching meta json
+// verifyCommentType checks if a given string is of a given commentType by parsing its identifier.
 func verifyCommentType(body string, commentType Type) bool {
-	var substrs []string
-	switch commentType {
-	case EligibleCommentType:
-		substrs = append(substrs, EligibleCommentHeaderBeginning)
-		substrs = append(substrs, EligibleCommentHeaderLegacy)
-	case RewardCommentType:
-		substrs = append(substrs, RewardCommentTableHeader)
-		substrs = append(substrs, ErrorRewardCommentHeader)
-	}
-
-	for _, substr := range substrs {
-		if strings.Contains(body, substr) {
-			return true
-		}
-	}
-
-	return false
+	identifier, ok := ParseIdentifier(body)
+	return ok && identifier.Type == commentType
 }
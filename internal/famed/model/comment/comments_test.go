@@ -5,13 +5,19 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	model2 "github.com/morphysm/famed-github-backend/internal/famed/model"
 	"github.com/morphysm/famed-github-backend/internal/famed/model/comment"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 )
 
 const (
 	eligibleCommentLegacy = "🤖 Assignees for Issue **Test 3 #5** are now eligible to Get Famed.\n\n✅ Add assignees to track contribution times of the issue \U0001F9B8‍♀️\U0001F9B9️\n✅ Add a single severity (CVSS) label to compute the score 🏷️️\n✅ Link a PR when closing the issue ♻️ \U0001F9B8‍♀️\U0001F9B9\n\nHappy hacking! \U0001F9BE💙❤️️"
-	eligibleCommentV1     = "<!--{\"type\":\"eligible\",\"version\":\"TODO\"}-->\n🤖 Assignees for issue **Test 3 #5** are now eligible to Get Famed.\n\n✅ Add assignees to track contribution times of the issue \U0001F9B8‍♀️\U0001F9B9️\n✅ Add a single severity (CVSS) label to compute the score 🏷️️\n\nHappy hacking! \U0001F9BE💙❤️"
+	eligibleCommentV1     = "<!--{\"type\":\"eligible\",\"version\":\"0.0.0\"}-->\n🤖 Assignees for issue **Test 3 #5** are now eligible to Get Famed.\n\n✅ Add assignees to track contribution times of the issue \U0001F9B8‍♀️\U0001F9B9️\n✅ Add a single severity (CVSS) label to compute the score 🏷️️\n\nHappy hacking! \U0001F9BE💙❤️"
+
+	rewardCommentLegacy   = "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n@test - you Got Famed! 💎 Check out your new score here: https://www.famed.morphysm.com/teams/test/test\n| Contributor | Time | Reward |\n| ----------- | ----------- | ----------- |\n|test|24h0m0s|975 POINTS|"
+	rewardCommentV1       = "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n@test - you Got Famed! 💎 Check out your new score here: https://www.famed.morphysm.com/teams/test/test\n| Contributor | Time | Reward | Status |\n| ----------- | ----------- | ----------- | ----------- |\n|test|24h0m0s|975 POINTS|approved|"
+	rewardCommentReopened = "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n### Issue reopened — reward pending\nThe issue has been reopened. The reward will be recalculated once the issue is closed again."
+	rewardCommentCustom   = "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\nThank you @test! 975 POINTS"
 
 	contributorComment = "This is a contributor comment"
 )
@@ -28,12 +34,10 @@ func TestFindComment(t *testing.T) {
 		ExpectedFound bool
 	}{
 		{
-			Name:          "Single Legacy Eligible Comment",
-			CommentType:   comment.EligibleCommentType,
-			BotLogin:      "test[bot]",
-			Comments:      []model.IssueComment{{User: model.User{Login: "test[bot]"}, Body: eligibleCommentLegacy}},
-			ExpectedFind:  model.IssueComment{User: model.User{Login: "test[bot]"}, Body: eligibleCommentLegacy},
-			ExpectedFound: true,
+			Name:        "Single Legacy Eligible Comment",
+			CommentType: comment.EligibleCommentType,
+			BotLogin:    "test[bot]",
+			Comments:    []model.IssueComment{{User: model.User{Login: "test[bot]"}, Body: eligibleCommentLegacy}},
 		},
 		{
 			Name:          "Single V1 Eligible Comment",
@@ -51,8 +55,6 @@ func TestFindComment(t *testing.T) {
 				{User: model.User{Login: "test[bot]"}, Body: eligibleCommentLegacy},
 				{User: model.User{Login: "test[bot]"}, Body: eligibleCommentLegacy},
 			},
-			ExpectedFind:  model.IssueComment{User: model.User{Login: "test[bot]"}, Body: eligibleCommentLegacy},
-			ExpectedFound: true,
 		},
 		{
 			Name:        "Multiple V1 Eligible Comments",
@@ -73,7 +75,7 @@ func TestFindComment(t *testing.T) {
 				{User: model.User{Login: "test[bot]"}, Body: eligibleCommentLegacy},
 				{User: model.User{Login: "test[bot]"}, Body: eligibleCommentV1},
 			},
-			ExpectedFind:  model.IssueComment{User: model.User{Login: "test[bot]"}, Body: eligibleCommentLegacy},
+			ExpectedFind:  model.IssueComment{User: model.User{Login: "test[bot]"}, Body: eligibleCommentV1},
 			ExpectedFound: true,
 		},
 		{
@@ -89,6 +91,44 @@ func TestFindComment(t *testing.T) {
 			ExpectedFind:  model.IssueComment{User: model.User{Login: "test[bot]"}, Body: eligibleCommentV1},
 			ExpectedFound: true,
 		},
+		{
+			Name:          "Single Legacy Reward Comment",
+			CommentType:   comment.RewardCommentType,
+			BotLogin:      "test[bot]",
+			Comments:      []model.IssueComment{{User: model.User{Login: "test[bot]"}, Body: rewardCommentLegacy}},
+			ExpectedFind:  model.IssueComment{User: model.User{Login: "test[bot]"}, Body: rewardCommentLegacy},
+			ExpectedFound: true,
+		},
+		{
+			Name:          "Single V1 Reward Comment",
+			CommentType:   comment.RewardCommentType,
+			BotLogin:      "test[bot]",
+			Comments:      []model.IssueComment{{User: model.User{Login: "test[bot]"}, Body: rewardCommentV1}},
+			ExpectedFind:  model.IssueComment{User: model.User{Login: "test[bot]"}, Body: rewardCommentV1},
+			ExpectedFound: true,
+		},
+		{
+			Name:          "Single Reopened Reward Comment",
+			CommentType:   comment.RewardCommentType,
+			BotLogin:      "test[bot]",
+			Comments:      []model.IssueComment{{User: model.User{Login: "test[bot]"}, Body: rewardCommentReopened}},
+			ExpectedFind:  model.IssueComment{User: model.User{Login: "test[bot]"}, Body: rewardCommentReopened},
+			ExpectedFound: true,
+		},
+		{
+			Name:          "Single Custom Template Reward Comment",
+			CommentType:   comment.RewardCommentType,
+			BotLogin:      "test[bot]",
+			Comments:      []model.IssueComment{{User: model.User{Login: "test[bot]"}, Body: rewardCommentCustom}},
+			ExpectedFind:  model.IssueComment{User: model.User{Login: "test[bot]"}, Body: rewardCommentCustom},
+			ExpectedFound: true,
+		},
+		{
+			Name:        "Custom Template Reward Comment - Eligible Type",
+			CommentType: comment.EligibleCommentType,
+			BotLogin:    "test[bot]",
+			Comments:    []model.IssueComment{{User: model.User{Login: "test[bot]"}, Body: rewardCommentCustom}},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -111,3 +151,36 @@ func TestFindComment(t *testing.T) {
 		})
 	}
 }
+
+func TestFindOverrides(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	severity := model.High
+	overrides := model2.RewardOverrides{
+		Split:    map[string]float64{"alice": 60, "bob": 40},
+		Exclude:  []string{"bot"},
+		Severity: &severity,
+	}
+	body, err := comment.NewOverridesComment(overrides).String()
+	assert.NoError(t, err)
+	comments := []model.IssueComment{
+		{User: model.User{Login: "contributor"}, Body: contributorComment},
+		{User: model.User{Login: "test[bot]"}, Body: body},
+	}
+
+	// WHEN
+	foundOverrides, found := comment.Comments(comments).FindOverrides("test[bot]")
+
+	// THEN
+	assert.True(t, found)
+	assert.Equal(t, overrides, foundOverrides)
+	assert.Equal(t, "<!--{\"type\":\"overrides\",\"version\":\"0.0.0\",\"overrides\":{\"split\":{\"alice\":60,\"bob\":40},\"exclude\":[\"bot\"],\"severity\":\"high\"}}-->\n"+
+		"### Famed reward overrides\n- Split: @alice 60, @bob 40\n- Excluded: @bot\n- Severity: high", body)
+
+	// WHEN
+	_, found = comment.Comments(comments).FindOverrides("other[bot]")
+
+	// THEN
+	assert.False(t, found)
+}
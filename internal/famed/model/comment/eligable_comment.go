@@ -1,9 +1,7 @@
 package comment
 
 import (
-	"fmt"
-	"strings"
-
+	model2 "github.com/morphysm/famed-github-backend/internal/famed/model"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 )
 
@@ -12,87 +10,81 @@ const (
 	EligibleCommentHeaderBeginning = "🤖 Assignees for issue"
 )
 
-type EligibleComment struct {
-	identifier    Identifier
-	headline      string
-	assigneeCheck string
-	severityCheck string
-	footer        string
+// eligibleRuleItems are the checklist items of the eligibility rules, the messages are translated by the templates.
+var eligibleRuleItems = map[model2.EligibilityRuleName]struct{ message, emoji string }{
+	model2.AssigneeRule:          {"Add assignees to track contribution times of the issue", "🦸‍♀️🦹️"},
+	model2.SeverityRule:          {"Add a single severity (CVSS) label to compute the score", "🏷️️"},
+	model2.PullRequestRule:       {"Link a pull request fixing the issue", "🔗"},
+	model2.PullRequestMergedRule: {"Merge the pull request fixing the issue", "🔀"},
+	model2.CVSSVectorRule:        {"Add a CVSS vector to the issue description or as label", "🧮"},
+	model2.MilestoneRule:         {"Add the issue to a milestone", "🏁"},
 }
 
-// NewEligibleComment generate an issue eligible comment.
-func NewEligibleComment(issue model.Issue, pullRequest *string) EligibleComment {
-	eligibleComment := EligibleComment{}
-	// TODO add version information.
-	eligibleComment.identifier = NewIdentifier(EligibleCommentType, "TODO")
-
-	eligibleComment.headline = fmt.Sprintf("%s **%s #%d** are now eligible to Get Famed.\n", EligibleCommentHeaderBeginning, issue.Title, issue.Number)
+// EligibleCommentData is the data eligible comment templates are rendered with.
+type EligibleCommentData struct {
+	Title  string
+	Number int
+	// Rules are the checklist items of the eligibility rules enabled for the repository
+	Rules []EligibleCommentRule
+	// HasAssignee is true if an assignee is assigned
+	HasAssignee bool
+	// HasSingleSeverity is true if a single valid severity label is assigned
+	HasSingleSeverity bool
+	// Severity is the severity of the issue, empty if the issue has not a single severity label
+	Severity model.IssueSeverity
+	// HasPullRequest is true if a pull request is linked
+	HasPullRequest bool
+}
 
-	// Check that an assignee is assigned
-	eligibleComment.assigneeCheck = fmt.Sprintf("%s️", assigneeCheck(issue.Assignees))
+// EligibleCommentRule is the checklist item of an eligibility rule.
+type EligibleCommentRule struct {
+	Name model2.EligibilityRuleName
+	// Message describes in English how to meet the rule, it is translated by the msg template function
+	Message string
+	Emoji   string
+	// Met is true if the issue meets the rule
+	Met bool
+}
 
-	// Check that a valid severity label is assigned
-	eligibleComment.severityCheck = fmt.Sprintf("%s️", severityCheck(issue.Severities))
+type EligibleComment struct {
+	identifier Identifier
+	templates  Templates
+	data       EligibleCommentData
+}
 
-	// Check that a PR is assigned
-	// TODO create rule
-	// comment = fmt.Sprintf("%s\n%s", comment, prComment(pullRequest))
+// NewEligibleComment generate an issue eligible comment listing whether the issue and its linked pull request meet the eligibility rules.
+func NewEligibleComment(templates Templates, rules model2.EligibilityRules, issue model.Issue, pullRequest *model.PullRequest) EligibleComment {
+	eligibleComment := EligibleComment{templates: templates}
+	eligibleComment.identifier = NewIdentifier(EligibleCommentType)
+
+	severity, err := issue.Severity()
+	eligibleComment.data = EligibleCommentData{
+		Title:             issue.Title,
+		Number:            issue.Number,
+		Rules:             make([]EligibleCommentRule, 0, len(rules)),
+		HasAssignee:       len(issue.Assignees) > 0,
+		HasSingleSeverity: err == nil,
+		Severity:          severity,
+		HasPullRequest:    pullRequest != nil,
+	}
 
-	// Final note
-	eligibleComment.footer = fmt.Sprintf("Happy hacking! 🦾💙❤️️")
+	for _, rule := range rules {
+		item := eligibleRuleItems[rule.Name()]
+		eligibleComment.data.Rules = append(eligibleComment.data.Rules, EligibleCommentRule{
+			Name:    rule.Name(),
+			Message: item.message,
+			Emoji:   item.emoji,
+			Met:     rule.Check(issue, pullRequest) == nil,
+		})
+	}
 
 	return eligibleComment
 }
 
 func (c EligibleComment) String() (string, error) {
-	var sb strings.Builder
-
-	identifier, err := c.identifier.String()
-	if err != nil {
-		return "", err
-	}
-
-	sb.WriteString(identifier)
-	sb.WriteString("\n")
-	sb.WriteString(c.headline)
-	sb.WriteString("\n")
-	sb.WriteString(c.assigneeCheck)
-	sb.WriteString("\n")
-	sb.WriteString(c.severityCheck)
-	sb.WriteString("\n\n")
-	sb.WriteString(c.footer)
-
-	return sb.String(), nil
+	return c.templates.render(c.identifier, c.templates.eligibleTemplate(), c.data)
 }
 
 func (c EligibleComment) Type() Type {
 	return c.identifier.Type
 }
-
-func assigneeCheck(assignees []model.User) string {
-	const msg = " Add assignees to track contribution times of the issue \U0001F9B8\u200d♀️\U0001F9B9"
-	if len(assignees) > 0 {
-		return "✅" + msg
-	}
-
-	return "❌" + msg
-}
-
-func severityCheck(severities []model.IssueSeverity) string {
-	const msg = " Add a single severity (CVSS) label to compute the score 🏷️"
-	if len(severities) == 1 {
-		return "✅" + msg
-	}
-
-	return "❌" + msg
-}
-
-// TODO commented out for DevConnect
-//func prComment(pullRequest *github.PullRequest) string {
-//	const msg = " Link a PR when closing the issue ♻️ \U0001F9B8‍♀️\U0001F9B9"
-//	if pullRequest != nil {
-//		return "✅" + msg
-//	}
-//
-//	return "❌" + msg
-//}
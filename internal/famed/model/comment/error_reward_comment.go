@@ -1,68 +1,77 @@
 package comment
 
 import (
-	"fmt"
-	"strings"
-
 	model2 "github.com/morphysm/famed-github-backend/internal/famed/model"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 )
 
 const ErrorRewardCommentHeader = "### Famed could not generate a reward suggestion."
 
+// The reasons are the English messages of the reasons, they are translated by the templates.
+const (
+	reasonMissingPullRequest     = "The issue is missing a pull request."
+	reasonMissingAssignee        = "The issue is missing an assignee."
+	reasonMissingSeverityLabel   = "The issue is missing a severity label."
+	reasonMultipleSeverityLabels = "The issue has more than one severity label."
+	reasonPullRequestNotMerged   = "The pull request of the issue is not merged."
+	reasonMissingCVSSVector      = "The issue is missing a CVSS vector."
+	reasonMissingMilestone       = "The issue is missing a milestone."
+	reasonNoContributors         = "The data provided by GitHub is not sufficient to generate a reward suggestion." +
+		"\nThis might be due to an assignment after the issue has been closed. Please assign assignees in the open state."
+	reasonUnknown = "Unknown."
+)
+
+// ErrorRewardCommentData is the data error reward comment templates are rendered with.
+type ErrorRewardCommentData struct {
+	// Reason describes in English why no reward could be suggested, it is translated by the msg template function
+	Reason string
+}
+
 type ErrorRewardComment struct {
 	identifier Identifier
-	headline   string
-	error      string
+	templates  Templates
+	data       ErrorRewardCommentData
 }
 
 // NewErrorRewardComment return a ErrorRewardComment.
-func NewErrorRewardComment(err error) ErrorRewardComment {
-	rewardCommentError := ErrorRewardComment{}
-	rewardCommentError.identifier = NewIdentifier(RewardCommentType, "TODO")
-
-	rewardCommentError.headline = ErrorRewardCommentHeader
-	rewardCommentError.error = "Reason: "
+func NewErrorRewardComment(templates Templates, err error) ErrorRewardComment {
+	rewardCommentError := ErrorRewardComment{templates: templates}
+	rewardCommentError.identifier = NewIdentifier(RewardCommentType)
 
 	switch err {
 	case model2.ErrIssueMissingPullRequest:
-		rewardCommentError.error = fmt.Sprintf("%sThe issue is missing a pull request.", rewardCommentError.error)
+		rewardCommentError.data.Reason = reasonMissingPullRequest
 
 	case model2.ErrIssueMissingAssignee:
-		rewardCommentError.error = fmt.Sprintf("%sThe issue is missing an assignee.", rewardCommentError.error)
+		rewardCommentError.data.Reason = reasonMissingAssignee
 
 	case model.ErrIssueMissingSeverityLabel:
-		rewardCommentError.error = fmt.Sprintf("%sThe issue is missing a severity label.", rewardCommentError.error)
+		rewardCommentError.data.Reason = reasonMissingSeverityLabel
 
 	case model.ErrIssueMultipleSeverityLabels:
-		rewardCommentError.error = fmt.Sprintf("%sThe issue has more than one severity label.", rewardCommentError.error)
+		rewardCommentError.data.Reason = reasonMultipleSeverityLabels
+
+	case model2.ErrIssuePullRequestNotMerged:
+		rewardCommentError.data.Reason = reasonPullRequestNotMerged
+
+	case model2.ErrIssueMissingCVSSVector:
+		rewardCommentError.data.Reason = reasonMissingCVSSVector
+
+	case model2.ErrIssueMissingMilestone:
+		rewardCommentError.data.Reason = reasonMissingMilestone
 
 	case ErrNoContributors:
-		rewardCommentError.error = fmt.Sprintf("%sThe data provided by GitHub is not sufficient to generate a reward suggestion.", rewardCommentError.error)
-		rewardCommentError.error = fmt.Sprintf("%s\nThis might be due to an assignment after the issue has been closed. Please assign assignees in the open state.", rewardCommentError.error)
+		rewardCommentError.data.Reason = reasonNoContributors
 
 	default:
-		rewardCommentError.error = fmt.Sprintf("%s Unknown.", rewardCommentError.error)
+		rewardCommentError.data.Reason = reasonUnknown
 	}
 
 	return rewardCommentError
 }
 
 func (c ErrorRewardComment) String() (string, error) {
-	var sb strings.Builder
-
-	identifier, err := c.identifier.String()
-	if err != nil {
-		return "", err
-	}
-
-	sb.WriteString(identifier)
-	sb.WriteString("\n")
-	sb.WriteString(c.headline)
-	sb.WriteString("\n")
-	sb.WriteString(c.error)
-
-	return sb.String(), nil
+	return c.templates.render(c.identifier, c.templates.errorRewardTemplate(), c.data)
 }
 
 func (c ErrorRewardComment) Type() Type {
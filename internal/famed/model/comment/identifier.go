@@ -3,22 +3,75 @@ package comment
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+
+	"github.com/morphysm/famed-github-backend/internal/devtoolkit/buildinfo"
+	model2 "github.com/morphysm/famed-github-backend/internal/famed/model"
 )
 
 type Type string
 
 const (
-	RewardCommentType   Type = "reward"
-	EligibleCommentType Type = "eligible"
+	RewardCommentType    Type = "reward"
+	EligibleCommentType  Type = "eligible"
+	OverridesCommentType Type = "overrides"
+)
+
+const (
+	identifierPrefix = "<!--"
+	identifierSuffix = "-->"
 )
 
+// Identifier is hidden as JSON in an HTML comment at the beginning of each bot comment.
+// It identifies the type of the comment and the version of the build that rendered it.
 type Identifier struct {
 	Type    Type   `json:"type"`
 	Version string `json:"version"`
+	// Overrides holds the reward overrides of overrides comments
+	Overrides *model2.RewardOverrides `json:"overrides,omitempty"`
 }
 
-func NewIdentifier(commentType Type, version string) Identifier {
-	return Identifier{Type: commentType, Version: version}
+// NewIdentifier returns the identifier of a comment of the given type rendered by the current build.
+func NewIdentifier(commentType Type) Identifier {
+	return Identifier{Type: commentType, Version: buildinfo.Version()}
+}
+
+// ParseIdentifier returns the identifier hidden at the beginning of a comment body and false if none is found.
+func ParseIdentifier(body string) (Identifier, bool) {
+	if !strings.HasPrefix(body, identifierPrefix) {
+		return Identifier{}, false
+	}
+
+	end := strings.Index(body, identifierSuffix)
+	if end == -1 {
+		return Identifier{}, false
+	}
+
+	var identifier Identifier
+	if err := json.Unmarshal([]byte(body[len(identifierPrefix):end]), &identifier); err != nil {
+		return Identifier{}, false
+	}
+
+	return identifier, true
+}
+
+// Outdated returns true if the comment was rendered by an older version than the current build.
+// Versions that are not semantic versions, like the "TODO" placeholder of early comments, are outdated.
+// Comments of newer versions are not outdated to not rewrite comments back and forth while different versions are deployed.
+func (i Identifier) Outdated() bool {
+	version, err := semver.NewVersion(i.Version)
+	if err != nil {
+		return true
+	}
+
+	current, err := semver.NewVersion(buildinfo.Version())
+	if err != nil {
+		return false
+	}
+
+	return version.LessThan(current)
 }
 
 func (i Identifier) String() (string, error) {
@@ -27,5 +80,5 @@ func (i Identifier) String() (string, error) {
 		return "", err
 	}
 
-	return fmt.Sprintf("<!--%s-->", string(b)), err
+	return fmt.Sprintf("%s%s%s", identifierPrefix, string(b), identifierSuffix), err
 }
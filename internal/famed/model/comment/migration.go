@@ -0,0 +1,34 @@
+package comment
+
+import (
+	"strings"
+)
+
+// OutdatedType returns the type of a comment rendered by an older version than the current build and true if the comment has to be migrated.
+// Comments posted before comments were identified are recognized by their headers.
+func OutdatedType(body string) (Type, bool) {
+	if identifier, ok := ParseIdentifier(body); ok {
+		return identifier.Type, identifier.Outdated()
+	}
+
+	return legacyType(body)
+}
+
+// legacyType returns the type of a comment without identifier by its header and false if it is no bot comment.
+// Overrides comments always had an identifier.
+func legacyType(body string) (Type, bool) {
+	legacyHeaders := map[Type][]string{
+		EligibleCommentType: {EligibleCommentHeaderBeginning, EligibleCommentHeaderLegacy},
+		RewardCommentType:   {RewardCommentTableHeader, RewardCommentTableHeaderLegacy, ErrorRewardCommentHeader, ReopenedRewardCommentHeader},
+	}
+
+	for commentType, headers := range legacyHeaders {
+		for _, header := range headers {
+			if strings.Contains(body, header) {
+				return commentType, true
+			}
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,85 @@
+package comment_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/internal/famed/model/comment"
+)
+
+func TestOutdatedType(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name             string
+		Body             string
+		ExpectedType     comment.Type
+		ExpectedOutdated bool
+	}{
+		{
+			Name:             "Current Eligible Comment",
+			Body:             eligibleCommentV1,
+			ExpectedType:     comment.EligibleCommentType,
+			ExpectedOutdated: false,
+		},
+		{
+			Name:             "Placeholder Version Reward Comment",
+			Body:             "<!--{\"type\":\"reward\",\"version\":\"TODO\"}-->\nThank you @test! 975 POINTS",
+			ExpectedType:     comment.RewardCommentType,
+			ExpectedOutdated: true,
+		},
+		{
+			Name:             "Older Version Overrides Comment",
+			Body:             "<!--{\"type\":\"overrides\",\"version\":\"0.0.0-alpha\",\"overrides\":{}}-->\n### Famed reward overrides",
+			ExpectedType:     comment.OverridesCommentType,
+			ExpectedOutdated: true,
+		},
+		{
+			Name:             "Newer Version Reward Comment",
+			Body:             "<!--{\"type\":\"reward\",\"version\":\"1.0.0\"}-->\nThank you @test! 975 POINTS",
+			ExpectedType:     comment.RewardCommentType,
+			ExpectedOutdated: false,
+		},
+		{
+			Name:             "Legacy Eligible Comment",
+			Body:             eligibleCommentLegacy,
+			ExpectedType:     comment.EligibleCommentType,
+			ExpectedOutdated: true,
+		},
+		{
+			Name:             "Legacy Reward Comment",
+			Body:             "@test - you Got Famed! 💎 Check out your new score here: https://www.famed.morphysm.com/teams/test/test\n| Contributor | Time | Reward |\n| ----------- | ----------- | ----------- |\n|test|24h0m0s|975 POINTS|",
+			ExpectedType:     comment.RewardCommentType,
+			ExpectedOutdated: true,
+		},
+		{
+			Name:             "Legacy Error Reward Comment",
+			Body:             "### Famed could not generate a reward suggestion.\nReason: Unknown.",
+			ExpectedType:     comment.RewardCommentType,
+			ExpectedOutdated: true,
+		},
+		{
+			Name:             "Contributor Comment",
+			Body:             contributorComment,
+			ExpectedOutdated: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// GIVEN
+
+			// WHEN
+			commentType, outdated := comment.OutdatedType(testCase.Body)
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedOutdated, outdated)
+			if testCase.ExpectedOutdated {
+				assert.Equal(t, testCase.ExpectedType, commentType)
+			}
+		})
+	}
+}
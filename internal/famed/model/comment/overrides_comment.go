@@ -0,0 +1,79 @@
+package comment
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	model2 "github.com/morphysm/famed-github-backend/internal/famed/model"
+)
+
+const OverridesCommentHeader = "### Famed reward overrides"
+
+// OverridesComment lists the reward overrides set by maintainers through commands.
+// The overrides are stored in the comment's hidden identifier to be read back on the next command.
+type OverridesComment struct {
+	identifier Identifier
+	overrides  model2.RewardOverrides
+}
+
+// NewOverridesComment returns an OverridesComment.
+func NewOverridesComment(overrides model2.RewardOverrides) OverridesComment {
+	identifier := NewIdentifier(OverridesCommentType)
+	identifier.Overrides = &overrides
+
+	return OverridesComment{
+		identifier: identifier,
+		overrides:  overrides,
+	}
+}
+
+func (c OverridesComment) String() (string, error) {
+	var sb strings.Builder
+
+	identifier, err := c.identifier.String()
+	if err != nil {
+		return "", err
+	}
+
+	sb.WriteString(identifier)
+	sb.WriteString("\n")
+	sb.WriteString(OverridesCommentHeader)
+
+	if c.overrides.IsZero() {
+		sb.WriteString("\nNo overrides are set, the reward is calculated from the issue.")
+		return sb.String(), nil
+	}
+
+	if len(c.overrides.Split) > 0 {
+		logins := make([]string, 0, len(c.overrides.Split))
+		for login := range c.overrides.Split {
+			logins = append(logins, login)
+		}
+		sort.Strings(logins)
+
+		shares := make([]string, len(logins))
+		for i, login := range logins {
+			shares[i] = fmt.Sprintf("@%s %g", login, c.overrides.Split[login])
+		}
+		sb.WriteString(fmt.Sprintf("\n- Split: %s", strings.Join(shares, ", ")))
+	}
+
+	if len(c.overrides.Exclude) > 0 {
+		excluded := make([]string, len(c.overrides.Exclude))
+		for i, login := range c.overrides.Exclude {
+			excluded[i] = "@" + login
+		}
+		sb.WriteString(fmt.Sprintf("\n- Excluded: %s", strings.Join(excluded, ", ")))
+	}
+
+	if c.overrides.Severity != nil {
+		sb.WriteString(fmt.Sprintf("\n- Severity: %s", *c.overrides.Severity))
+	}
+
+	return sb.String(), nil
+}
+
+func (c OverridesComment) Type() Type {
+	return c.identifier.Type
+}
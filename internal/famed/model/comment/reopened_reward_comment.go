@@ -0,0 +1,40 @@
+package comment
+
+import (
+	"strings"
+)
+
+const ReopenedRewardCommentHeader = "### Issue reopened — reward pending"
+
+// ReopenedRewardComment replaces the reward comment of a reopened issue until the issue is closed again.
+type ReopenedRewardComment struct {
+	identifier Identifier
+}
+
+// NewReopenedRewardComment returns a ReopenedRewardComment.
+func NewReopenedRewardComment() ReopenedRewardComment {
+	return ReopenedRewardComment{
+		identifier: NewIdentifier(RewardCommentType),
+	}
+}
+
+func (c ReopenedRewardComment) String() (string, error) {
+	var sb strings.Builder
+
+	identifier, err := c.identifier.String()
+	if err != nil {
+		return "", err
+	}
+
+	sb.WriteString(identifier)
+	sb.WriteString("\n")
+	sb.WriteString(ReopenedRewardCommentHeader)
+	sb.WriteString("\n")
+	sb.WriteString("The issue has been reopened. The reward will be recalculated once the issue is closed again.")
+
+	return sb.String(), nil
+}
+
+func (c ReopenedRewardComment) Type() Type {
+	return c.identifier.Type
+}
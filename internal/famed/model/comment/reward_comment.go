@@ -2,57 +2,81 @@ package comment
 
 import (
 	"errors"
-	"fmt"
-	"strings"
+	"time"
 
 	model2 "github.com/morphysm/famed-github-backend/internal/famed/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 )
 
 var ErrNoContributors = errors.New("GitHub data incomplete")
 
-const RewardCommentTableHeader = "| Contributor | Time | Reward |\n| ----------- | ----------- | ----------- |"
+const (
+	// RewardCommentTableHeader is the table header of reward comments posted before work shares were shown.
+	RewardCommentTableHeader = "| Contributor | Time | Reward | Status |\n| ----------- | ----------- | ----------- | ----------- |"
+	// RewardCommentTableHeaderLegacy is the table header of reward comments posted before payout statuses were shown.
+	RewardCommentTableHeaderLegacy = "| Contributor | Time | Reward |\n| ----------- | ----------- | ----------- |"
+)
+
+// RewardCommentData is the data reward comment templates are rendered with.
+type RewardCommentData struct {
+	Contributors []RewardCommentContributor
+	// Severity is the severity the rewards were computed with
+	Severity model.IssueSeverity
+	Currency string
+	// BoardURL is the URL of the repository's board
+	BoardURL string
+	// Breakdown explains how the reward of the issue was derived
+	Breakdown model2.RewardBreakdown
+}
+
+// RewardCommentContributor is the reward of a contributor as shown in reward comments.
+type RewardCommentContributor struct {
+	Login    string
+	WorkTime time.Duration
+	// Share is the share of the contributor in the work logged on the issue between 0 and 1
+	Share  float64
+	Reward int
+	Status model2.PayoutStatus
+}
 
 type RewardComment struct {
 	identifier Identifier
-	headline   string
-	table      string
+	templates  Templates
+	data       RewardCommentData
 }
 
-// NewRewardComment return a RewardComment.
-func NewRewardComment(contributors []*model2.Contributor, currency string, owner string, repoName string) RewardComment {
-	rewardComment := RewardComment{}
-	// TODO pass version information
-	rewardComment.identifier = NewIdentifier(RewardCommentType, "TODO")
+// NewRewardComment return a RewardComment explaining the reward with its breakdown.
+// The payout status of each contributor is taken from statuses, contributors without a status are shown as suggested.
+func NewRewardComment(templates Templates, contributors []*model2.Contributor, breakdown model2.RewardBreakdown, currency string, boardURL string, statuses map[string]model2.PayoutStatus) RewardComment {
+	rewardComment := RewardComment{templates: templates}
+	rewardComment.identifier = NewIdentifier(RewardCommentType)
 
-	for _, contributor := range contributors {
-		rewardComment.headline = fmt.Sprintf("%s@%s ", rewardComment.headline, contributor.Login)
+	rewardComment.data = RewardCommentData{
+		Contributors: make([]RewardCommentContributor, 0, len(contributors)),
+		Severity:     breakdown.Severity,
+		Currency:     currency,
+		BoardURL:     boardURL,
+		Breakdown:    breakdown,
 	}
-
-	rewardComment.headline = fmt.Sprintf("%s- you Got Famed! 💎 Check out your new score here: https://www.famed.morphysm.com/teams/%s/%s", rewardComment.headline, owner, repoName)
-	rewardComment.table = RewardCommentTableHeader
-
 	for _, contributor := range contributors {
-		rewardComment.table = fmt.Sprintf("%s\n|%s|%s|%d %s|", rewardComment.table, contributor.Login, contributor.TotalWorkTime, int(contributor.RewardSum), currency)
+		status, ok := statuses[contributor.Login]
+		if !ok {
+			status = model2.Suggested
+		}
+		rewardComment.data.Contributors = append(rewardComment.data.Contributors, RewardCommentContributor{
+			Login:    contributor.Login,
+			WorkTime: contributor.TotalWorkTime,
+			Share:    contributor.WorkShare,
+			Reward:   int(contributor.RewardSum),
+			Status:   status,
+		})
 	}
 
 	return rewardComment
 }
 
 func (c RewardComment) String() (string, error) {
-	var sb strings.Builder
-
-	identifier, err := c.identifier.String()
-	if err != nil {
-		return "", err
-	}
-
-	sb.WriteString(identifier)
-	sb.WriteString("\n")
-	sb.WriteString(c.headline)
-	sb.WriteString("\n")
-	sb.WriteString(c.table)
-
-	return sb.String(), nil
+	return c.templates.render(c.identifier, c.templates.rewardTemplate(), c.data)
 }
 
 func (c RewardComment) Type() Type {
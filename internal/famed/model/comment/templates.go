@@ -0,0 +1,147 @@
+package comment
+
+import (
+	"embed"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"golang.org/x/text/language"
+
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+//go:embed templates/*.md.tmpl
+var defaultTemplateFiles embed.FS
+
+const (
+	eligibleTemplateName    = "eligible"
+	rewardTemplateName      = "reward"
+	errorRewardTemplateName = "errorReward"
+)
+
+var defaultTemplates = Templates{
+	eligible:    mustParseDefaultTemplate(eligibleTemplateName, "templates/eligible.md.tmpl"),
+	reward:      mustParseDefaultTemplate(rewardTemplateName, "templates/reward.md.tmpl"),
+	errorReward: mustParseDefaultTemplate(errorRewardTemplateName, "templates/error_reward.md.tmpl"),
+	language:    supportedLanguages[0],
+}
+
+// Templates holds the templates the eligible, reward and error reward comments are rendered with and the language they are rendered in.
+// Templates that are not set are rendered with the embedded default templates in English.
+type Templates struct {
+	eligible    *template.Template
+	reward      *template.Template
+	errorReward *template.Template
+	language    language.Tag
+}
+
+// DefaultTemplates returns the embedded default templates rendered in English.
+func DefaultTemplates() Templates {
+	return defaultTemplates
+}
+
+// NewTemplates parses the set comment templates to be rendered in the given language,
+// templates that are not set fall back to the default templates and an empty language to English.
+// Templates are verified by executing them with empty data, a template referencing unknown fields returns an error.
+func NewTemplates(commentTemplates model.CommentTemplates, lang string) (Templates, error) {
+	templates := defaultTemplates
+
+	var err error
+	if templates.language, err = parseLanguage(lang); err != nil {
+		return Templates{}, err
+	}
+
+	if commentTemplates.Eligible != "" {
+		if templates.eligible, err = parseTemplate(eligibleTemplateName, commentTemplates.Eligible, EligibleCommentData{}); err != nil {
+			return Templates{}, fmt.Errorf("invalid eligible comment template: %w", err)
+		}
+	}
+
+	if commentTemplates.Reward != "" {
+		if templates.reward, err = parseTemplate(rewardTemplateName, commentTemplates.Reward, RewardCommentData{}); err != nil {
+			return Templates{}, fmt.Errorf("invalid reward comment template: %w", err)
+		}
+	}
+
+	if commentTemplates.ErrorReward != "" {
+		if templates.errorReward, err = parseTemplate(errorRewardTemplateName, commentTemplates.ErrorReward, ErrorRewardCommentData{}); err != nil {
+			return Templates{}, fmt.Errorf("invalid error reward comment template: %w", err)
+		}
+	}
+
+	return templates, nil
+}
+
+func parseTemplate(name string, text string, data interface{}) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(templateFuncs(supportedLanguages[0])).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tmpl.Execute(io.Discard, data); err != nil {
+		return nil, err
+	}
+
+	return tmpl, nil
+}
+
+func (t Templates) eligibleTemplate() *template.Template {
+	if t.eligible == nil {
+		return defaultTemplates.eligible
+	}
+
+	return t.eligible
+}
+
+func (t Templates) rewardTemplate() *template.Template {
+	if t.reward == nil {
+		return defaultTemplates.reward
+	}
+
+	return t.reward
+}
+
+func (t Templates) errorRewardTemplate() *template.Template {
+	if t.errorReward == nil {
+		return defaultTemplates.errorReward
+	}
+
+	return t.errorReward
+}
+
+func mustParseDefaultTemplate(name string, path string) *template.Template {
+	content, err := defaultTemplateFiles.ReadFile(path)
+	if err != nil {
+		panic(err)
+	}
+
+	return template.Must(template.New(name).Funcs(templateFuncs(supportedLanguages[0])).Parse(string(content)))
+}
+
+// render returns the comment body consisting of the identifier followed by the template executed with data in the language of the templates.
+// Trailing newlines of the executed template are removed.
+func (t Templates) render(identifier Identifier, tmpl *template.Template, data interface{}) (string, error) {
+	var sb strings.Builder
+
+	identifierString, err := identifier.String()
+	if err != nil {
+		return "", err
+	}
+
+	// The functions are bound to the language on a copy to not affect templates executed concurrently
+	localized, err := tmpl.Clone()
+	if err != nil {
+		return "", err
+	}
+	localized.Funcs(templateFuncs(t.language))
+
+	sb.WriteString(identifierString)
+	sb.WriteString("\n")
+	if err := localized.Execute(&sb, data); err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(sb.String(), "\n"), nil
+}
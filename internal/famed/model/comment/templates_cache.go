@@ -0,0 +1,60 @@
+package comment
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+// TemplatesCache caches the parsed templates of each repository together with the comment templates and language they were parsed from,
+// so that the templates of a repository are only parsed once per config.
+// A repository keeps a single entry which is replaced once its config changes.
+// Templates that failed to parse are cached with their error.
+type TemplatesCache struct {
+	sync.RWMutex
+	templates map[string]cachedTemplates
+}
+
+type cachedTemplates struct {
+	commentTemplates model.CommentTemplates
+	lang             string
+	templates        Templates
+	err              error
+}
+
+// NewTemplatesCache returns a pointer to an empty TemplatesCache.
+func NewTemplatesCache() *TemplatesCache {
+	return &TemplatesCache{
+		templates: make(map[string]cachedTemplates),
+	}
+}
+
+// Get returns the templates parsed by NewTemplates from the given comment templates and language of a repository.
+// The templates of the famed config are cached under an empty owner and repository name.
+func (c *TemplatesCache) Get(owner string, repoName string, commentTemplates model.CommentTemplates, lang string) (Templates, error) {
+	key := strings.ToLower(owner + "/" + repoName)
+
+	c.RLock()
+	cached, ok := c.templates[key]
+	c.RUnlock()
+	if ok && cached.commentTemplates == commentTemplates && cached.lang == lang {
+		return cached.templates, cached.err
+	}
+
+	templates, err := NewTemplates(commentTemplates, lang)
+
+	c.Lock()
+	defer c.Unlock()
+	c.templates[key] = cachedTemplates{commentTemplates: commentTemplates, lang: lang, templates: templates, err: err}
+
+	return templates, err
+}
+
+// Len returns the number of cached repositories.
+func (c *TemplatesCache) Len() int {
+	c.RLock()
+	defer c.RUnlock()
+
+	return len(c.templates)
+}
@@ -0,0 +1,54 @@
+package comment_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/internal/famed/model/comment"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+func TestTemplatesCache(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	cache := comment.NewTemplatesCache()
+	commentTemplates := model.CommentTemplates{ErrorReward: "Famed could not reward this issue: {{ .Reason }}"}
+
+	// WHEN
+	templates, err := cache.Get("testOwner", "testRepo", commentTemplates, "ja")
+	cachedTemplates, cachedErr := cache.Get("TestOwner", "testRepo", commentTemplates, "ja")
+
+	// THEN
+	assert.NoError(t, err)
+	assert.NoError(t, cachedErr)
+	// The parsed templates are reused, templates parsed again would hold new template functions
+	assert.Equal(t, templates, cachedTemplates)
+
+	// WHEN
+	_, err = cache.Get("testOwner", "invalidRepo", model.CommentTemplates{Reward: "{{ .Unknown }}"}, "")
+	_, cachedErr = cache.Get("testOwner", "invalidRepo", model.CommentTemplates{Reward: "{{ .Unknown }}"}, "")
+
+	// THEN the error is cached
+	assert.Error(t, err)
+	assert.Same(t, err, cachedErr)
+
+	// WHEN
+	_, err = cache.Get("testOwner", "testRepo", commentTemplates, "unknown")
+
+	// THEN templates are parsed again once the language changes
+	assert.Error(t, err)
+
+	// WHEN the config of a repository changes repeatedly
+	for _, reward := range []string{"Reward 1", "Reward 2", "Reward 3"} {
+		templates, err = cache.Get("testOwner", "testRepo", model.CommentTemplates{Reward: reward}, "")
+		assert.NoError(t, err)
+	}
+
+	// THEN the repository keeps a single entry
+	assert.Equal(t, 2, cache.Len())
+	cachedTemplates, err = cache.Get("testOwner", "testRepo", model.CommentTemplates{Reward: "Reward 3"}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, templates, cachedTemplates)
+}
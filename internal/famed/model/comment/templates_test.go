@@ -0,0 +1,219 @@
+package comment_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	model2 "github.com/morphysm/famed-github-backend/internal/famed/model"
+	"github.com/morphysm/famed-github-backend/internal/famed/model/comment"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+func TestTemplates(t *testing.T) {
+	t.Parallel()
+
+	contributors := []*model2.Contributor{{Login: "test", TotalWorkTime: 24 * time.Hour, WorkShare: 1, RewardSum: 975.5, Severities: map[model.IssueSeverity]int{model.High: 1}}}
+	breakdown := rewardBreakdown(model2.RewardFormulaOptions{Formula: model2.Polynomial, KMultiplier: 2}, 24*time.Hour, 0)
+	issue := model.Issue{Title: "Test 3", Number: 5, Assignees: []model.User{{Login: "test"}}, Severities: []model.IssueSeverity{model.High}}
+	rules, err := model2.NewEligibilityRules(model2.DefaultEligibilityRules)
+	assert.NoError(t, err)
+	splitContributors, splitBreakdown := splitRewardContributors(t)
+
+	testCases := []struct {
+		Name             string
+		CommentTemplates model.CommentTemplates
+		Language         string
+		Comment          func(templates comment.Templates) comment.Comment
+		ExpectedBody     string
+		ExpectedErr      bool
+	}{
+		{
+			Name: "Default Eligible",
+			Comment: func(templates comment.Templates) comment.Comment {
+				return comment.NewEligibleComment(templates, rules, issue, nil)
+			},
+			// The footer of the eligible comment ends with an additional variation selector
+			ExpectedBody: eligibleCommentV1 + "\ufe0f",
+		},
+		{
+			Name: "Default Reward",
+			Comment: func(templates comment.Templates) comment.Comment {
+				return comment.NewRewardComment(templates, contributors, breakdown, "POINTS", "https://www.famed.morphysm.com/teams/test/test", map[string]model2.PayoutStatus{"test": model2.Approved})
+			},
+			ExpectedBody: "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n@test - you Got Famed! 💎 Check out your new score here: https://www.famed.morphysm.com/teams/test/test\n| Contributor | Time | Share | Reward | Status |\n| ----------- | ----------- | ----------- | ----------- | ----------- |\n|test|1 day|100%|975 POINTS|approved|\n\n- Severity: high (1,000 POINTS)\n- Time open: 1 day of 40 days to fix\n- Reopened (k): 0\n- Decay factor: 0.975\n\n<details>\n<summary>How the reward is calculated</summary>\n\n`reward = severity reward × max(0, (1 − days open / days to fix)^(k multiplier × k + 1))`\n\n`975 = 1,000 × max(0, (1 − 1 / 40)^(2 × 0 + 1))`\n\nThe reward is divided by the share of time each contributor was assigned to the issue.\n</details>",
+		},
+		{
+			Name: "Default Error Reward",
+			Comment: func(templates comment.Templates) comment.Comment {
+				return comment.NewErrorRewardComment(templates, model2.ErrIssueMissingAssignee)
+			},
+			ExpectedBody: "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n### Famed could not generate a reward suggestion.\nReason: The issue is missing an assignee.",
+		},
+		{
+			Name:             "Custom Eligible",
+			CommentTemplates: model.CommentTemplates{Eligible: "{{ .Title }} #{{ .Number }}: {{ if .HasSingleSeverity }}{{ .Severity }}{{ end }}\n"},
+			Comment: func(templates comment.Templates) comment.Comment {
+				return comment.NewEligibleComment(templates, rules, issue, nil)
+			},
+			ExpectedBody: "<!--{\"type\":\"eligible\",\"version\":\"0.0.0\"}-->\nTest 3 #5: high",
+		},
+		{
+			Name:             "Custom Reward",
+			CommentTemplates: model.CommentTemplates{Reward: "{{ range .Contributors }}Thank you @{{ .Login }}! {{ .Reward }} {{ $.Currency }} for {{ $.Severity }} in {{ .WorkTime }} {{ end }}{{ .BoardURL }}"},
+			Comment: func(templates comment.Templates) comment.Comment {
+				return comment.NewRewardComment(templates, contributors, breakdown, "POINTS", "https://boards.example.com/test/test", nil)
+			},
+			ExpectedBody: "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\nThank you @test! 975 POINTS for high in 24h0m0s https://boards.example.com/test/test",
+		},
+		{
+			Name:             "Custom Error Reward",
+			CommentTemplates: model.CommentTemplates{ErrorReward: "No reward: {{ .Reason }}"},
+			Comment: func(templates comment.Templates) comment.Comment {
+				return comment.NewErrorRewardComment(templates, model2.ErrIssueMissingAssignee)
+			},
+			ExpectedBody: "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\nNo reward: The issue is missing an assignee.",
+		},
+		{
+			Name:     "Spanish Reward",
+			Language: "es",
+			Comment: func(templates comment.Templates) comment.Comment {
+				return comment.NewRewardComment(templates, rewardContributors(26*time.Hour+5*time.Minute, 1234567), rewardBreakdown(model2.RewardFormulaOptions{Formula: model2.Linear, ReopenPenalty: 0.1}, 26*time.Hour+5*time.Minute, 1), "POINTS", "https://www.famed.morphysm.com/teams/test/test", nil)
+			},
+			ExpectedBody: "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n@test - ¡conseguiste Famed! 💎 Consulta tu nueva puntuación aquí: https://www.famed.morphysm.com/teams/test/test\n| Contribuidor | Tiempo | Participación | Recompensa | Estado |\n| ----------- | ----------- | ----------- | ----------- | ----------- |\n|test|1 día 2 horas 5 minutos|100\u00a0%|1.234.567 POINTS|sugerida|\n\n- Severidad: high (1.000 POINTS)\n- Tiempo abierta: 1 día 2 horas 5 minutos de 40 días para corregir\n- Reabierta (k): 1\n- Factor de decaimiento: 0,876\n\n<details>\n<summary>Cómo se calcula la recompensa</summary>\n\n`reward = severity reward × max(0, 1 − days open / days to fix) × max(0, 1 − k × reopen penalty)`\n\n`875,547 = 1.000 × max(0, 1 − 1,087 / 40) × max(0, 1 − 1 × 0,1)`\n\nLa recompensa se divide según el tiempo que cada contribuidor estuvo asignado a la issue.\n</details>",
+		},
+		{
+			Name:     "Japanese Reward",
+			Language: "ja",
+			Comment: func(templates comment.Templates) comment.Comment {
+				return comment.NewRewardComment(templates, rewardContributors(50*time.Hour, 1234567), rewardBreakdown(model2.RewardFormulaOptions{Formula: model2.Step, Steps: []model2.RewardStep{{Days: 7, Factor: 1}}}, 50*time.Hour, 0), "POINTS", "https://www.famed.morphysm.com/teams/test/test", nil)
+			},
+			ExpectedBody: "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n@test - Famed を獲得しました！💎 新しいスコアはこちら: https://www.famed.morphysm.com/teams/test/test\n| 貢献者 | 時間 | 割合 | 報酬 | ステータス |\n| ----------- | ----------- | ----------- | ----------- | ----------- |\n|test|2日2時間|100%|1,234,567 POINTS|提案済み|\n\n- 深刻度: high (1,000 POINTS)\n- オープン期間: 2日2時間\n- 再オープン回数 (k): 0\n- 減衰係数: 1\n\n<details>\n<summary>報酬の計算方法</summary>\n\n`reward = severity reward × deadline factor × max(0, 1 − k × reopen penalty)`\n\n`1,000 = 1,000 × 1 × max(0, 1 − 0 × 0)`\n\nIssue は 7日以内に修正されました。\n\n報酬は各貢献者が Issue に割り当てられていた時間の割合で分けられます。\n</details>",
+		},
+		{
+			Name:     "English Reward - Large Numbers",
+			Language: "en-US",
+			Comment: func(templates comment.Templates) comment.Comment {
+				return comment.NewRewardComment(templates, rewardContributors(30*time.Second, 1234567), rewardBreakdown(model2.RewardFormulaOptions{Formula: model2.Flat}, 30*time.Second, 0), "POINTS", "https://www.famed.morphysm.com/teams/test/test", nil)
+			},
+			ExpectedBody: "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n@test - you Got Famed! 💎 Check out your new score here: https://www.famed.morphysm.com/teams/test/test\n| Contributor | Time | Share | Reward | Status |\n| ----------- | ----------- | ----------- | ----------- | ----------- |\n|test|0 minutes|100%|1,234,567 POINTS|suggested|\n\n- Severity: high (1,000 POINTS)\n- Time open: 0 minutes\n- Reopened (k): 0\n- Decay factor: 1\n\n<details>\n<summary>How the reward is calculated</summary>\n\n`reward = severity reward`\n\n`1,000 = 1,000`\n\nThe reward is divided by the share of time each contributor was assigned to the issue.\n</details>",
+		},
+		{
+			Name:     "Spanish Error Reward",
+			Language: "es",
+			Comment: func(templates comment.Templates) comment.Comment {
+				return comment.NewErrorRewardComment(templates, model2.ErrIssueMissingAssignee)
+			},
+			ExpectedBody: "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n### Famed no pudo generar una sugerencia de recompensa.\nMotivo: A la issue le falta un responsable.",
+		},
+		{
+			Name: "Eligible - All Rules",
+			Comment: func(templates comment.Templates) comment.Comment {
+				allRules, _ := model2.NewEligibilityRules([]model2.EligibilityRuleName{model2.AssigneeRule, model2.SeverityRule, model2.PullRequestRule, model2.PullRequestMergedRule, model2.CVSSVectorRule, model2.MilestoneRule})
+				return comment.NewEligibleComment(templates, allRules, issue, &model.PullRequest{URL: "test"})
+			},
+			ExpectedBody: "<!--{\"type\":\"eligible\",\"version\":\"0.0.0\"}-->\n🤖 Assignees for issue **Test 3 #5** are now eligible to Get Famed.\n\n✅ Add assignees to track contribution times of the issue 🦸\u200d♀️🦹️\n✅ Add a single severity (CVSS) label to compute the score 🏷️️\n✅ Link a pull request fixing the issue 🔗\n❌ Merge the pull request fixing the issue 🔀\n❌ Add a CVSS vector to the issue description or as label 🧮\n❌ Add the issue to a milestone 🏁\n\nHappy hacking! 🦾💙❤️️",
+		},
+		{
+			Name:     "Spanish Error Reward - Pull Request Not Merged",
+			Language: "es",
+			Comment: func(templates comment.Templates) comment.Comment {
+				return comment.NewErrorRewardComment(templates, model2.ErrIssuePullRequestNotMerged)
+			},
+			ExpectedBody: "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n### Famed no pudo generar una sugerencia de recompensa.\nMotivo: La pull request de la issue no está fusionada.",
+		},
+		{
+			Name:     "Japanese Eligible",
+			Language: "ja",
+			Comment: func(templates comment.Templates) comment.Comment {
+				return comment.NewEligibleComment(templates, rules, model.Issue{Title: "Test 3", Number: 1005}, nil)
+			},
+			ExpectedBody: "<!--{\"type\":\"eligible\",\"version\":\"0.0.0\"}-->\n🤖 Issue **Test 3 #1005** の担当者は Get Famed の対象になりました。\n\n❌ 担当者を追加して Issue への貢献時間を記録しましょう 🦸\u200d♀️🦹️\n❌ スコアを計算するために深刻度 (CVSS) ラベルを 1 つ追加してください 🏷️️\n\nハッピーハッキング！ 🦾💙❤️️",
+		},
+		{
+			Name:             "Custom Template - Localized",
+			CommentTemplates: model.CommentTemplates{Reward: "{{ range .Contributors }}{{ msg (print .Status) }} {{ number .Reward }} {{ duration .WorkTime }}{{ end }}"},
+			Language:         "es",
+			Comment: func(templates comment.Templates) comment.Comment {
+				return comment.NewRewardComment(templates, rewardContributors(time.Hour, 1000), model2.RewardBreakdown{}, "POINTS", "", nil)
+			},
+			ExpectedBody: "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\nsugerida 1.000 1 hora",
+		},
+		{
+			Name: "Split Reward",
+			Comment: func(templates comment.Templates) comment.Comment {
+				return comment.NewRewardComment(templates, splitContributors, splitBreakdown, "POINTS", "https://www.famed.morphysm.com/teams/test/test", nil)
+			},
+			// The shares are the split, not the work time
+			ExpectedBody: "<!--{\"type\":\"reward\",\"version\":\"0.0.0\"}-->\n@alice @bob - you Got Famed! 💎 Check out your new score here: https://www.famed.morphysm.com/teams/test/test\n| Contributor | Time | Share | Reward | Status |\n| ----------- | ----------- | ----------- | ----------- | ----------- |\n|alice|1 day|60%|585 POINTS|suggested|\n|bob|0 minutes|40%|390 POINTS|suggested|\n\n- Severity: high (1,000 POINTS)\n- Time open: 1 day of 40 days to fix\n- Reopened (k): 0\n- Decay factor: 0.975\n\n<details>\n<summary>How the reward is calculated</summary>\n\n`reward = severity reward × max(0, (1 − days open / days to fix)^(k multiplier × k + 1))`\n\n`975 = 1,000 × max(0, (1 − 1 / 40)^(2 × 0 + 1))`\n\nThe reward is divided by the split set by the maintainers.\n</details>",
+		},
+		{
+			Name:        "Unsupported Language",
+			Language:    "de",
+			ExpectedErr: true,
+		},
+		{
+			Name:             "Invalid Template",
+			CommentTemplates: model.CommentTemplates{Reward: "{{ range .Contributors }}"},
+			ExpectedErr:      true,
+		},
+		{
+			Name:             "Unknown Field",
+			CommentTemplates: model.CommentTemplates{Eligible: "{{ .Assignee }}"},
+			ExpectedErr:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// WHEN
+			templates, err := comment.NewTemplates(testCase.CommentTemplates, testCase.Language)
+
+			// THEN
+			if testCase.ExpectedErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			body, err := testCase.Comment(templates).String()
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedBody, body)
+		})
+	}
+}
+
+func rewardContributors(workTime time.Duration, reward float64) []*model2.Contributor {
+	return []*model2.Contributor{{Login: "test", TotalWorkTime: workTime, WorkShare: 1, RewardSum: reward}}
+}
+
+func rewardBreakdown(options model2.RewardFormulaOptions, timeOpen time.Duration, reopenCount int) model2.RewardBreakdown {
+	rewardStructure := model2.NewRewardStructure(map[model.IssueSeverity]float64{model.High: 1000}, 40, options)
+	return rewardStructure.Breakdown(timeOpen, reopenCount, model.High)
+}
+
+// splitRewardContributors returns the contributors and breakdown of an issue worked on by alice only and split 60/40 between alice and bob by the maintainers.
+func splitRewardContributors(t *testing.T) ([]*model2.Contributor, model2.RewardBreakdown) {
+	t.Helper()
+
+	open := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	closed := open.Add(24 * time.Hour)
+	issue := model.NewEnrichIssue(model.Issue{
+		Number:     1,
+		CreatedAt:  open,
+		ClosedAt:   &closed,
+		Assignees:  []model.User{{Login: "alice"}},
+		Severities: []model.IssueSeverity{model.High},
+	}, nil, []model.IssueEvent{{Event: "assigned", CreatedAt: open, Assignee: &model.User{Login: "alice"}}})
+	rewardStructure := model2.NewRewardStructure(map[model.IssueSeverity]float64{model.High: 1000}, 40, model2.RewardFormulaOptions{Formula: model2.Polynomial, KMultiplier: 2})
+	boardOptions := model2.NewBoardOptions("POINTS", rewardStructure, model2.Month, model2.Window{}, closed).
+		WithOverrides(issue.Number, model2.RewardOverrides{Split: map[string]float64{"alice": 60, "bob": 40}})
+
+	contributors, breakdown, err := model2.NewBlueTeamFromIssue(issue, boardOptions)
+	assert.NoError(t, err)
+
+	return contributors, breakdown
+}
@@ -1,24 +1,108 @@
 package model
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 )
 
+// FamedLabelKey is the key of the label issues are tracked by.
+// It is not overridden per repository as issues are tracked by the label of the global configuration.
+const FamedLabelKey = "famed"
+
 type Config struct {
-	Currency  string
-	Rewards   map[model.IssueSeverity]float64
-	Labels    map[string]model.Label
-	DaysToFix int
-	BotLogin  string
+	Currency      string
+	Rewards       map[model.IssueSeverity]float64
+	Labels        map[string]model.Label
+	DaysToFix     int
+	RewardFormula RewardFormulaOptions
+	Granularity   Granularity
+	BotLogin      string
+	// Concurrency is the number of repositories and issues processed concurrently
+	Concurrency int
+	// BoardURL is the URL the boards of the repositories are published under
+	BoardURL         string
+	CommentTemplates model.CommentTemplates
+	// Language is the language the comments are rendered in
+	Language string
+	// EligibilityRules are the names of the rules an issue has to meet to be rewarded
+	EligibilityRules []EligibilityRuleName
 }
 
 // NewFamedConfig returns a new instance of the famed config.
-func NewFamedConfig(currency string, rewards map[model.IssueSeverity]float64, labels map[string]model.Label, daysToFix int, botLogin string) Config {
+func NewFamedConfig(currency string, rewards map[model.IssueSeverity]float64, labels map[string]model.Label, daysToFix int, rewardFormula RewardFormulaOptions, granularity Granularity, botLogin string, concurrency int, boardURL string, commentTemplates model.CommentTemplates, language string, eligibilityRules []EligibilityRuleName) Config {
 	return Config{
-		Currency:  currency,
-		Rewards:   rewards,
-		Labels:    labels,
-		DaysToFix: daysToFix,
-		BotLogin:  botLogin,
+		Currency:         currency,
+		Rewards:          rewards,
+		Labels:           labels,
+		DaysToFix:        daysToFix,
+		RewardFormula:    rewardFormula,
+		Granularity:      granularity,
+		BotLogin:         botLogin,
+		Concurrency:      concurrency,
+		BoardURL:         boardURL,
+		CommentTemplates: commentTemplates,
+		Language:         language,
+		EligibilityRules: eligibilityRules,
+	}
+}
+
+// Merge returns a copy of the config overridden by the values set in a repository's config.
+// Rewards and labels are overridden per key, comment templates per template, all other values are replaced.
+// The famed label is not overridden.
+func (c Config) Merge(repoConfig model.RepoConfig) Config {
+	merged := c
+
+	if repoConfig.Currency != nil {
+		merged.Currency = *repoConfig.Currency
+	}
+
+	if repoConfig.DaysToFix != nil {
+		merged.DaysToFix = *repoConfig.DaysToFix
+	}
+
+	if repoConfig.Language != nil {
+		merged.Language = *repoConfig.Language
 	}
+
+	// An empty list disables all rules, only a missing list keeps the rules of the config
+	if repoConfig.EligibilityRules != nil {
+		merged.EligibilityRules = make([]EligibilityRuleName, len(repoConfig.EligibilityRules))
+		for i, name := range repoConfig.EligibilityRules {
+			merged.EligibilityRules[i] = EligibilityRuleName(name)
+		}
+	}
+
+	if len(repoConfig.Rewards) > 0 {
+		merged.Rewards = make(map[model.IssueSeverity]float64, len(c.Rewards)+len(repoConfig.Rewards))
+		for severity, reward := range c.Rewards {
+			merged.Rewards[severity] = reward
+		}
+		for severity, reward := range repoConfig.Rewards {
+			merged.Rewards[severity] = reward
+		}
+	}
+
+	if len(repoConfig.Labels) > 0 {
+		merged.Labels = make(map[string]model.Label, len(c.Labels)+len(repoConfig.Labels))
+		for key, label := range c.Labels {
+			merged.Labels[key] = label
+		}
+		for key, label := range repoConfig.Labels {
+			if key == FamedLabelKey {
+				continue
+			}
+			merged.Labels[key] = label
+		}
+	}
+
+	merged.CommentTemplates = c.CommentTemplates.Merge(repoConfig.Templates)
+
+	return merged
+}
+
+// RepoBoardURL returns the URL of the board of a repository.
+func (c Config) RepoBoardURL(owner string, repoName string) string {
+	return fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(c.BoardURL, "/"), owner, repoName)
 }
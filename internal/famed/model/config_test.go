@@ -7,6 +7,7 @@ import (
 
 	model2 "github.com/morphysm/famed-github-backend/internal/famed/model"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+	"github.com/morphysm/famed-github-backend/pkg/pointer"
 )
 
 func TestConfig(t *testing.T) {
@@ -32,6 +33,47 @@ func TestConfig(t *testing.T) {
 	assert.Equal(t, cfg.BotLogin, "b")
 }
 
+func TestConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	cfg := NewTestConfig()
+	repoConfig := model.RepoConfig{
+		Currency:  pointer.String("USD"),
+		DaysToFix: pointer.Int(30),
+		Rewards:   map[model.IssueSeverity]float64{model.Critical: 5000},
+		Labels: map[string]model.Label{
+			"famed": {Name: "bounty", Color: "ff0000", Description: "Eligible for a bounty"},
+			"high":  {Name: "severe", Color: "ff8c00", Description: "High severity"},
+		},
+		Templates:        model.CommentTemplates{Reward: "Reward: {{ .Currency }}"},
+		Language:         pointer.String("es"),
+		EligibilityRules: []string{"assignee", "milestone"},
+	}
+
+	// WHEN
+	merged := cfg.Merge(repoConfig)
+
+	// THEN
+	assert.Equal(t, "USD", merged.Currency)
+	assert.Equal(t, 30, merged.DaysToFix)
+	assert.Equal(t, 5000.0, merged.Rewards[model.Critical])
+	assert.Equal(t, 3000.0, merged.Rewards[model.High])
+	assert.Equal(t, "severe", merged.Labels["high"].Name)
+	// The famed label issues are tracked by is not overridden
+	assert.Equal(t, cfg.Labels["famed"], merged.Labels["famed"])
+	assert.Equal(t, model.CommentTemplates{Reward: "Reward: {{ .Currency }}"}, merged.CommentTemplates)
+	assert.Equal(t, "es", merged.Language)
+	assert.Equal(t, []model2.EligibilityRuleName{model2.AssigneeRule, model2.MilestoneRule}, merged.EligibilityRules)
+	assert.Equal(t, "https://www.famed.morphysm.com/teams/testOwner/testRepo", merged.RepoBoardURL("testOwner", "testRepo"))
+	assert.Equal(t, model2.Month, merged.Granularity)
+	// The merged config must not modify the original config
+	assert.Equal(t, NewTestConfig(), cfg)
+	assert.Equal(t, cfg, cfg.Merge(model.RepoConfig{}))
+	// An empty list disables all rules
+	assert.Equal(t, []model2.EligibilityRuleName{}, cfg.Merge(model.RepoConfig{EligibilityRules: []string{}}).EligibilityRules)
+}
+
 func NewTestConfig() model2.Config {
 	rewards := map[model.IssueSeverity]float64{
 		model.Info:     0,
@@ -52,6 +94,13 @@ func NewTestConfig() model2.Config {
 		rewards,
 		labels,
 		40,
+		model2.RewardFormulaOptions{Formula: model2.Polynomial, KMultiplier: 2},
+		model2.Month,
 		"b",
+		8,
+		"https://www.famed.morphysm.com/teams",
+		model.CommentTemplates{},
+		"en",
+		model2.DefaultEligibilityRules,
 	)
 }
@@ -19,8 +19,12 @@ type Contributor struct {
 	TimeToDisclosure TimeToDisclosure            `json:"timeToDisclosure"`
 	Severities       map[model.IssueSeverity]int `json:"severities"`
 	MeanSeverity     float64                     `json:"meanSeverity"`
+	// Repos holds the per repository breakdown of owner boards
+	Repos map[string]RepoContribution `json:"repos,omitempty"`
 	// For issue rewardComment generation
 	TotalWorkTime time.Duration `json:"-"`
+	// WorkShare is the share of the contributor in the work logged on the issue, for issue rewardComment generation
+	WorkShare float64 `json:"-"`
 }
 
 type TimeToDisclosure struct {
@@ -35,23 +39,23 @@ type RewardEvent struct {
 	URL    string    `json:"url"`
 }
 
-func newContributor(assignee model.User, currency string, now time.Time) *Contributor {
+func newContributor(assignee model.User, boardOptions BoardOptions) *Contributor {
 	return &Contributor{
 		Login:            assignee.Login,
 		AvatarURL:        assignee.AvatarURL,
 		HTMLURL:          assignee.HTMLURL,
 		Rewards:          []RewardEvent{},
-		Currency:         currency,
+		Currency:         boardOptions.Currency,
 		TimeToDisclosure: TimeToDisclosure{},
 		Severities:       map[model.IssueSeverity]int{},
-		RewardsLastYear:  NewRewardsLastYear(now),
+		RewardsLastYear:  boardOptions.newRewardsSeries(),
 	}
 }
 
 // mapIssue maps an issue to a contributor.
-func (c *Contributor) mapIssue(url string, reportedDate, publishedDate time.Time, reward float64, severity model.IssueSeverity, now time.Time) {
+func (c *Contributor) mapIssue(url string, reportedDate, publishedDate time.Time, reward float64, severity model.IssueSeverity, boardOptions BoardOptions) {
 	// Set reward
-	c.updateReward(url, now, publishedDate, reward)
+	c.updateReward(url, publishedDate, reward, boardOptions)
 
 	// Increment fix count
 	c.incrementFixCounters(publishedDate.Sub(reportedDate).Minutes(), severity)
@@ -102,7 +106,7 @@ func (c *Contributor) updateAverageSeverity() {
 
 }
 
-func (c *Contributor) updateReward(url string, now, date time.Time, reward float64) {
+func (c *Contributor) updateReward(url string, date time.Time, reward float64, boardOptions BoardOptions) {
 	// Append reward to reward slice
 	c.Rewards = append(c.Rewards, RewardEvent{
 		Date:   date,
@@ -113,8 +117,8 @@ func (c *Contributor) updateReward(url string, now, date time.Time, reward float
 	// Updated reward sum
 	c.RewardSum += reward
 
-	// Add reward by month
-	if month, ok := isInTheLast12Months(now, date); ok {
-		c.RewardsLastYear[month].Reward += reward
+	// Add reward to its bucket
+	if bucket := bucketsAgo(boardOptions.Granularity, boardOptions.seriesEnd(), date); bucket >= 0 && bucket < len(c.RewardsLastYear) {
+		c.RewardsLastYear[bucket].Reward += reward
 	}
 }
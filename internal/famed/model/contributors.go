@@ -2,6 +2,7 @@ package model
 
 import (
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/phuslu/log"
@@ -15,10 +16,10 @@ import (
 type Contributors map[string]*Contributor
 
 // mapAssigneeIfMissing adds a contributor to the contributors' map if the contributor is missing.
-func (cs Contributors) mapAssigneeIfMissing(assignee model.User, currency string, now time.Time) {
+func (cs Contributors) mapAssigneeIfMissing(assignee model.User, boardOptions BoardOptions) {
 	_, ok := cs[assignee.Login]
 	if !ok {
-		cs[assignee.Login] = newContributor(assignee, currency, now)
+		cs[assignee.Login] = newContributor(assignee, boardOptions)
 	}
 }
 
@@ -47,11 +48,28 @@ func (cs Contributors) updateAverageSeverity() {
 // close (time issue was closed)
 // k (number of times the issue was reopened)
 // workLogs (time each contributor worked on the issue)
-func (cs Contributors) UpdateRewards(url string, workLogs WorkLogs, open time.Time, close time.Time, k int, severity model.IssueSeverity, boardOptions BoardOptions) {
-	points := boardOptions.RewardStructure.Reward(close.Sub(open), k, severity)
+// overrides (split and exclusions set by maintainers)
+// and returns how the reward of the issue was derived.
+func (cs Contributors) UpdateRewards(url string, workLogs WorkLogs, open time.Time, close time.Time, k int, severity model.IssueSeverity, overrides RewardOverrides, boardOptions BoardOptions) RewardBreakdown {
+	breakdown := boardOptions.RewardStructure.Breakdown(close.Sub(open), k, severity)
+	breakdown.Split = len(overrides.Split) > 0
+	points := breakdown.Reward
 	// Get the sum of work per contributor and the total sum of work
 	contributorsWork, workSum := workLogs.Sum()
 
+	// Excluded contributors do not share the reward
+	for login, contributorTotalWork := range contributorsWork {
+		if overrides.IsExcluded(login) {
+			workSum -= contributorTotalWork
+			delete(contributorsWork, login)
+		}
+	}
+
+	if breakdown.Split {
+		cs.updateSplitRewards(url, contributorsWork, close, points, overrides, boardOptions)
+		return breakdown
+	}
+
 	// Divide base reward based on percentage of each contributor
 	for login, contributorTotalWork := range contributorsWork {
 		if contributorTotalWork < 0 {
@@ -61,8 +79,9 @@ func (cs Contributors) UpdateRewards(url string, workLogs WorkLogs, open time.Ti
 		}
 		contributor := cs[login]
 
-		// Assign total work to contributor for issue rewardComment generation
+		// Assign total work and share of work to contributor for issue rewardComment generation
 		contributor.TotalWorkTime = contributorTotalWork
+		contributor.WorkShare = workShare(contributorTotalWork, workSum, len(contributorsWork))
 
 		// Calculated share of reward
 		// workSum can be 0
@@ -74,10 +93,61 @@ func (cs Contributors) UpdateRewards(url string, workLogs WorkLogs, open time.Ti
 		}
 
 		// Updated reward sum
-		contributor.updateReward(url, boardOptions.Now, close, reward)
+		contributor.updateReward(url, close, reward, boardOptions)
+	}
+
+	return breakdown
+}
+
+// workShare returns the share of a contributor's work in the work sum.
+// If no work was logged, the contributors share equally.
+func workShare(contributorWork time.Duration, workSum time.Duration, contributorCount int) float64 {
+	if workSum == 0 {
+		return 1 / float64(contributorCount)
+	}
+
+	return float64(contributorWork) / float64(workSum)
+}
+
+// updateSplitRewards divides the reward by the shares of the split set by maintainers instead of the work of each contributor.
+// Contributors missing in the split are not rewarded, the work share of a contributor is its share of the split.
+func (cs Contributors) updateSplitRewards(url string, contributorsWork map[string]time.Duration, close time.Time, points float64, overrides RewardOverrides, boardOptions BoardOptions) {
+	for login, contributorTotalWork := range contributorsWork {
+		cs[login].TotalWorkTime = contributorTotalWork
+		cs[login].WorkShare = 0
+	}
+
+	var shareSum float64
+	for login, share := range overrides.Split {
+		if !overrides.IsExcluded(login) {
+			shareSum += share
+		}
+	}
+
+	for login, share := range overrides.Split {
+		if overrides.IsExcluded(login) {
+			continue
+		}
+
+		login = cs.existingLogin(login)
+		cs.mapAssigneeIfMissing(model.User{Login: login}, boardOptions)
+		cs[login].WorkShare = share / shareSum
+		cs[login].updateReward(url, close, points*share/shareSum, boardOptions)
 	}
 }
 
+// existingLogin returns the login of the contributor matching the login case-insensitively.
+// If no contributor matches, the login is returned as is.
+func (cs Contributors) existingLogin(login string) string {
+	for existing := range cs {
+		if strings.EqualFold(existing, login) {
+			return existing
+		}
+	}
+
+	return login
+}
+
 func (cs Contributors) toSortedSlice() []*Contributor {
 	contributorsSlice := cs.toSlice()
 	sortContributors(contributorsSlice)
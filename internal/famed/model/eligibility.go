@@ -0,0 +1,157 @@
+package model
+
+import (
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+// EligibilityRuleName is the name an eligibility rule is enabled by in the config.
+type EligibilityRuleName string
+
+const (
+	AssigneeRule          EligibilityRuleName = "assignee"
+	SeverityRule          EligibilityRuleName = "severity"
+	PullRequestRule       EligibilityRuleName = "pullRequest"
+	PullRequestMergedRule EligibilityRuleName = "pullRequestMerged"
+	CVSSVectorRule        EligibilityRuleName = "cvssVector"
+	MilestoneRule         EligibilityRuleName = "milestone"
+)
+
+// DefaultEligibilityRules are the rules enabled if the config does not set any.
+var DefaultEligibilityRules = []EligibilityRuleName{AssigneeRule, SeverityRule}
+
+// EligibilityRule is a condition an issue has to meet to be rewarded.
+type EligibilityRule interface {
+	// Name returns the name the rule is enabled by.
+	Name() EligibilityRuleName
+	// Check returns why the issue and its linked pull request, nil if no pull request is linked, do not meet the rule.
+	// It returns nil if the rule is met.
+	Check(issue model.Issue, pullRequest *model.PullRequest) error
+}
+
+var eligibilityRules = map[EligibilityRuleName]EligibilityRule{
+	AssigneeRule:          assigneeRule{},
+	SeverityRule:          severityRule{},
+	PullRequestRule:       pullRequestRule{},
+	PullRequestMergedRule: pullRequestMergedRule{},
+	CVSSVectorRule:        cvssVectorRule{},
+	MilestoneRule:         milestoneRule{},
+}
+
+// EligibilityRules are the rules an issue has to meet to be rewarded, in the order they are checked.
+type EligibilityRules []EligibilityRule
+
+// NewEligibilityRules returns the rules enabled by the given names in the given order.
+// Unknown names return ErrUnknownEligibilityRule.
+func NewEligibilityRules(names []EligibilityRuleName) (EligibilityRules, error) {
+	rules := make(EligibilityRules, 0, len(names))
+	for _, name := range names {
+		rule, ok := eligibilityRules[name]
+		if !ok {
+			return nil, ErrUnknownEligibilityRule
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// Check returns why the issue does not meet the first rule it fails, nil if all rules are met.
+func (r EligibilityRules) Check(issue model.Issue, pullRequest *model.PullRequest) error {
+	for _, rule := range r {
+		if err := rule.Check(issue, pullRequest); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// assigneeRule requires at least one assignee to track the contribution times of.
+type assigneeRule struct{}
+
+func (assigneeRule) Name() EligibilityRuleName {
+	return AssigneeRule
+}
+
+func (assigneeRule) Check(issue model.Issue, _ *model.PullRequest) error {
+	if len(issue.Assignees) == 0 {
+		return ErrIssueMissingAssignee
+	}
+
+	return nil
+}
+
+// severityRule requires a single severity to compute the reward with.
+type severityRule struct{}
+
+func (severityRule) Name() EligibilityRuleName {
+	return SeverityRule
+}
+
+func (severityRule) Check(issue model.Issue, _ *model.PullRequest) error {
+	_, err := issue.Severity()
+	return err
+}
+
+// pullRequestRule requires a linked pull request.
+type pullRequestRule struct{}
+
+func (pullRequestRule) Name() EligibilityRuleName {
+	return PullRequestRule
+}
+
+func (pullRequestRule) Check(_ model.Issue, pullRequest *model.PullRequest) error {
+	if pullRequest == nil {
+		return ErrIssueMissingPullRequest
+	}
+
+	return nil
+}
+
+// pullRequestMergedRule requires the linked pull request to be merged.
+type pullRequestMergedRule struct{}
+
+func (pullRequestMergedRule) Name() EligibilityRuleName {
+	return PullRequestMergedRule
+}
+
+func (pullRequestMergedRule) Check(_ model.Issue, pullRequest *model.PullRequest) error {
+	if pullRequest == nil {
+		return ErrIssueMissingPullRequest
+	}
+	if !pullRequest.Merged {
+		return ErrIssuePullRequestNotMerged
+	}
+
+	return nil
+}
+
+// cvssVectorRule requires a CVSS vector in the issue body or labels instead of a severity label only.
+type cvssVectorRule struct{}
+
+func (cvssVectorRule) Name() EligibilityRuleName {
+	return CVSSVectorRule
+}
+
+func (cvssVectorRule) Check(issue model.Issue, _ *model.PullRequest) error {
+	if issue.CVSSScore == nil {
+		return ErrIssueMissingCVSSVector
+	}
+
+	return nil
+}
+
+// milestoneRule requires the issue to be added to a milestone.
+type milestoneRule struct{}
+
+func (milestoneRule) Name() EligibilityRuleName {
+	return MilestoneRule
+}
+
+func (milestoneRule) Check(issue model.Issue, _ *model.PullRequest) error {
+	if issue.Milestone == nil {
+		return ErrIssueMissingMilestone
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model2 "github.com/morphysm/famed-github-backend/internal/famed/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+	"github.com/morphysm/famed-github-backend/pkg/pointer"
+)
+
+func TestEligibilityRules_Check(t *testing.T) {
+	t.Parallel()
+
+	eligibleIssue := model.Issue{
+		Assignees:  []model.User{{Login: "test"}},
+		Severities: []model.IssueSeverity{model.High},
+		CVSSScore:  pointer.Float64(8.1),
+		Milestone:  pointer.String("v1.0.0"),
+	}
+	allRules := []model2.EligibilityRuleName{
+		model2.AssigneeRule,
+		model2.SeverityRule,
+		model2.PullRequestRule,
+		model2.PullRequestMergedRule,
+		model2.CVSSVectorRule,
+		model2.MilestoneRule,
+	}
+
+	testCases := []struct {
+		Name        string
+		Rules       []model2.EligibilityRuleName
+		Issue       model.Issue
+		PullRequest *model.PullRequest
+		ExpectedErr error
+	}{
+		{
+			Name:        "All rules met",
+			Rules:       allRules,
+			Issue:       eligibleIssue,
+			PullRequest: &model.PullRequest{URL: "test", Merged: true},
+		},
+		{
+			Name:  "No rules",
+			Rules: []model2.EligibilityRuleName{},
+		},
+		{
+			Name:        "Missing assignee",
+			Rules:       model2.DefaultEligibilityRules,
+			Issue:       model.Issue{Severities: []model.IssueSeverity{model.High}},
+			ExpectedErr: model2.ErrIssueMissingAssignee,
+		},
+		{
+			Name:        "Multiple severities",
+			Rules:       model2.DefaultEligibilityRules,
+			Issue:       model.Issue{Assignees: []model.User{{Login: "test"}}, Severities: []model.IssueSeverity{model.High, model.Low}},
+			ExpectedErr: model.ErrIssueMultipleSeverityLabels,
+		},
+		{
+			Name:        "Missing pull request",
+			Rules:       []model2.EligibilityRuleName{model2.PullRequestRule},
+			Issue:       eligibleIssue,
+			ExpectedErr: model2.ErrIssueMissingPullRequest,
+		},
+		{
+			Name:        "Missing pull request to be merged",
+			Rules:       []model2.EligibilityRuleName{model2.PullRequestMergedRule},
+			Issue:       eligibleIssue,
+			ExpectedErr: model2.ErrIssueMissingPullRequest,
+		},
+		{
+			Name:        "Pull request not merged",
+			Rules:       []model2.EligibilityRuleName{model2.PullRequestMergedRule},
+			Issue:       eligibleIssue,
+			PullRequest: &model.PullRequest{URL: "test"},
+			ExpectedErr: model2.ErrIssuePullRequestNotMerged,
+		},
+		{
+			Name:        "Severity label without CVSS vector",
+			Rules:       []model2.EligibilityRuleName{model2.SeverityRule, model2.CVSSVectorRule},
+			Issue:       model.Issue{Severities: []model.IssueSeverity{model.High}},
+			ExpectedErr: model2.ErrIssueMissingCVSSVector,
+		},
+		{
+			Name:        "Missing milestone",
+			Rules:       []model2.EligibilityRuleName{model2.MilestoneRule},
+			Issue:       model.Issue{},
+			ExpectedErr: model2.ErrIssueMissingMilestone,
+		},
+		{
+			Name:        "First rule not met",
+			Rules:       []model2.EligibilityRuleName{model2.MilestoneRule, model2.AssigneeRule},
+			Issue:       model.Issue{},
+			ExpectedErr: model2.ErrIssueMissingMilestone,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// GIVEN
+			rules, err := model2.NewEligibilityRules(testCase.Rules)
+			assert.NoError(t, err)
+
+			// WHEN
+			err = rules.Check(testCase.Issue, testCase.PullRequest)
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedErr, err)
+		})
+	}
+}
+
+func TestNewEligibilityRules(t *testing.T) {
+	t.Parallel()
+
+	// WHEN
+	rules, err := model2.NewEligibilityRules([]model2.EligibilityRuleName{model2.MilestoneRule, model2.AssigneeRule})
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Len(t, rules, 2)
+	assert.Equal(t, model2.MilestoneRule, rules[0].Name())
+	assert.Equal(t, model2.AssigneeRule, rules[1].Name())
+
+	// WHEN
+	_, err = model2.NewEligibilityRules([]model2.EligibilityRuleName{model2.AssigneeRule, "unknown"})
+
+	// THEN
+	assert.Equal(t, model2.ErrUnknownEligibilityRule, err)
+}
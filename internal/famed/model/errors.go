@@ -9,13 +9,38 @@ var (
 	ErrMissingOwnerPathParameter = errors.New("missing owner path parameter")
 	ErrAppNotInstalled           = errors.New("GitHub app not installed for given repository")
 
+	ErrInvalidSinceQueryParameter = errors.New("invalid since query parameter, expected RFC 3339 timestamp or date")
+	ErrInvalidUntilQueryParameter = errors.New("invalid until query parameter, expected RFC 3339 timestamp or date")
+	ErrInvalidTimeWindow          = errors.New("since query parameter must be before until query parameter")
+	ErrTimeWindowTooLarge         = errors.New("the time window given by the since and until query parameters is too large")
+
+	ErrMissingIssueNumberPathParameter = errors.New("missing or invalid issue number path parameter")
+	ErrMissingLoginPathParameter       = errors.New("missing login path parameter")
+	ErrInvalidStatusQueryParameter     = errors.New("invalid status query parameter, expected suggested, approved, paid or void")
+	ErrInvalidFormatQueryParameter     = errors.New("invalid format query parameter, expected json or csv")
+	ErrLedgerEntryNotFound             = errors.New("ledger entry not found")
+	ErrInvalidPayoutTransition         = errors.New("the ledger entry cannot be moved to the requested status")
+	ErrMissingPaymentReference         = errors.New("a payment reference is required to mark a ledger entry as paid")
+
 	ErrIssueMissingAssignee    = errors.New("the issue is missing an assignee")
 	ErrIssueMissingClosedAt    = errors.New("the issue is missing the closed at timestamp")
 	ErrIssueMissingPullRequest = errors.New("the issue is missing a pull request")
 
-	ErrEventMissingData            = errors.New("the event is missing data promised by the GitHub API")
-	ErrEventNotRepoAdded           = errors.New("the event is not a repo added to github event")
-	ErrEventNotInstallationCreated = errors.New("the event is not a github created event")
+	ErrIssuePullRequestNotMerged = errors.New("the pull request of the issue is not merged")
+	ErrIssueMissingCVSSVector    = errors.New("the issue is missing a CVSS vector")
+	ErrIssueMissingMilestone     = errors.New("the issue is missing a milestone")
+	ErrUnknownEligibilityRule    = errors.New("unknown eligibility rule, expected assignee, severity, pullRequest, pullRequestMerged, cvssVector or milestone")
+
+	ErrEventMissingData = errors.New("the event is missing data promised by the GitHub API")
 
 	ErrEventNotHandled = errors.New("the event is not handled")
+
+	ErrMissingDeliveryIDPathParameter = errors.New("missing delivery id path parameter")
+	ErrDeliveryNotFound               = errors.New("webhook delivery not found or its payload was not stored")
+	ErrInvalidDryRunQueryParameter    = errors.New("invalid dryRun query parameter, expected true or false")
+
+	ErrUnknownCommand          = errors.New("unknown famed command, expected split, recalc, exclude or severity")
+	ErrInvalidCommandArguments = errors.New("invalid famed command arguments")
+
+	ErrCommentNotMigratable = errors.New("the comment cannot be migrated to the current version")
 )
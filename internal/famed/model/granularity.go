@@ -0,0 +1,103 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// Granularity is the size of the buckets the rewards of a contributor are summed up in.
+type Granularity string
+
+const (
+	Week    Granularity = "week"
+	Month   Granularity = "month"
+	Quarter Granularity = "quarter"
+)
+
+const (
+	weeksInAYear    = 52
+	monthsInAYear   = 12
+	quartersInAYear = 4
+	daysInAWeek     = 7
+)
+
+// IsValid returns true if the granularity is one of week, month or quarter.
+func (g Granularity) IsValid() bool {
+	switch g {
+	case Week, Month, Quarter:
+		return true
+	default:
+		return false
+	}
+}
+
+// bucketsInAYear returns the number of buckets of the granularity covering a year.
+func (g Granularity) bucketsInAYear() int {
+	switch g {
+	case Week:
+		return weeksInAYear
+	case Quarter:
+		return quartersInAYear
+	default:
+		return monthsInAYear
+	}
+}
+
+// bucketStart returns the start of the bucket containing t.
+// Weeks start on Monday.
+func (g Granularity) bucketStart(t time.Time) time.Time {
+	year, month, day := t.Date()
+	switch g {
+	case Week:
+		daysSinceMonday := (int(t.Weekday()) + daysInAWeek - 1) % daysInAWeek
+		return time.Date(year, month, day-daysSinceMonday, 0, 0, 0, 0, t.Location())
+	case Quarter:
+		return time.Date(year, month-(month-1)%3, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	}
+}
+
+// addBuckets returns the start of the bucket n buckets after the bucket starting at start.
+func (g Granularity) addBuckets(start time.Time, n int) time.Time {
+	switch g {
+	case Week:
+		return start.AddDate(0, 0, daysInAWeek*n)
+	case Quarter:
+		return start.AddDate(0, 3*n, 0)
+	default:
+		return start.AddDate(0, n, 0)
+	}
+}
+
+// index returns the sequence number of the bucket containing t.
+// The difference of two indexes is the number of buckets in between them.
+func (g Granularity) index(t time.Time) int {
+	year, month, _ := t.Date()
+	switch g {
+	case Week:
+		start := g.bucketStart(t)
+		// Unix days are counted in UTC to not be affected by daylight saving time,
+		// the Unix epoch is a Thursday, so the days of a Monday plus three are a multiple of seven
+		days := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC).Unix() / (24 * 60 * 60)
+		return (int(days) + 3) / daysInAWeek
+	case Quarter:
+		return year*quartersInAYear + (int(month)-1)/3
+	default:
+		return year*monthsInAYear + int(month) - 1
+	}
+}
+
+// label returns the label of the bucket containing t.
+// Months are labeled as "4.2022", weeks by their ISO week as "W16.2022" and quarters as "Q2.2022".
+func (g Granularity) label(t time.Time) string {
+	switch g {
+	case Week:
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("W%d.%d", week, year)
+	case Quarter:
+		return fmt.Sprintf("Q%d.%d", (int(t.Month())-1)/3+1, t.Year())
+	default:
+		return fmt.Sprintf("%d.%d", t.Month(), t.Year())
+	}
+}
@@ -0,0 +1,31 @@
+package model
+
+// LabelReport represents the outcome of reconciling the labels of a repository with the configured labels.
+// The repository name is empty if the repositories of the owner could not be reconciled.
+type LabelReport struct {
+	Owner     string   `json:"owner"`
+	RepoName  string   `json:"repoName"`
+	Created   []string `json:"created"`
+	Updated   []string `json:"updated"`
+	Unchanged []string `json:"unchanged"`
+	Errors    []string `json:"errors,omitempty"`
+}
+
+// NewLabelReport returns an empty LabelReport of a repository.
+func NewLabelReport(owner string, repoName string) LabelReport {
+	return LabelReport{
+		Owner:     owner,
+		RepoName:  repoName,
+		Created:   []string{},
+		Updated:   []string{},
+		Unchanged: []string{},
+	}
+}
+
+// NewFailedLabelReport returns a LabelReport of an owner whose repositories could not be reconciled.
+func NewFailedLabelReport(owner string, err error) LabelReport {
+	report := NewLabelReport(owner, "")
+	report.Errors = []string{err.Error()}
+
+	return report
+}
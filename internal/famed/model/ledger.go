@@ -0,0 +1,84 @@
+package model
+
+import (
+	"math"
+	"time"
+)
+
+// PayoutStatus is the state of a ledger entry in the payout workflow.
+type PayoutStatus string
+
+const (
+	// Suggested entries are created from reward comments and await approval.
+	Suggested PayoutStatus = "suggested"
+	// Approved entries await payment.
+	Approved PayoutStatus = "approved"
+	// Paid entries were paid, the payment reference is recorded with the entry.
+	Paid PayoutStatus = "paid"
+	// Void entries will not be paid.
+	Void PayoutStatus = "void"
+)
+
+// IsValid returns true if the status is one of suggested, approved, paid or void.
+func (s PayoutStatus) IsValid() bool {
+	switch s {
+	case Suggested, Approved, Paid, Void:
+		return true
+	default:
+		return false
+	}
+}
+
+// LedgerEntry records the payout of a reward to a contributor for fixing an issue.
+type LedgerEntry struct {
+	Owner       string       `json:"owner"`
+	RepoName    string       `json:"repoName"`
+	IssueNumber int          `json:"issueNumber"`
+	IssueURL    string       `json:"issueUrl"`
+	Login       string       `json:"login"`
+	Amount      float64      `json:"amount"`
+	Currency    string       `json:"currency"`
+	Status      PayoutStatus `json:"status"`
+	Reference   string       `json:"reference,omitempty"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	UpdatedAt   time.Time    `json:"updatedAt"`
+}
+
+// NewLedgerEntry returns a new suggested ledger entry.
+// The amount is truncated to the whole number shown in the reward comment.
+func NewLedgerEntry(owner string, repoName string, issueNumber int, issueURL string, login string, amount float64, currency string, now time.Time) LedgerEntry {
+	return LedgerEntry{
+		Owner:       owner,
+		RepoName:    repoName,
+		IssueNumber: issueNumber,
+		IssueURL:    issueURL,
+		Login:       login,
+		Amount:      math.Trunc(amount),
+		Currency:    currency,
+		Status:      Suggested,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// Transition returns a copy of the entry moved to the given status.
+// Suggested entries can be approved, approved entries can be paid with a payment reference
+// and entries that are not paid yet can be voided.
+func (e LedgerEntry) Transition(status PayoutStatus, reference string, now time.Time) (LedgerEntry, error) {
+	switch {
+	case status == Approved && e.Status == Suggested:
+	case status == Paid && e.Status == Approved:
+		if reference == "" {
+			return LedgerEntry{}, ErrMissingPaymentReference
+		}
+		e.Reference = reference
+	case status == Void && (e.Status == Suggested || e.Status == Approved):
+	default:
+		return LedgerEntry{}, ErrInvalidPayoutTransition
+	}
+
+	e.Status = status
+	e.UpdatedAt = now
+
+	return e, nil
+}
@@ -0,0 +1,58 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/internal/famed/model"
+)
+
+func TestLedgerEntry_Transition(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2022, 4, 20, 0, 0, 0, 0, time.UTC)
+	updatedAt := createdAt.Add(24 * time.Hour)
+
+	testCases := []struct {
+		Name        string
+		From        model.PayoutStatus
+		To          model.PayoutStatus
+		Reference   string
+		ExpectedErr error
+	}{
+		{Name: "Approve suggested", From: model.Suggested, To: model.Approved},
+		{Name: "Pay approved", From: model.Approved, To: model.Paid, Reference: "TX-1"},
+		{Name: "Pay approved without reference", From: model.Approved, To: model.Paid, ExpectedErr: model.ErrMissingPaymentReference},
+		{Name: "Pay suggested", From: model.Suggested, To: model.Paid, Reference: "TX-1", ExpectedErr: model.ErrInvalidPayoutTransition},
+		{Name: "Void suggested", From: model.Suggested, To: model.Void},
+		{Name: "Void approved", From: model.Approved, To: model.Void},
+		{Name: "Void paid", From: model.Paid, To: model.Void, ExpectedErr: model.ErrInvalidPayoutTransition},
+		{Name: "Approve void", From: model.Void, To: model.Approved, ExpectedErr: model.ErrInvalidPayoutTransition},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// GIVEN
+			entry := model.NewLedgerEntry("testOwner", "testRepo", 1, "TestURL", "testUser", 975.6, "POINTS", createdAt)
+			entry.Status = testCase.From
+
+			// WHEN
+			transitioned, err := entry.Transition(testCase.To, testCase.Reference, updatedAt)
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedErr, err)
+			if testCase.ExpectedErr != nil {
+				return
+			}
+			assert.Equal(t, testCase.To, transitioned.Status)
+			assert.Equal(t, testCase.Reference, transitioned.Reference)
+			assert.Equal(t, 975.0, transitioned.Amount)
+			assert.Equal(t, createdAt, transitioned.CreatedAt)
+			assert.Equal(t, updatedAt, transitioned.UpdatedAt)
+		})
+	}
+}
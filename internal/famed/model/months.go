@@ -1,59 +1,87 @@
 package model
 
 import (
-	"fmt"
 	"time"
 )
 
+// RewardsLastYear holds the rewards of a contributor summed up by bucket, starting with the most recent bucket.
+// By default, the buckets are the months of the last year. Depending on the board options,
+// the buckets can also be weeks or quarters and cover the time window of the board.
 type RewardsLastYear []monthlyReward
 
+// monthlyReward is the reward of a single bucket, the json key month is kept for compatibility and holds the bucket label.
 type monthlyReward struct {
 	Month  string  `json:"month"`
 	Reward float64 `json:"reward"`
 }
 
-const monthsInAYear = 12
-
 // NewRewardsLastYear returns rewardsLastYear with instantiated months starting at the current month and going back 11 months.
 func NewRewardsLastYear(timeStart time.Time) RewardsLastYear {
-	rewardsLastYear := make([]monthlyReward, monthsInAYear)
-	year, month, _ := timeStart.Date()
-	for i := 0; i < 12; i++ {
-		rewardsLastYear[i].Month = fmt.Sprintf("%d.%d", month, year)
-		month--
-		if month < 1 {
-			month = 12
-			year--
-		}
+	return NewRewardsSeries(Month, nil, timeStart)
+}
+
+// MaxRewardsSeriesBuckets is the maximum number of buckets of a rewards series, ten years of weeks.
+const MaxRewardsSeriesBuckets = 10 * weeksInAYear
+
+// NewRewardsSeries returns rewards with instantiated buckets of the given granularity starting at the bucket of end
+// and going back to the bucket of since. If since is nil, the buckets cover a year.
+// The series is cut off after MaxRewardsSeriesBuckets buckets.
+func NewRewardsSeries(granularity Granularity, since *time.Time, end time.Time) RewardsLastYear {
+	buckets := seriesBuckets(granularity, since, end)
+	if buckets > MaxRewardsSeriesBuckets {
+		buckets = MaxRewardsSeriesBuckets
+	}
+
+	rewards := make(RewardsLastYear, buckets)
+	bucketStart := granularity.bucketStart(end)
+	for i := range rewards {
+		rewards[i].Month = granularity.label(bucketStart)
+		bucketStart = granularity.addBuckets(bucketStart, -1)
 	}
 
-	return rewardsLastYear
+	return rewards
 }
 
-// lastDayOfMonth returns the last day of a month of a given time.
-func lastDayOfMonth(now time.Time) time.Time {
-	currentYear, currentMonth, _ := now.Date()
-	currentLocation := now.Location()
+// seriesBuckets returns the number of buckets of the given granularity from the bucket of since to the bucket of end.
+// If since is nil, the buckets cover a year.
+func seriesBuckets(granularity Granularity, since *time.Time, end time.Time) int {
+	if since == nil {
+		return granularity.bucketsInAYear()
+	}
 
-	firstOfMonth := time.Date(currentYear, currentMonth, 1, 0, 0, 0, 0, currentLocation)
+	buckets := bucketsAgo(granularity, end, *since) + 1
+	if buckets < 0 {
+		return 0
+	}
 
-	return firstOfMonth.AddDate(0, 1, -1)
+	return buckets
 }
 
-// isInTheLast12Months returns how many months ago the then date is and
-// true if the month of the passed date is less than the current month and 11 months ago.
-func isInTheLast12Months(now time.Time, then time.Time) (int, bool) {
-	lastOfMonth := lastDayOfMonth(now)
-	aYearAgo := lastOfMonth.AddDate(-1, 0, 0)
-	if then.Sub(aYearAgo) > 0 {
-		// Same year
-		if now.Year()-then.Year() == 0 {
-			return int(now.Month() - then.Month()), true
+// emptyCopy returns a copy of the rewards last year with the same months and all rewards set to 0.
+func (r RewardsLastYear) emptyCopy() RewardsLastYear {
+	emptyCopy := make(RewardsLastYear, len(r))
+	for i, reward := range r {
+		emptyCopy[i].Month = reward.Month
+	}
+
+	return emptyCopy
+}
+
+// add adds the rewards of other to the rewards of the matching months.
+// Months of other that are not present are ignored.
+func (r RewardsLastYear) add(other RewardsLastYear) {
+	for _, otherReward := range other {
+		for i := range r {
+			if r[i].Month == otherReward.Month {
+				r[i].Reward += otherReward.Reward
+				break
+			}
 		}
-		// Different year
-		monthsTillTheEndOfTheYear := 12 - then.Month()
-		return int(monthsTillTheEndOfTheYear + now.Month()), true
 	}
+}
 
-	return 0, false
+// bucketsAgo returns how many buckets of the given granularity the then date lies before the end date.
+// The result is negative if then lies in a bucket after the bucket of end.
+func bucketsAgo(granularity Granularity, end time.Time, then time.Time) int {
+	return granularity.index(end) - granularity.index(then)
 }
@@ -7,50 +7,92 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func TestIsLessThenAYearAndThisMonthAgo(t *testing.T) {
+func TestBucketsAgo(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
-		Name           string
-		Now            time.Time
-		Then           time.Time
-		ExpectedMonths int
-		ExpectedOk     bool
+		Name            string
+		Granularity     Granularity
+		End             time.Time
+		Then            time.Time
+		ExpectedBuckets int
 	}{
 		{
-			Name:           "Difference 0",
-			Now:            time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-			Then:           time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-			ExpectedMonths: 0,
-			ExpectedOk:     true,
+			Name:            "Difference 0",
+			Granularity:     Month,
+			End:             time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			Then:            time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			ExpectedBuckets: 0,
 		},
 		{
-			Name:           "Difference 1 month same year",
-			Now:            time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC),
-			Then:           time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-			ExpectedMonths: 1,
-			ExpectedOk:     true,
+			Name:            "Difference 1 month same year",
+			Granularity:     Month,
+			End:             time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC),
+			Then:            time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			ExpectedBuckets: 1,
 		},
 		{
-			Name:           "Difference 1 month different year",
-			Now:            time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-			Then:           time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC),
-			ExpectedMonths: 1,
-			ExpectedOk:     true,
+			Name:            "Difference 1 month different year",
+			Granularity:     Month,
+			End:             time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			Then:            time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC),
+			ExpectedBuckets: 1,
 		},
 		{
-			Name:           "Difference 11 months and 29 days different year",
-			Now:            time.Date(2021, 11, 30, 0, 0, 0, 0, time.UTC),
-			Then:           time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC),
-			ExpectedMonths: 11,
-			ExpectedOk:     true,
+			Name:            "Difference 11 months and 29 days different year",
+			Granularity:     Month,
+			End:             time.Date(2021, 11, 30, 0, 0, 0, 0, time.UTC),
+			Then:            time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC),
+			ExpectedBuckets: 11,
 		},
 		{
-			Name:           "Difference 12 months different year",
-			Now:            time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-			Then:           time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
-			ExpectedMonths: 0,
-			ExpectedOk:     false,
+			Name:            "Difference 12 months different year",
+			Granularity:     Month,
+			End:             time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			Then:            time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			ExpectedBuckets: 12,
+		},
+		{
+			Name:            "Then after end",
+			Granularity:     Month,
+			End:             time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			Then:            time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC),
+			ExpectedBuckets: -1,
+		},
+		{
+			Name:            "Same week from Monday to Sunday",
+			Granularity:     Week,
+			End:             time.Date(2022, 4, 24, 23, 0, 0, 0, time.UTC),
+			Then:            time.Date(2022, 4, 18, 0, 0, 0, 0, time.UTC),
+			ExpectedBuckets: 0,
+		},
+		{
+			Name:            "Difference 1 week from Sunday to Monday",
+			Granularity:     Week,
+			End:             time.Date(2022, 4, 18, 0, 0, 0, 0, time.UTC),
+			Then:            time.Date(2022, 4, 17, 0, 0, 0, 0, time.UTC),
+			ExpectedBuckets: 1,
+		},
+		{
+			Name:            "Difference 2 weeks different year",
+			Granularity:     Week,
+			End:             time.Date(2022, 1, 5, 0, 0, 0, 0, time.UTC),
+			Then:            time.Date(2021, 12, 22, 0, 0, 0, 0, time.UTC),
+			ExpectedBuckets: 2,
+		},
+		{
+			Name:            "Same quarter",
+			Granularity:     Quarter,
+			End:             time.Date(2022, 6, 30, 0, 0, 0, 0, time.UTC),
+			Then:            time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+			ExpectedBuckets: 0,
+		},
+		{
+			Name:            "Difference 1 quarter different year",
+			Granularity:     Quarter,
+			End:             time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			Then:            time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC),
+			ExpectedBuckets: 1,
 		},
 	}
 
@@ -59,11 +101,10 @@ func TestIsLessThenAYearAndThisMonthAgo(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			t.Parallel()
 			// WHEN
-			months, ok := isInTheLast12Months(testCase.Now, testCase.Then)
+			buckets := bucketsAgo(testCase.Granularity, testCase.End, testCase.Then)
 
 			// THEN
-			assert.Equal(t, testCase.ExpectedMonths, months)
-			assert.Equal(t, testCase.ExpectedOk, ok)
+			assert.Equal(t, testCase.ExpectedBuckets, buckets)
 		})
 	}
 }
@@ -144,3 +185,88 @@ func TestNewRewardsLastYear(t *testing.T) {
 		})
 	}
 }
+
+func TestNewRewardsSeries(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name        string
+		Granularity Granularity
+		Since       *time.Time
+		End         time.Time
+		Expected    RewardsLastYear
+	}{
+		{
+			Name:        "Quarters of a year",
+			Granularity: Quarter,
+			End:         time.Date(2022, 4, 20, 0, 0, 0, 0, time.UTC),
+			Expected: RewardsLastYear{
+				{Month: "Q2.2022", Reward: 0},
+				{Month: "Q1.2022", Reward: 0},
+				{Month: "Q4.2021", Reward: 0},
+				{Month: "Q3.2021", Reward: 0},
+			},
+		},
+		{
+			Name:        "Weeks since",
+			Granularity: Week,
+			Since:       &since,
+			End:         time.Date(2022, 1, 20, 0, 0, 0, 0, time.UTC),
+			Expected: RewardsLastYear{
+				{Month: "W3.2022", Reward: 0},
+				{Month: "W2.2022", Reward: 0},
+				{Month: "W1.2022", Reward: 0},
+				{Month: "W52.2021", Reward: 0},
+			},
+		},
+		{
+			Name:        "Months since",
+			Granularity: Month,
+			Since:       &since,
+			End:         time.Date(2022, 3, 31, 0, 0, 0, 0, time.UTC),
+			Expected: RewardsLastYear{
+				{Month: "3.2022", Reward: 0},
+				{Month: "2.2022", Reward: 0},
+				{Month: "1.2022", Reward: 0},
+			},
+		},
+		{
+			Name:        "Since after end",
+			Granularity: Month,
+			Since:       &since,
+			End:         time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC),
+			Expected:    RewardsLastYear{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// WHEN
+			rewards := NewRewardsSeries(testCase.Granularity, testCase.Since, testCase.End)
+
+			// THEN
+			assert.Equal(t, testCase.Expected, rewards)
+		})
+	}
+}
+
+func TestNewRewardsSeries_MaxBuckets(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	since := time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2022, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	// WHEN
+	rewards := NewRewardsSeries(Week, &since, end)
+
+	// THEN
+	assert.Len(t, rewards, MaxRewardsSeriesBuckets)
+	assert.Equal(t, "W13.2022", rewards[0].Month)
+	assert.True(t, NewBoardOptions("", nil, Week, Window{Since: &since}, end).ExceedsMaxBuckets())
+	assert.False(t, NewBoardOptions("", nil, Week, Window{}, end).ExceedsMaxBuckets())
+}
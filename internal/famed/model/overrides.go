@@ -0,0 +1,67 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+// RewardOverrides holds the overrides set by maintainers through commands for the reward of an issue.
+type RewardOverrides struct {
+	// Split maps logins to their share of the reward, shares are relative to the sum of all shares
+	Split map[string]float64 `json:"split,omitempty"`
+	// Exclude holds the logins of contributors excluded from the reward
+	Exclude []string `json:"exclude,omitempty"`
+	// Severity replaces the severity read from the issue labels
+	Severity *model.IssueSeverity `json:"severity,omitempty"`
+}
+
+// Apply returns a copy of the overrides with the commands applied in order.
+func (o RewardOverrides) Apply(commands []Command) RewardOverrides {
+	applied := RewardOverrides{
+		Split:    o.Split,
+		Exclude:  append([]string{}, o.Exclude...),
+		Severity: o.Severity,
+	}
+
+	for _, command := range commands {
+		switch command.Name {
+		case SplitCommand:
+			applied.Split = command.Split
+		case ExcludeCommand:
+			for _, login := range command.Logins {
+				if !applied.IsExcluded(login) {
+					applied.Exclude = append(applied.Exclude, login)
+				}
+			}
+		case SeverityCommand:
+			severity := command.Severity
+			applied.Severity = &severity
+		case RecalcCommand:
+			// Recalculation does not change the overrides
+		}
+	}
+
+	if len(applied.Exclude) == 0 {
+		applied.Exclude = nil
+	}
+
+	return applied
+}
+
+// IsZero returns true if no override is set.
+func (o RewardOverrides) IsZero() bool {
+	return len(o.Split) == 0 && len(o.Exclude) == 0 && o.Severity == nil
+}
+
+// IsExcluded returns true if the contributor with the login is excluded from the reward.
+// Logins are compared case-insensitively since GitHub treats them case-insensitively.
+func (o RewardOverrides) IsExcluded(login string) bool {
+	for _, excluded := range o.Exclude {
+		if strings.EqualFold(excluded, login) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,97 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/internal/famed/model"
+	model2 "github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+func TestRewardOverrides_Apply(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	high := model2.High
+	overrides := model.RewardOverrides{Exclude: []string{"bot"}}
+	commands := []model.Command{
+		{Name: model.SplitCommand, Split: map[string]float64{"alice": 60, "bob": 40}},
+		{Name: model.ExcludeCommand, Logins: []string{"Bot", "dependabot"}},
+		{Name: model.SeverityCommand, Severity: model2.High},
+		{Name: model.RecalcCommand},
+	}
+
+	// WHEN
+	applied := overrides.Apply(commands)
+
+	// THEN
+	assert.Equal(t, model.RewardOverrides{
+		Split:    map[string]float64{"alice": 60, "bob": 40},
+		Exclude:  []string{"bot", "dependabot"},
+		Severity: &high,
+	}, applied)
+	assert.Equal(t, model.RewardOverrides{Exclude: []string{"bot"}}, overrides)
+	assert.True(t, model.RewardOverrides{}.Apply([]model.Command{{Name: model.RecalcCommand}}).IsZero())
+}
+
+func TestUpdateRewards_Overrides(t *testing.T) {
+	t.Parallel()
+
+	open := time.Date(2022, 4, 4, 0, 0, 0, 0, time.UTC)
+	closed := open.Add(24 * time.Hour)
+
+	testCases := []struct {
+		Name      string
+		Overrides model.RewardOverrides
+		Expected  map[string]float64
+	}{
+		{
+			Name:     "No overrides",
+			Expected: map[string]float64{"alice": 750, "bot": 250},
+		},
+		{
+			Name:      "Exclude",
+			Overrides: model.RewardOverrides{Exclude: []string{"bot"}},
+			Expected:  map[string]float64{"alice": 1000, "bot": 0},
+		},
+		{
+			Name:      "Split",
+			Overrides: model.RewardOverrides{Split: map[string]float64{"Alice": 60, "bob": 40}},
+			Expected:  map[string]float64{"alice": 600, "bob": 400, "bot": 0},
+		},
+		{
+			Name:      "Split with exclusion",
+			Overrides: model.RewardOverrides{Split: map[string]float64{"alice": 1, "bot": 1}, Exclude: []string{"bot"}},
+			Expected:  map[string]float64{"alice": 1000, "bot": 0},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// GIVEN
+			boardOptions := model.NewBoardOptions("POINTS", model.NewFlatRewardStructure(map[model2.IssueSeverity]float64{model2.Low: 1000}), model.Month, model.Window{}, closed)
+			contributors := model.Contributors{
+				"alice": {Login: "alice", RewardsLastYear: model.NewRewardsLastYear(closed)},
+				"bot":   {Login: "bot", RewardsLastYear: model.NewRewardsLastYear(closed)},
+			}
+			workLogs := model.WorkLogs{
+				"alice": {{Start: open, End: open.Add(18 * time.Hour)}},
+				"bot":   {{Start: open, End: open.Add(6 * time.Hour)}},
+			}
+
+			// WHEN
+			contributors.UpdateRewards("TestURL", workLogs, open, closed, 0, model2.Low, testCase.Overrides, boardOptions)
+
+			// THEN
+			rewards := make(map[string]float64, len(contributors))
+			for login, contributor := range contributors {
+				rewards[login] = contributor.RewardSum
+			}
+			assert.Equal(t, testCase.Expected, rewards)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+// RepoContribution represents the share of a contributor's board entry coming from a single repository.
+type RepoContribution struct {
+	RewardSum  float64                     `json:"rewardSum"`
+	FixCount   int                         `json:"fixCount"`
+	Severities map[model.IssueSeverity]int `json:"severities"`
+}
+
+// NewOwnerTeam merges the teams of multiple repositories, mapped by repository name, into a single team.
+// Each contributor of the resulting team holds a per repository breakdown of its contributions.
+func NewOwnerTeam(repoTeams map[string][]*Contributor) []*Contributor {
+	contributors := Contributors{}
+	for repoName, team := range repoTeams {
+		for _, contributor := range team {
+			contributors.mergeRepoContributor(repoName, contributor)
+		}
+	}
+
+	contributors.updateMeanAndDeviationOfDisclosure()
+	contributors.updateAverageSeverity()
+
+	return contributors.toSortedSlice()
+}
+
+// mergeRepoContributor merges a contributor of a repository's team into the contributors' map.
+func (cs Contributors) mergeRepoContributor(repoName string, repoContributor *Contributor) {
+	contributor, ok := cs[repoContributor.Login]
+	if !ok {
+		contributor = &Contributor{
+			Login:           repoContributor.Login,
+			AvatarURL:       repoContributor.AvatarURL,
+			HTMLURL:         repoContributor.HTMLURL,
+			Rewards:         []RewardEvent{},
+			Currency:        repoContributor.Currency,
+			RewardsLastYear: repoContributor.RewardsLastYear.emptyCopy(),
+			Severities:      map[model.IssueSeverity]int{},
+			Repos:           map[string]RepoContribution{},
+		}
+		cs[repoContributor.Login] = contributor
+	}
+
+	contributor.FixCount += repoContributor.FixCount
+	contributor.Rewards = append(contributor.Rewards, repoContributor.Rewards...)
+	contributor.RewardSum += repoContributor.RewardSum
+	contributor.RewardsLastYear.add(repoContributor.RewardsLastYear)
+	contributor.TimeToDisclosure.Time = append(contributor.TimeToDisclosure.Time, repoContributor.TimeToDisclosure.Time...)
+
+	repoSeverities := make(map[model.IssueSeverity]int, len(repoContributor.Severities))
+	for severity, count := range repoContributor.Severities {
+		contributor.Severities[severity] += count
+		repoSeverities[severity] = count
+	}
+
+	contributor.Repos[repoName] = RepoContribution{
+		RewardSum:  repoContributor.RewardSum,
+		FixCount:   repoContributor.FixCount,
+		Severities: repoSeverities,
+	}
+}
@@ -0,0 +1,95 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/internal/famed/model"
+	model2 "github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+func TestNewOwnerTeam(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	now := time.Date(2022, 4, 20, 0, 0, 0, 0, time.UTC)
+	date := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	rewardsLastYear := model.NewRewardsLastYear(now)
+	rewardsLastYear[1].Reward = 100
+
+	repoTeams := map[string][]*model.Contributor{
+		"repo1": {
+			{
+				Login:            "testUser1",
+				FixCount:         1,
+				Rewards:          []model.RewardEvent{{Date: date, Reward: 100, URL: "TestURL1"}},
+				RewardSum:        100,
+				Currency:         "POINTS",
+				RewardsLastYear:  rewardsLastYear,
+				TimeToDisclosure: model.TimeToDisclosure{Time: []float64{60}},
+				Severities:       map[model2.IssueSeverity]int{model2.Low: 1},
+			},
+		},
+		"repo2": {
+			{
+				Login:            "testUser1",
+				FixCount:         1,
+				Rewards:          []model.RewardEvent{{Date: date, Reward: 100, URL: "TestURL2"}},
+				RewardSum:        100,
+				Currency:         "POINTS",
+				RewardsLastYear:  rewardsLastYear,
+				TimeToDisclosure: model.TimeToDisclosure{Time: []float64{180}},
+				Severities:       map[model2.IssueSeverity]int{model2.Medium: 1},
+			},
+			{
+				Login:            "testUser2",
+				FixCount:         1,
+				Rewards:          []model.RewardEvent{{Date: date, Reward: 50, URL: "TestURL3"}},
+				RewardSum:        50,
+				Currency:         "POINTS",
+				RewardsLastYear:  model.NewRewardsLastYear(now),
+				TimeToDisclosure: model.TimeToDisclosure{Time: []float64{60}},
+				Severities:       map[model2.IssueSeverity]int{model2.Low: 1},
+			},
+		},
+	}
+
+	// WHEN
+	team := model.NewOwnerTeam(repoTeams)
+
+	// THEN
+	assert.Len(t, team, 2)
+
+	first := team[0]
+	assert.Equal(t, "testUser1", first.Login)
+	assert.Equal(t, 2, first.FixCount)
+	assert.Equal(t, float64(200), first.RewardSum)
+	assert.Len(t, first.Rewards, 2)
+	assert.Equal(t, float64(200), first.RewardsLastYear[1].Reward)
+	assert.Equal(t, float64(100), rewardsLastYear[1].Reward)
+	assert.Equal(t, map[model2.IssueSeverity]int{model2.Low: 1, model2.Medium: 1}, first.Severities)
+	assert.Equal(t, float64(120), first.TimeToDisclosure.Mean)
+	assert.Equal(t, float64(60), first.TimeToDisclosure.StandardDeviation)
+	assert.Equal(t, 3.75, first.MeanSeverity)
+	assert.Equal(t, map[string]model.RepoContribution{
+		"repo1": {RewardSum: 100, FixCount: 1, Severities: map[model2.IssueSeverity]int{model2.Low: 1}},
+		"repo2": {RewardSum: 100, FixCount: 1, Severities: map[model2.IssueSeverity]int{model2.Medium: 1}},
+	}, first.Repos)
+
+	second := team[1]
+	assert.Equal(t, "testUser2", second.Login)
+	assert.Equal(t, float64(50), second.RewardSum)
+	assert.Equal(t, map[string]model.RepoContribution{
+		"repo2": {RewardSum: 50, FixCount: 1, Severities: map[model2.IssueSeverity]int{model2.Low: 1}},
+	}, second.Repos)
+}
+
+func TestNewOwnerTeamEmpty(t *testing.T) {
+	t.Parallel()
+
+	team := model.NewOwnerTeam(map[string][]*model.Contributor{"repo1": {}})
+
+	assert.Equal(t, []*model.Contributor{}, team)
+}
@@ -0,0 +1,66 @@
+package model
+
+import "github.com/pmezard/go-difflib/difflib"
+
+// CommentAction identifies a change to an issue comment.
+type CommentAction string
+
+const (
+	CommentPosted  CommentAction = "post"
+	CommentUpdated CommentAction = "update"
+	CommentDeleted CommentAction = "delete"
+)
+
+// PlannedComment represents a change to an issue comment that a dry run would have made.
+// Updated and deleted comments are identified by their comment ID, posted comments by their issue number.
+// Before holds the body of the comment prior to the change if known, Diff the changes of the body as unified diff.
+type PlannedComment struct {
+	Action      CommentAction `json:"action"`
+	Owner       string        `json:"owner"`
+	RepoName    string        `json:"repoName"`
+	IssueNumber int           `json:"issueNumber,omitempty"`
+	CommentID   int64         `json:"commentId,omitempty"`
+	Before      string        `json:"before,omitempty"`
+	Body        string        `json:"body,omitempty"`
+	Diff        string        `json:"diff,omitempty"`
+}
+
+// NewPlannedComment returns a new PlannedComment changing the body of a comment from before to after.
+func NewPlannedComment(action CommentAction, owner string, repoName string, issueNumber int, commentID int64, before string, after string) PlannedComment {
+	return PlannedComment{
+		Action:      action,
+		Owner:       owner,
+		RepoName:    repoName,
+		IssueNumber: issueNumber,
+		CommentID:   commentID,
+		Before:      before,
+		Body:        after,
+		Diff:        commentDiff(before, after),
+	}
+}
+
+// commentDiff returns the line based unified diff of two comment bodies.
+func commentDiff(before string, after string) string {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(before),
+		B:        splitLines(after),
+		FromFile: "before",
+		ToFile:   "after",
+		Context:  3,
+	})
+	if err != nil {
+		// Writing to a string builder does not fail
+		return ""
+	}
+
+	return diff
+}
+
+// splitLines splits a comment body into lines, an empty body has no lines.
+func splitLines(body string) []string {
+	if body == "" {
+		return nil
+	}
+
+	return difflib.SplitLines(body)
+}
@@ -0,0 +1,63 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/internal/famed/model"
+)
+
+func TestNewPlannedComment(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name         string
+		Action       model.CommentAction
+		Before       string
+		After        string
+		ExpectedDiff string
+	}{
+		{
+			Name:         "Post",
+			Action:       model.CommentPosted,
+			After:        "first\nsecond",
+			ExpectedDiff: "--- before\n+++ after\n@@ -0,0 +1,2 @@\n+first\n+second\n",
+		},
+		{
+			Name:         "Update",
+			Action:       model.CommentUpdated,
+			Before:       "first\nsecond",
+			After:        "first\nthird",
+			ExpectedDiff: "--- before\n+++ after\n@@ -1,2 +1,2 @@\n first\n-second\n+third\n",
+		},
+		{
+			Name:         "Update - No changes",
+			Action:       model.CommentUpdated,
+			Before:       "first",
+			After:        "first",
+			ExpectedDiff: "",
+		},
+		{
+			Name:         "Delete",
+			Action:       model.CommentDeleted,
+			Before:       "first",
+			ExpectedDiff: "--- before\n+++ after\n@@ -1 +0,0 @@\n-first\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// WHEN
+			plannedComment := model.NewPlannedComment(testCase.Action, "testOwner", "testRepo", 1, 2, testCase.Before, testCase.After)
+
+			// THEN
+			assert.Equal(t, testCase.Action, plannedComment.Action)
+			assert.Equal(t, testCase.Before, plannedComment.Before)
+			assert.Equal(t, testCase.After, plannedComment.Body)
+			assert.Equal(t, testCase.ExpectedDiff, plannedComment.Diff)
+		})
+	}
+}
@@ -1,20 +1,18 @@
 package model
 
 import (
-	"time"
-
 	"github.com/phuslu/log"
 
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 )
 
-func NewRedTeamFromIssues(issues []model.Issue, currency string, now time.Time) ([]*Contributor, error) {
+func NewRedTeamFromIssues(issues []model.Issue, boardOptions BoardOptions) ([]*Contributor, error) {
 	contributors := Contributors{}
 	if len(issues) == 0 {
 		return []*Contributor{}, nil
 	}
 
-	contributors.mapRedTeamFromIssues(issues, currency, now)
+	contributors.mapRedTeamFromIssues(issues, boardOptions)
 	contributors.updateMeanAndDeviationOfDisclosure()
 	contributors.updateAverageSeverity()
 
@@ -22,22 +20,27 @@ func NewRedTeamFromIssues(issues []model.Issue, currency string, now time.Time)
 }
 
 // mapBlueTeamIssue maps an issue to the contributors map.
-func (cs Contributors) mapRedTeamFromIssues(issues []model.Issue, currency string, now time.Time) {
+func (cs Contributors) mapRedTeamFromIssues(issues []model.Issue, boardOptions BoardOptions) {
 	for _, issue := range issues {
 		if issue.RedTeam == nil || issue.BountyPoints == nil || issue.ClosedAt == nil {
 			log.Warn().Msgf("[mapRedTeamFromIssues] issue with id: %d: is missing data", issue.ID)
 			continue
 		}
 
-		cs.mapRedTeamFromIssue(issue, currency, now)
+		// Skip issues closed outside the board's time window
+		if !boardOptions.Window.Contains(*issue.ClosedAt) {
+			continue
+		}
+
+		cs.mapRedTeamFromIssue(issue, boardOptions)
 	}
 }
 
 // mapBlueTeamIssue maps an issue to the contributors map.
-func (cs Contributors) mapRedTeamFromIssue(issue model.Issue, currency string, now time.Time) {
+func (cs Contributors) mapRedTeamFromIssue(issue model.Issue, boardOptions BoardOptions) {
 	// Get red team contributor from map
 	for _, teamer := range issue.RedTeam {
-		cs.mapAssigneeIfMissing(teamer, currency, now)
+		cs.mapAssigneeIfMissing(teamer, boardOptions)
 		contributor := cs[teamer.Login]
 
 		severity, err := issue.Severity()
@@ -46,6 +49,6 @@ func (cs Contributors) mapRedTeamFromIssue(issue model.Issue, currency string, n
 			return
 		}
 
-		contributor.mapIssue(issue.HTMLURL, issue.CreatedAt, *issue.ClosedAt, float64(*issue.BountyPoints)/float64(len(issue.RedTeam)), severity, now)
+		contributor.mapIssue(issue.HTMLURL, issue.CreatedAt, *issue.ClosedAt, float64(*issue.BountyPoints)/float64(len(issue.RedTeam)), severity, boardOptions)
 	}
 }
@@ -2,19 +2,108 @@ package model
 
 import (
 	"math"
+	"sort"
 	"time"
 
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 )
 
-type RewardStructure struct {
+// RewardFormula is the name of a formula used to calculate rewards.
+type RewardFormula string
+
+const (
+	// Polynomial decays the reward polynomially over the days to fix, reopens increase the degree of the polynomial.
+	Polynomial RewardFormula = "polynomial"
+	// Linear decays the reward linearly over the days to fix, each reopen lowers the reward by the reopen penalty.
+	Linear RewardFormula = "linear"
+	// Step rewards a factor of the severity reward depending on the first deadline met, each reopen lowers the reward by the reopen penalty.
+	Step RewardFormula = "step"
+	// Flat rewards the severity reward independent of the time to fix and reopens.
+	Flat RewardFormula = "flat"
+)
+
+// RewardFormulaOptions holds the formula used to calculate rewards and its parameters.
+type RewardFormulaOptions struct {
+	Formula       RewardFormula
+	KMultiplier   int
+	ReopenPenalty float64
+	Steps         []RewardStep
+}
+
+// RewardStep is a deadline of the step formula, issues fixed within Days are rewarded with Factor times the severity reward.
+type RewardStep struct {
+	Days   int
+	Factor float64
+}
+
+// RewardStructure calculates the reward of a fixed issue.
+type RewardStructure interface {
+	// Reward returns the reward for t (time the issue was open), k (number of times the issue was reopened) and the issue's severity.
+	Reward(t time.Duration, k int, severity model.IssueSeverity) float64
+	// Breakdown returns how the reward for t, k and the issue's severity is derived.
+	Breakdown(t time.Duration, k int, severity model.IssueSeverity) RewardBreakdown
+}
+
+// RewardBreakdown explains how the reward of an issue is derived from its severity, the time it was open and the number of times it was reopened.
+// The reward is the severity reward multiplied by the decay factor of the formula.
+type RewardBreakdown struct {
+	Formula        RewardFormula
+	Severity       model.IssueSeverity
+	SeverityReward float64
+	TimeOpen       time.Duration
+	// DaysToFix is the number of days after which the polynomial and linear formulas reward nothing
+	DaysToFix int
+	// ReopenCount is the number of times the issue was reopened (k)
+	ReopenCount   int
+	KMultiplier   int
+	ReopenPenalty float64
+	// StepDays and StepFactor are the first deadline met by the step formula, StepDays is 0 if no deadline was met
+	StepDays   int
+	StepFactor float64
+	Factor     float64
+	Reward     float64
+	// Split is true if the reward is divided by the split set by maintainers instead of the work of each contributor
+	Split bool
+}
+
+// DaysOpen returns the number of days the issue was open.
+func (b RewardBreakdown) DaysOpen() float64 {
+	return b.TimeOpen.Hours() / 24
+}
+
+// IsValid returns true if the formula is one of polynomial, linear, step or flat.
+func (f RewardFormula) IsValid() bool {
+	switch f {
+	case Polynomial, Linear, Step, Flat:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewRewardStructure returns the reward structure of the formula selected by the options.
+// If no formula is selected, the polynomial formula is used.
+func NewRewardStructure(severityReward map[model.IssueSeverity]float64, maxDaysToFix int, options RewardFormulaOptions) RewardStructure {
+	switch options.Formula {
+	case Linear:
+		return NewLinearRewardStructure(severityReward, maxDaysToFix, options.ReopenPenalty)
+	case Step:
+		return NewStepRewardStructure(severityReward, options.Steps, options.ReopenPenalty)
+	case Flat:
+		return NewFlatRewardStructure(severityReward)
+	default:
+		return NewPolynomialRewardStructure(severityReward, maxDaysToFix, options.KMultiplier)
+	}
+}
+
+type polynomialRewardStructure struct {
 	severityReward map[model.IssueSeverity]float64
 	maxDaysToFix   int
 	kMultiplier    int
 }
 
-func NewRewardStructure(severityReward map[model.IssueSeverity]float64, maxDaysToFix, kMultiplier int) RewardStructure {
-	return RewardStructure{
+func NewPolynomialRewardStructure(severityReward map[model.IssueSeverity]float64, maxDaysToFix, kMultiplier int) RewardStructure {
+	return polynomialRewardStructure{
 		severityReward: severityReward,
 		maxDaysToFix:   maxDaysToFix,
 		kMultiplier:    kMultiplier,
@@ -22,13 +111,164 @@ func NewRewardStructure(severityReward map[model.IssueSeverity]float64, maxDaysT
 }
 
 // Reward returns the base reward multiplied by the severity reward.
-func (RW RewardStructure) Reward(t time.Duration, k int, severity model.IssueSeverity) float64 {
+func (RW polynomialRewardStructure) Reward(t time.Duration, k int, severity model.IssueSeverity) float64 {
 	return RW.baseReward(t, k) * RW.severityReward[severity]
 }
 
+// Breakdown returns the base reward as decay factor.
+func (RW polynomialRewardStructure) Breakdown(t time.Duration, k int, severity model.IssueSeverity) RewardBreakdown {
+	return RewardBreakdown{
+		Formula:        Polynomial,
+		Severity:       severity,
+		SeverityReward: RW.severityReward[severity],
+		TimeOpen:       t,
+		DaysToFix:      RW.maxDaysToFix,
+		ReopenCount:    k,
+		KMultiplier:    RW.kMultiplier,
+		Factor:         RW.baseReward(t, k),
+		Reward:         RW.Reward(t, k, severity),
+	}
+}
+
 // reward returns the base reward for t (time the issue was open) and k (number of times the issue was reopened).
-func (RW RewardStructure) baseReward(t time.Duration, k int) float64 {
-	// 1 - t (in days) / 40 ^ 2*k+1
-	reward := math.Pow(1.0-t.Hours()/float64(RW.maxDaysToFix*24), float64(RW.kMultiplier)*float64(k)+1)
-	return math.Max(0, reward)
+func (RW polynomialRewardStructure) baseReward(t time.Duration, k int) float64 {
+	// max(0, 1 - t (in days) / 40) ^ 2*k+1, the base is clamped as an even exponent would turn an overdue issue's negative base positive
+	base := math.Max(0, 1.0-t.Hours()/float64(RW.maxDaysToFix*24))
+	return math.Pow(base, float64(RW.kMultiplier)*float64(k)+1)
+}
+
+type linearRewardStructure struct {
+	severityReward map[model.IssueSeverity]float64
+	maxDaysToFix   int
+	reopenPenalty  float64
+}
+
+func NewLinearRewardStructure(severityReward map[model.IssueSeverity]float64, maxDaysToFix int, reopenPenalty float64) RewardStructure {
+	return linearRewardStructure{
+		severityReward: severityReward,
+		maxDaysToFix:   maxDaysToFix,
+		reopenPenalty:  reopenPenalty,
+	}
+}
+
+// Reward returns the base reward multiplied by the severity reward.
+func (RW linearRewardStructure) Reward(t time.Duration, k int, severity model.IssueSeverity) float64 {
+	return RW.factor(t, k) * RW.severityReward[severity]
+}
+
+// Breakdown returns the base reward lowered by the reopens as decay factor.
+func (RW linearRewardStructure) Breakdown(t time.Duration, k int, severity model.IssueSeverity) RewardBreakdown {
+	return RewardBreakdown{
+		Formula:        Linear,
+		Severity:       severity,
+		SeverityReward: RW.severityReward[severity],
+		TimeOpen:       t,
+		DaysToFix:      RW.maxDaysToFix,
+		ReopenCount:    k,
+		ReopenPenalty:  RW.reopenPenalty,
+		Factor:         RW.factor(t, k),
+		Reward:         RW.Reward(t, k, severity),
+	}
+}
+
+// factor returns the base reward for t (time the issue was open) lowered by the reopen penalty for k (number of times the issue was reopened).
+func (RW linearRewardStructure) factor(t time.Duration, k int) float64 {
+	// 1 - t (in days) / 40
+	baseReward := math.Max(0, 1.0-t.Hours()/float64(RW.maxDaysToFix*24))
+	return baseReward * reopenFactor(k, RW.reopenPenalty)
+}
+
+type stepRewardStructure struct {
+	severityReward map[model.IssueSeverity]float64
+	steps          []RewardStep
+	reopenPenalty  float64
+}
+
+func NewStepRewardStructure(severityReward map[model.IssueSeverity]float64, steps []RewardStep, reopenPenalty float64) RewardStructure {
+	sortedSteps := make([]RewardStep, len(steps))
+	copy(sortedSteps, steps)
+	sort.Slice(sortedSteps, func(i, j int) bool {
+		return sortedSteps[i].Days < sortedSteps[j].Days
+	})
+
+	return stepRewardStructure{
+		severityReward: severityReward,
+		steps:          sortedSteps,
+		reopenPenalty:  reopenPenalty,
+	}
+}
+
+// Reward returns the factor of the first deadline met multiplied by the severity reward.
+// If no deadline is met, the reward is 0.
+func (RW stepRewardStructure) Reward(t time.Duration, k int, severity model.IssueSeverity) float64 {
+	step, ok := RW.step(t)
+	if !ok {
+		return 0
+	}
+
+	return step.Factor * reopenFactor(k, RW.reopenPenalty) * RW.severityReward[severity]
+}
+
+// Breakdown returns the factor of the first deadline met lowered by the reopens as decay factor.
+func (RW stepRewardStructure) Breakdown(t time.Duration, k int, severity model.IssueSeverity) RewardBreakdown {
+	breakdown := RewardBreakdown{
+		Formula:        Step,
+		Severity:       severity,
+		SeverityReward: RW.severityReward[severity],
+		TimeOpen:       t,
+		ReopenCount:    k,
+		ReopenPenalty:  RW.reopenPenalty,
+		Reward:         RW.Reward(t, k, severity),
+	}
+	if step, ok := RW.step(t); ok {
+		breakdown.StepDays = step.Days
+		breakdown.StepFactor = step.Factor
+		breakdown.Factor = step.Factor * reopenFactor(k, RW.reopenPenalty)
+	}
+
+	return breakdown
+}
+
+// step returns the first deadline met for t (time the issue was open) and false if no deadline is met.
+func (RW stepRewardStructure) step(t time.Duration) (RewardStep, bool) {
+	for _, step := range RW.steps {
+		if t <= time.Duration(step.Days)*24*time.Hour {
+			return step, true
+		}
+	}
+
+	return RewardStep{}, false
+}
+
+type flatRewardStructure struct {
+	severityReward map[model.IssueSeverity]float64
+}
+
+func NewFlatRewardStructure(severityReward map[model.IssueSeverity]float64) RewardStructure {
+	return flatRewardStructure{
+		severityReward: severityReward,
+	}
+}
+
+// Reward returns the severity reward.
+func (RW flatRewardStructure) Reward(_ time.Duration, _ int, severity model.IssueSeverity) float64 {
+	return RW.severityReward[severity]
+}
+
+// Breakdown returns a decay factor of 1.
+func (RW flatRewardStructure) Breakdown(t time.Duration, k int, severity model.IssueSeverity) RewardBreakdown {
+	return RewardBreakdown{
+		Formula:        Flat,
+		Severity:       severity,
+		SeverityReward: RW.severityReward[severity],
+		TimeOpen:       t,
+		ReopenCount:    k,
+		Factor:         1,
+		Reward:         RW.Reward(t, k, severity),
+	}
+}
+
+// reopenFactor returns the factor a reward is lowered by for k (number of times the issue was reopened).
+func reopenFactor(k int, reopenPenalty float64) float64 {
+	return math.Max(0, 1.0-float64(k)*reopenPenalty)
 }
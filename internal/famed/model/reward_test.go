@@ -92,6 +92,7 @@ func TestUpdateReward(t *testing.T) {
 				TimeToDisclosure: model.TimeToDisclosure{},
 				Severities:       nil,
 				MeanSeverity:     0,
+				WorkShare:        1,
 			}},
 		},
 		{
@@ -135,6 +136,7 @@ func TestUpdateReward(t *testing.T) {
 					TimeToDisclosure: model.TimeToDisclosure{},
 					Severities:       nil,
 					MeanSeverity:     0,
+					WorkShare:        0.5,
 				},
 				"TestUser2": {
 					Login:            "TestUser2",
@@ -147,6 +149,7 @@ func TestUpdateReward(t *testing.T) {
 					TimeToDisclosure: model.TimeToDisclosure{},
 					Severities:       nil,
 					MeanSeverity:     0,
+					WorkShare:        0.5,
 				},
 			},
 		},
@@ -204,6 +207,7 @@ func TestUpdateReward(t *testing.T) {
 					Severities:       nil,
 					MeanSeverity:     0,
 					TotalWorkTime:    24 * time.Hour,
+					WorkShare:        1,
 				},
 			},
 		},
@@ -249,6 +253,7 @@ func TestUpdateReward(t *testing.T) {
 				Severities:       nil,
 				MeanSeverity:     0,
 				TotalWorkTime:    86400000000000,
+				WorkShare:        1,
 			}},
 		},
 	}
@@ -258,9 +263,9 @@ func TestUpdateReward(t *testing.T) {
 		t.Run(tC.Name, func(t *testing.T) {
 			t.Parallel()
 			// WHEN
-			rewardStructure := model.NewRewardStructure(map[model2.IssueSeverity]float64{model2.Low: 1}, 40, 2)
-			BoardOptions := model.NewBoardOptions("POINTS", rewardStructure, time.Date(2022, 4, 4, 0, 0, 0, 0, time.UTC))
-			tC.Contributors.UpdateRewards("TestURL", tC.WorkLogs, tC.Open, tC.Close, tC.K, model2.Low, BoardOptions)
+			rewardStructure := model.NewPolynomialRewardStructure(map[model2.IssueSeverity]float64{model2.Low: 1}, 40, 2)
+			BoardOptions := model.NewBoardOptions("POINTS", rewardStructure, model.Month, model.Window{}, time.Date(2022, 4, 4, 0, 0, 0, 0, time.UTC))
+			tC.Contributors.UpdateRewards("TestURL", tC.WorkLogs, tC.Open, tC.Close, tC.K, model2.Low, model.RewardOverrides{}, BoardOptions)
 
 			// THEN
 			assert.Equal(t, tC.Expected, tC.Contributors)
@@ -320,7 +325,7 @@ func TestReward(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			t.Parallel()
 			// WHEN
-			rewardStructure := model.NewRewardStructure(map[model2.IssueSeverity]float64{model2.Low: 1}, 40, 2)
+			rewardStructure := model.NewPolynomialRewardStructure(map[model2.IssueSeverity]float64{model2.Low: 1}, 40, 2)
 			severityResult := rewardStructure.Reward(testCase.T, testCase.K, model2.Low)
 
 			// THEN
@@ -328,3 +333,107 @@ func TestReward(t *testing.T) {
 		})
 	}
 }
+
+func TestRewardFormulas(t *testing.T) {
+	t.Parallel()
+
+	severityReward := map[model2.IssueSeverity]float64{model2.Low: 1000}
+	steps := []model.RewardStep{{Days: 30, Factor: 0.5}, {Days: 7, Factor: 1}}
+
+	testCases := []struct {
+		Name     string
+		Options  model.RewardFormulaOptions
+		T        time.Duration
+		K        int
+		Expected float64
+	}{
+		{
+			Name:     "Default polynomial",
+			Options:  model.RewardFormulaOptions{KMultiplier: 2},
+			T:        time.Hour * 24 * 20,
+			K:        0,
+			Expected: 500,
+		},
+		{
+			Name:     "Polynomial with reopens",
+			Options:  model.RewardFormulaOptions{Formula: model.Polynomial, KMultiplier: 2},
+			T:        time.Hour * 24 * 20,
+			K:        2,
+			Expected: 31.25,
+		},
+		{
+			Name:     "Polynomial after days to fix with even exponent",
+			Options:  model.RewardFormulaOptions{Formula: model.Polynomial, KMultiplier: 1},
+			T:        time.Hour * 24 * 50,
+			K:        1,
+			Expected: 0,
+		},
+		{
+			Name:     "Linear",
+			Options:  model.RewardFormulaOptions{Formula: model.Linear, ReopenPenalty: 0.25},
+			T:        time.Hour * 24 * 10,
+			K:        0,
+			Expected: 750,
+		},
+		{
+			Name:     "Linear with reopens",
+			Options:  model.RewardFormulaOptions{Formula: model.Linear, ReopenPenalty: 0.25},
+			T:        time.Hour * 24 * 10,
+			K:        2,
+			Expected: 375,
+		},
+		{
+			Name:     "Linear after days to fix",
+			Options:  model.RewardFormulaOptions{Formula: model.Linear, ReopenPenalty: 0.25},
+			T:        time.Hour * 24 * 50,
+			K:        0,
+			Expected: 0,
+		},
+		{
+			Name:     "Step first deadline",
+			Options:  model.RewardFormulaOptions{Formula: model.Step, Steps: steps},
+			T:        time.Hour * 24 * 7,
+			K:        0,
+			Expected: 1000,
+		},
+		{
+			Name:     "Step second deadline with reopen",
+			Options:  model.RewardFormulaOptions{Formula: model.Step, Steps: steps, ReopenPenalty: 0.1},
+			T:        time.Hour * 24 * 8,
+			K:        1,
+			Expected: 450,
+		},
+		{
+			Name:     "Step no deadline met",
+			Options:  model.RewardFormulaOptions{Formula: model.Step, Steps: steps},
+			T:        time.Hour * 24 * 31,
+			K:        0,
+			Expected: 0,
+		},
+		{
+			Name:     "Flat",
+			Options:  model.RewardFormulaOptions{Formula: model.Flat},
+			T:        time.Hour * 24 * 100,
+			K:        5,
+			Expected: 1000,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// GIVEN
+			rewardStructure := model.NewRewardStructure(severityReward, 40, testCase.Options)
+
+			// WHEN
+			reward := rewardStructure.Reward(testCase.T, testCase.K, model2.Low)
+			breakdown := rewardStructure.Breakdown(testCase.T, testCase.K, model2.Low)
+
+			// THEN
+			assert.InDelta(t, testCase.Expected, reward, 0.000001)
+			assert.InDelta(t, testCase.Expected, breakdown.Reward, 0.000001)
+			assert.InDelta(t, testCase.Expected, breakdown.Factor*breakdown.SeverityReward, 0.000001)
+		})
+	}
+}
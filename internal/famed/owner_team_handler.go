@@ -0,0 +1,62 @@
+package famed
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/morphysm/famed-github-backend/internal/famed/model"
+)
+
+// teamFunc returns the team of a single repository limited to a time window.
+type teamFunc func(ctx context.Context, owner string, repoName string, window model.Window) ([]*model.Contributor, error)
+
+// GetOwnerBlueTeam returns a list of contributors for the famed board of all repositories of an owner.
+func (gH *githubHandler) GetOwnerBlueTeam(c echo.Context) error {
+	return gH.getOwnerTeam(c, gH.blueTeam)
+}
+
+// GetOwnerRedTeam returns a list of red teamers for the famed board of all repositories of an owner.
+func (gH *githubHandler) GetOwnerRedTeam(c echo.Context) error {
+	return gH.getOwnerTeam(c, gH.redTeam)
+}
+
+// getOwnerTeam merges the teams returned by team for all repositories of the owner into a single sorted team.
+func (gH *githubHandler) getOwnerTeam(c echo.Context, team teamFunc) error {
+	owner := c.Param("owner")
+	if owner == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, model.ErrMissingOwnerPathParameter.Error())
+	}
+
+	window, err := gH.parseWindow(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if ok := gH.githubInstallationClient.CheckInstallation(owner); !ok {
+		return echo.NewHTTPError(http.StatusBadRequest, model.ErrAppNotInstalled.Error())
+	}
+
+	ctx := c.Request().Context()
+	repos, err := gH.githubInstallationClient.GetRepos(ctx, owner)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadGateway, err.Error())
+	}
+
+	repoTeams := make(map[string][]*model.Contributor, len(repos))
+	for _, repoName := range repos {
+		if gH.repoHidden(owner, repoName) {
+			continue
+		}
+
+		repoTeam, err := team(ctx, owner, repoName, window)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadGateway, err.Error())
+		}
+
+		repoTeams[repoName] = repoTeam
+	}
+
+	return c.JSON(http.StatusOK, model.NewOwnerTeam(repoTeams))
+}
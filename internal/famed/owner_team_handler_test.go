@@ -0,0 +1,98 @@
+package famed_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/internal/famed"
+	model2 "github.com/morphysm/famed-github-backend/internal/famed/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers/providersfakes"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage/storagefakes"
+)
+
+func TestGetOwnerBlueTeam(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name             string
+		Owner            string
+		AppInstalled     bool
+		Repos            []string
+		ReposErr         error
+		Boards           map[string]storage.Board
+		ExpectedResponse string
+		ExpectedErr      error
+	}{
+		{
+			Name:        "Missing Owner",
+			Owner:       "",
+			ExpectedErr: echo.NewHTTPError(http.StatusBadRequest, model2.ErrMissingOwnerPathParameter.Error()),
+		},
+		{
+			Name:         "Not Installed",
+			Owner:        "testOwner",
+			AppInstalled: false,
+			ExpectedErr:  echo.NewHTTPError(http.StatusBadRequest, model2.ErrAppNotInstalled.Error()),
+		},
+		{
+			Name:         "Repos Error",
+			Owner:        "testOwner",
+			AppInstalled: true,
+			ReposErr:     errors.New("testError"),
+			ExpectedErr:  echo.NewHTTPError(http.StatusBadGateway, "testError"),
+		},
+		{
+			Name:         "Valid - Two Repos",
+			Owner:        "testOwner",
+			AppInstalled: true,
+			Repos:        []string{"repo1", "repo2"},
+			Boards: map[string]storage.Board{
+				"repo1": {Contributors: []*model2.Contributor{{Login: "testUser", FixCount: 1, RewardSum: 100, Rewards: []model2.RewardEvent{}, Severities: map[model.IssueSeverity]int{model.Low: 1}}}},
+				"repo2": {Contributors: []*model2.Contributor{{Login: "testUser", FixCount: 1, RewardSum: 50, Rewards: []model2.RewardEvent{}, Severities: map[model.IssueSeverity]int{model.Low: 1}}}},
+			},
+			ExpectedResponse: "[{\"login\":\"testUser\",\"avatarUrl\":\"\",\"htmlUrl\":\"\",\"fixCount\":2,\"rewards\":[],\"rewardSum\":150,\"currency\":\"\",\"rewardsLastYear\":[],\"timeToDisclosure\":{\"time\":null,\"mean\":0,\"standardDeviation\":0},\"severities\":{\"low\":2},\"meanSeverity\":2,\"repos\":{\"repo1\":{\"rewardSum\":100,\"fixCount\":1,\"severities\":{\"low\":1}},\"repo2\":{\"rewardSum\":50,\"fixCount\":1,\"severities\":{\"low\":1}}}}]\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// GIVEN
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/famed/owners/"+testCase.Owner+"/contributors", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames([]string{"owner"}...)
+			ctx.SetParamValues([]string{testCase.Owner}...)
+
+			fakeInstallationClient := &providersfakes.FakeInstallationClient{}
+			fakeInstallationClient.CheckInstallationReturns(testCase.AppInstalled)
+			fakeInstallationClient.GetReposReturns(testCase.Repos, testCase.ReposErr)
+			fakeStore := &storagefakes.FakeStore{}
+			fakeStore.GetBoardStub = func(owner string, repoName string, team storage.Team) (storage.Board, bool, error) {
+				board, ok := testCase.Boards[repoName]
+				return board, ok, nil
+			}
+
+			githubHandler := famed.NewHandler(nil, fakeInstallationClient, fakeStore, NewTestConfig(), Now)
+
+			// WHEN
+			err := githubHandler.GetOwnerBlueTeam(ctx)
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedErr, err)
+			if testCase.ExpectedResponse != "" {
+				assert.Equal(t, 0, fakeInstallationClient.GetEnrichedIssuesCallCount())
+				assert.Equal(t, testCase.ExpectedResponse, rec.Body.String())
+			}
+		})
+	}
+}
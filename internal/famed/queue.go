@@ -0,0 +1,218 @@
+package famed
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/phuslu/log"
+
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage"
+)
+
+// deadJobsLimit is the number of most recent dead-lettered jobs kept for inspection.
+const deadJobsLimit = 1000
+
+// jobHandler processes a single job of the event queue.
+type jobHandler func(ctx context.Context, job storage.Job) error
+
+// eventQueue processes the webhook events persisted as jobs in the store.
+// Jobs sharing a key are processed one at a time in the order they were enqueued,
+// while jobs of different keys are processed concurrently.
+// Failed jobs are retried with an exponential backoff and dead-lettered once they run out of attempts
+// or failed with an error retrying cannot resolve.
+type eventQueue struct {
+	store       storage.Store
+	handle      jobHandler
+	now         func() time.Time
+	maxAttempts int
+	backoff     time.Duration
+
+	wake    chan struct{}
+	mu      sync.Mutex
+	running map[string]bool
+	// workers tracks the jobs being processed
+	workers sync.WaitGroup
+	// stopped is closed once the queue stopped and all jobs being processed returned
+	stopped chan struct{}
+}
+
+// newEventQueue returns a pointer to an event queue.
+func newEventQueue(store storage.Store, handle jobHandler, now func() time.Time, maxAttempts int, backoff time.Duration) *eventQueue {
+	return &eventQueue{
+		store:       store,
+		handle:      handle,
+		now:         now,
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+		wake:        make(chan struct{}, 1),
+		running:     make(map[string]bool),
+		stopped:     make(chan struct{}),
+	}
+}
+
+// enqueue persists an event as a job and wakes the queue.
+func (q *eventQueue) enqueue(key string, eventType string, deliveryID string, payload []byte) error {
+	// The status is set before the job is stored to not overwrite the status set by a worker processing the job
+	q.setDeliveryStatus(deliveryID, storage.DeliveryQueued, "")
+
+	now := q.now()
+	_, err := q.store.PutJob(storage.Job{
+		Key:           key,
+		EventType:     eventType,
+		Payload:       payload,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		DeliveryID:    deliveryID,
+	})
+	if err != nil {
+		return err
+	}
+
+	q.notify()
+	return nil
+}
+
+// notify wakes the queue without blocking, a pending wake up already covers the new state.
+func (q *eventQueue) notify() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// run dispatches due jobs until the context is cancelled, then waits for the jobs being processed.
+// Jobs persisted before a restart are picked up on the first dispatch.
+func (q *eventQueue) run(ctx context.Context) {
+	defer close(q.stopped)
+
+	for {
+		var retry <-chan time.Time
+		next, err := q.dispatch(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("[run] error while dispatching jobs")
+			retry = time.After(q.backoff)
+		} else if !next.IsZero() {
+			retry = time.After(next.Sub(q.now()))
+		}
+
+		select {
+		case <-ctx.Done():
+			q.workers.Wait()
+			return
+		case <-q.wake:
+		case <-retry:
+		}
+	}
+}
+
+// dispatch starts processing the first job of every key that is not being processed and is due.
+// It returns the time the earliest job not yet due becomes due, or the zero time if there is none.
+func (q *eventQueue) dispatch(ctx context.Context) (time.Time, error) {
+	jobs, err := q.store.GetJobs()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var (
+		now  = q.now()
+		next time.Time
+		seen = make(map[string]bool)
+	)
+	for _, job := range jobs {
+		// Only the first job of a key is eligible to keep the jobs of a key in order
+		if seen[job.Key] {
+			continue
+		}
+		seen[job.Key] = true
+
+		if q.running[job.Key] {
+			continue
+		}
+
+		if job.NextAttemptAt.After(now) {
+			if next.IsZero() || job.NextAttemptAt.Before(next) {
+				next = job.NextAttemptAt
+			}
+			continue
+		}
+
+		q.running[job.Key] = true
+		q.workers.Add(1)
+		go q.process(ctx, job)
+	}
+
+	return next, nil
+}
+
+// process handles a job and removes, reschedules or dead-letters it depending on the outcome.
+// A job interrupted by the queue stopping is kept as is to be processed again after a restart.
+func (q *eventQueue) process(ctx context.Context, job storage.Job) {
+	defer func() {
+		q.mu.Lock()
+		delete(q.running, job.Key)
+		q.mu.Unlock()
+		q.notify()
+		q.workers.Done()
+	}()
+
+	err := q.handle(ctx, job)
+	if err != nil && ctx.Err() != nil {
+		log.Warn().Msgf("[process] keeping job %d of %s interrupted by the queue stopping: %v", job.ID, job.Key, err)
+		return
+	}
+	if err == nil {
+		if err := q.store.DeleteJob(job.ID); err != nil {
+			log.Error().Err(err).Msgf("[process] error while deleting job %d", job.ID)
+		}
+		q.setDeliveryStatus(job.DeliveryID, storage.DeliveryProcessed, "")
+		return
+	}
+
+	job.Attempts++
+	job.LastError = err.Error()
+	if job.Attempts >= q.maxAttempts || isPermanentError(err) {
+		log.Error().Err(err).Msgf("[process] dead-lettering job %d of %s after %d attempts", job.ID, job.Key, job.Attempts)
+		if err := q.store.DeadLetterJob(job, deadJobsLimit); err != nil {
+			log.Error().Err(err).Msgf("[process] error while dead-lettering job %d", job.ID)
+		}
+		q.setDeliveryStatus(job.DeliveryID, storage.DeliveryDeadLettered, job.LastError)
+		return
+	}
+
+	job.NextAttemptAt = q.now().Add(q.backoff << (job.Attempts - 1))
+	log.Warn().Msgf("[process] retrying job %d of %s at %s: %v", job.ID, job.Key, job.NextAttemptAt, err)
+	if _, err := q.store.PutJob(job); err != nil {
+		log.Error().Err(err).Msgf("[process] error while rescheduling job %d", job.ID)
+	}
+	q.setDeliveryStatus(job.DeliveryID, storage.DeliveryRetrying, job.LastError)
+}
+
+// setDeliveryStatus updates the status of the delivery a job was enqueued for, errors are logged since the status is only informational.
+func (q *eventQueue) setDeliveryStatus(deliveryID string, status storage.DeliveryStatus, lastError string) {
+	if deliveryID == "" {
+		return
+	}
+
+	if err := q.store.SetDeliveryStatus(deliveryID, status, lastError, q.now()); err != nil {
+		log.Error().Err(err).Msgf("[setDeliveryStatus] error while updating delivery %s", deliveryID)
+	}
+}
+
+// isPermanentError returns whether retrying a job cannot resolve the error it failed with.
+// Client errors are permanent, server and GitHub API errors are not.
+// Unhandled events are not failures, their handlers return nil.
+func isPermanentError(err error) bool {
+	var httpError *echo.HTTPError
+	if errors.As(err, &httpError) {
+		return httpError.Code < http.StatusInternalServerError
+	}
+
+	return false
+}
@@ -0,0 +1,33 @@
+package famed
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage"
+)
+
+type eventQueueState struct {
+	Pending []storage.Job `json:"pending"`
+	Dead    []storage.Job `json:"dead"`
+}
+
+// GetEventQueue returns the webhook events waiting to be processed and the events that were given up on.
+func (gH *githubHandler) GetEventQueue(c echo.Context) error {
+	pending, err := gH.store.GetJobs()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	dead, err := gH.store.GetDeadJobs()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	state := eventQueueState{Pending: []storage.Job{}, Dead: []storage.Job{}}
+	state.Pending = append(state.Pending, pending...)
+	state.Dead = append(state.Dead, dead...)
+
+	return c.JSON(http.StatusOK, state)
+}
@@ -0,0 +1,262 @@
+package famed_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v41/github"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/internal/famed"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage"
+	"github.com/morphysm/famed-github-backend/pkg/pointer"
+)
+
+func TestEventQueue(t *testing.T) {
+	t.Parallel()
+
+	closedEvent := &github.IssuesEvent{
+		Action: pointer.String("closed"),
+		Issue: &github.Issue{
+			ID:        pointer.Int64(0),
+			Title:     pointer.String("test"),
+			HTMLURL:   pointer.String("TestURL"),
+			Labels:    []*github.Label{{Name: pointer.String("famed")}},
+			Number:    pointer.Int(1),
+			CreatedAt: pointer.Time(time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)),
+			ClosedAt:  pointer.Time(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		Repo: &github.Repository{
+			Name:  pointer.String("test"),
+			Owner: &github.User{Login: pointer.String("test")},
+		},
+	}
+	b, _ := json.Marshal(closedEvent)
+	var payload map[string]interface{}
+	_ = json.Unmarshal(b, &payload)
+	errGitHub := errors.New("GitHub unavailable")
+
+	testCases := []struct {
+		Name                      string
+		GetCommentsErrs           []error
+		ExpectedPostCommentCalls  int
+		ExpectedDeadJobs          int
+		ExpectedDeadJobsAttempts  int
+		ExpectedGetCommentsCalls  int
+		ExpectedDeadJobsLastError string
+	}{
+		{
+			Name:                     "Processed",
+			ExpectedPostCommentCalls: 1,
+			ExpectedGetCommentsCalls: 1,
+		},
+		{
+			Name:                     "Retried",
+			GetCommentsErrs:          []error{errGitHub, errGitHub},
+			ExpectedPostCommentCalls: 1,
+			ExpectedGetCommentsCalls: 3,
+		},
+		{
+			Name:                      "Dead-lettered",
+			GetCommentsErrs:           []error{errGitHub, errGitHub, errGitHub},
+			ExpectedDeadJobs:          1,
+			ExpectedDeadJobsAttempts:  3,
+			ExpectedGetCommentsCalls:  3,
+			ExpectedDeadJobsLastError: errGitHub.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// GIVEN
+			fakeInstallationClient, _, ctx := newIssuesEventTest(t, payload)
+			cl, _ := providers.NewInstallationClient("", nil, nil, "", "famed", nil)
+			fakeInstallationClient.ParseWebHookEventStub = cl.ParseWebHookEvent
+			for i, err := range testCase.GetCommentsErrs {
+				fakeInstallationClient.GetCommentsReturnsOnCall(i, nil, err)
+			}
+
+			store, err := storage.NewBoltStore(filepath.Join(t.TempDir(), "famed.db"))
+			assert.NoError(t, err)
+			t.Cleanup(func() { _ = store.Close() })
+
+			queueCtx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+			githubHandler := famed.NewHandler(nil, fakeInstallationClient, store, NewTestConfig(), Now)
+			githubHandler.StartEventQueue(queueCtx, 3, time.Millisecond)
+
+			// WHEN
+			err = githubHandler.PostEvent(ctx)
+
+			// THEN
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusAccepted, ctx.Response().Status)
+			assert.Eventually(t, func() bool {
+				jobs, err := store.GetJobs()
+				return err == nil && len(jobs) == 0
+			}, time.Second, time.Millisecond)
+			assert.Equal(t, testCase.ExpectedGetCommentsCalls, fakeInstallationClient.GetCommentsCallCount())
+			assert.Equal(t, testCase.ExpectedPostCommentCalls, fakeInstallationClient.PostCommentCallCount())
+			deadJobs, err := store.GetDeadJobs()
+			assert.NoError(t, err)
+			assert.Len(t, deadJobs, testCase.ExpectedDeadJobs)
+			if testCase.ExpectedDeadJobs > 0 {
+				assert.Equal(t, testCase.ExpectedDeadJobsAttempts, deadJobs[0].Attempts)
+				assert.Equal(t, testCase.ExpectedDeadJobsLastError, deadJobs[0].LastError)
+			}
+		})
+	}
+}
+
+func TestEventQueueStop(t *testing.T) {
+	t.Parallel()
+
+	closedEvent := &github.IssuesEvent{
+		Action: pointer.String("closed"),
+		Issue: &github.Issue{
+			ID:        pointer.Int64(0),
+			Title:     pointer.String("test"),
+			HTMLURL:   pointer.String("TestURL"),
+			Labels:    []*github.Label{{Name: pointer.String("famed")}},
+			Number:    pointer.Int(1),
+			CreatedAt: pointer.Time(time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)),
+			ClosedAt:  pointer.Time(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		Repo: &github.Repository{
+			Name:  pointer.String("test"),
+			Owner: &github.User{Login: pointer.String("test")},
+		},
+	}
+	b, _ := json.Marshal(closedEvent)
+	var payload map[string]interface{}
+	_ = json.Unmarshal(b, &payload)
+
+	// GIVEN
+	fakeInstallationClient, _, ctx := newIssuesEventTest(t, payload)
+	cl, _ := providers.NewInstallationClient("", nil, nil, "", "famed", nil)
+	fakeInstallationClient.ParseWebHookEventStub = cl.ParseWebHookEvent
+	// The job is processed until the queue is stopped
+	fakeInstallationClient.GetCommentsStub = func(ctx context.Context, _ string, _ string, _ int) ([]model.IssueComment, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	store, err := storage.NewBoltStore(filepath.Join(t.TempDir(), "famed.db"))
+	assert.NoError(t, err)
+
+	queueCtx, cancel := context.WithCancel(context.Background())
+	githubHandler := famed.NewHandler(nil, fakeInstallationClient, store, NewTestConfig(), Now)
+	githubHandler.StartEventQueue(queueCtx, 3, time.Millisecond)
+	assert.NoError(t, githubHandler.PostEvent(ctx))
+	assert.Eventually(t, func() bool {
+		return fakeInstallationClient.GetCommentsCallCount() == 1
+	}, time.Second, time.Millisecond)
+
+	// WHEN
+	cancel()
+	githubHandler.WaitEventQueue()
+
+	// THEN
+	// The interrupted job is kept to be processed after a restart
+	jobs, err := store.GetJobs()
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 1)
+	assert.Equal(t, 0, jobs[0].Attempts)
+	deadJobs, err := store.GetDeadJobs()
+	assert.NoError(t, err)
+	assert.Len(t, deadJobs, 0)
+	assert.NoError(t, store.Close())
+}
+
+func TestGetEventQueue(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	store, err := storage.NewBoltStore(filepath.Join(t.TempDir(), "famed.db"))
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+	pending, err := store.PutJob(storage.Job{Key: "issue/1", EventType: "issues", Payload: []byte(`{}`)})
+	assert.NoError(t, err)
+	dead, err := store.PutJob(storage.Job{Key: "issue/2", EventType: "issues", Payload: []byte(`{}`), Attempts: 5})
+	assert.NoError(t, err)
+	assert.NoError(t, store.DeadLetterJob(dead, 10))
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/queue", nil)
+	rec := httptest.NewRecorder()
+	ctx := echo.New().NewContext(req, rec)
+	githubHandler := famed.NewHandler(nil, nil, store, NewTestConfig(), Now)
+
+	// WHEN
+	err = githubHandler.GetEventQueue(ctx)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, fmt.Sprintf(`{"pending":[{"id":%d,"key":"issue/1","eventType":"issues","payload":{},"attempts":0,"nextAttemptAt":"0001-01-01T00:00:00Z","createdAt":"0001-01-01T00:00:00Z"}],"dead":[{"id":%d,"key":"issue/2","eventType":"issues","payload":{},"attempts":5,"nextAttemptAt":"0001-01-01T00:00:00Z","createdAt":"0001-01-01T00:00:00Z"}]}`, pending.ID, dead.ID), rec.Body.String())
+}
+
+func TestPostEventRedelivery(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	event := &github.IssuesEvent{
+		Action: pointer.String("closed"),
+		Issue: &github.Issue{
+			ID:        pointer.Int64(0),
+			Title:     pointer.String("test"),
+			HTMLURL:   pointer.String("TestURL"),
+			Labels:    []*github.Label{{Name: pointer.String("famed")}},
+			Number:    pointer.Int(1),
+			CreatedAt: pointer.Time(time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)),
+			ClosedAt:  pointer.Time(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		Repo: &github.Repository{
+			Name:  pointer.String("test"),
+			Owner: &github.User{Login: pointer.String("test")},
+		},
+	}
+	b, _ := json.Marshal(event)
+	var payload map[string]interface{}
+	_ = json.Unmarshal(b, &payload)
+
+	store, err := storage.NewBoltStore(filepath.Join(t.TempDir(), "famed.db"))
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	fakeInstallationClient, _, ctx := newIssuesEventTest(t, payload)
+	ctx.Request().Header.Set(github.DeliveryIDHeader, "72d3162e-cc78-11e3-81ab-4c9367dc0958")
+	githubHandler := famed.NewHandler(nil, fakeInstallationClient, store, NewTestConfig(), Now)
+	assert.NoError(t, githubHandler.PostEvent(ctx))
+
+	_, _, redeliveryCtx := newIssuesEventTest(t, payload)
+	redeliveryCtx.Request().Header.Set(github.DeliveryIDHeader, "72d3162e-cc78-11e3-81ab-4c9367dc0958")
+
+	// WHEN
+	err = githubHandler.PostEvent(redeliveryCtx)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, redeliveryCtx.Response().Status)
+	assert.Equal(t, 1, fakeInstallationClient.PostCommentCallCount())
+
+	// WHEN
+	rec := httptest.NewRecorder()
+	deliveriesCtx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/admin/webhooks", nil), rec)
+	err = githubHandler.GetWebhooks(deliveriesCtx)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{"id":"72d3162e-cc78-11e3-81ab-4c9367dc0958","eventType":"issues","status":"processed","receivedAt":"2022-04-20T00:00:00Z","updatedAt":"2022-04-20T00:00:00Z"}]`, rec.Body.String())
+}
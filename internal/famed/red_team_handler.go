@@ -5,9 +5,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 
-	"github.com/morphysm/famed-github-backend/internal/config"
 	model2 "github.com/morphysm/famed-github-backend/internal/famed/model"
-	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 )
 
 func (gH *githubHandler) GetRedTeam(c echo.Context) error {
@@ -21,20 +19,18 @@ func (gH *githubHandler) GetRedTeam(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, model2.ErrMissingRepoPathParameter.Error())
 	}
 
-	if ok := gH.githubInstallationClient.CheckInstallation(owner); !ok {
-		return echo.NewHTTPError(http.StatusBadRequest, model2.ErrAppNotInstalled.Error())
+	window, err := gH.parseWindow(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
-	famedLabel := gH.famedConfig.Labels[config.FamedLabelKey]
-	issueState := model.All
-	issues, err := gH.githubInstallationClient.GetIssuesByRepo(c.Request().Context(), owner, repoName, []string{famedLabel.Name}, &issueState)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadGateway, err.Error())
+	if ok := gH.githubInstallationClient.CheckInstallation(owner); !ok || gH.repoHidden(owner, repoName) {
+		return echo.NewHTTPError(http.StatusBadRequest, model2.ErrAppNotInstalled.Error())
 	}
 
-	redTeam, err := model2.NewRedTeamFromIssues(issues, gH.famedConfig.Currency, gH.now())
+	redTeam, err := gH.redTeam(c.Request().Context(), owner, repoName, window)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(http.StatusBadGateway, err.Error())
 	}
 
 	return c.JSON(http.StatusOK, redTeam)
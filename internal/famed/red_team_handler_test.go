@@ -14,6 +14,7 @@ import (
 	"github.com/morphysm/famed-github-backend/internal/famed"
 	model "github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers/providersfakes"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage/storagefakes"
 	"github.com/morphysm/famed-github-backend/pkg/pointer"
 )
 
@@ -152,7 +153,7 @@ func TestRedTeam(t *testing.T) {
 			// TODO testUser for error
 			fakeInstallationClient.GetIssuesByRepoReturns(testCase.Issues, nil)
 
-			githubHandler := famed.NewHandler(nil, fakeInstallationClient, famedConfig, Now)
+			githubHandler := famed.NewHandler(nil, fakeInstallationClient, &storagefakes.FakeStore{}, famedConfig, Now)
 
 			// WHEN
 			err := githubHandler.GetRedTeam(ctx)
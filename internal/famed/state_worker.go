@@ -2,28 +2,62 @@ package famed
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"time"
 
 	"github.com/phuslu/log"
 
 	famedModel "github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage"
+	libSync "github.com/morphysm/famed-github-backend/pkg/sync"
 )
 
-// CleanState iterates over all issues and updates their comments if necessary.
+// cleanStateHistorySize is the number of state worker reports kept.
+const cleanStateHistorySize = 100
+
+// CleanState iterates over all repositories, reconciles their labels and processes the issues changed since the last run.
+// The repositories of all installations are processed concurrently within the concurrency budget shared with their issues.
+// The comments of the changed open issues are updated if necessary and the stored boards are refreshed.
+// A repository without high-water mark, because it was never processed before, is processed entirely.
+// A repository whose bot comments were not yet migrated to the current version is processed entirely,
+// rewriting the bot comments rendered by older versions to the current format.
+// The run is recorded as report.
 func (gH *githubHandler) CleanState() {
 	log.Info().Msgf("[CleanState] running clean up...")
 
 	ctx := context.Background()
+	run := storage.CleanStateRun{StartedAt: gH.now(), Errors: []string{}}
+	defer func() {
+		run.DurationSeconds = gH.now().Sub(run.StartedAt).Seconds()
+		if err := gH.store.AddCleanStateRun(run, cleanStateHistorySize); err != nil {
+			log.Error().Err(err).Msg("[CleanState] error while storing run report")
+		}
+	}()
+
 	installations, err := gH.githubAppClient.GetInstallations(ctx)
 	if err != nil {
 		log.Error().Err(err).Msg("[CleanState] error while getting installations")
+		run.Errors = append(run.Errors, fmt.Sprintf("installations: %v", err))
 	}
 
+	var mu sync.Mutex
+	executor := libSync.NewSharedExecutor(ctx, gH.budget)
 	for _, installation := range installations {
+		// Suspended installations are not accessible until unsuspended
+		if installation.SuspendedAt != nil {
+			gH.githubInstallationClient.SuspendInstallation(installation.Account.Login)
+			continue
+		}
+
 		// Check if installation client is set up and if necessary add client
 		if !gH.githubInstallationClient.CheckInstallation(installation.Account.Login) {
 			err := gH.githubInstallationClient.AddInstallation(installation.Account.Login, installation.ID)
 			if err != nil {
 				log.Error().Err(err).Msg("[CleanState] error while adding github")
+				mu.Lock()
+				run.Errors = append(run.Errors, fmt.Sprintf("%s: %v", installation.Account.Login, err))
+				mu.Unlock()
 				continue
 			}
 		}
@@ -31,27 +65,106 @@ func (gH *githubHandler) CleanState() {
 		repos, err := gH.githubInstallationClient.GetRepos(ctx, installation.Account.Login)
 		if err != nil {
 			log.Error().Err(err).Msg("[CleanState] error while getting repos")
+			mu.Lock()
+			run.Errors = append(run.Errors, fmt.Sprintf("%s: %v", installation.Account.Login, err))
+			mu.Unlock()
 			continue
 		}
 
 		for _, repoName := range repos {
-			issues, err := gH.githubInstallationClient.GetEnrichedIssues(ctx, installation.Account.Login, repoName, famedModel.Opened)
-			if err != nil {
-				log.Error().Err(err).Msgf("[CleanState] error while fetching issues for %s/%s", installation.Account.Login, repoName)
-			}
+			owner, repoName := installation.Account.Login, repoName
+			executor.Go(func(ctx context.Context) error {
+				var repoRun storage.CleanStateRun
+				gH.cleanRepoState(ctx, owner, repoName, &repoRun)
 
-			commentsIssues := make(map[*famedModel.EnrichedIssue][]famedModel.IssueComment, len(issues))
-			for _, issue := range issues {
-				comments, _ := gH.githubInstallationClient.GetComments(ctx, installation.Account.Login, repoName, issue.Number)
-				commentsIssues[&issue] = comments
-			}
+				mu.Lock()
+				defer mu.Unlock()
+				run.ReposScanned += repoRun.ReposScanned
+				run.IssuesScanned += repoRun.IssuesScanned
+				run.CommentsChanged += repoRun.CommentsChanged
+				run.Errors = append(run.Errors, repoRun.Errors...)
+				return nil
+			})
+		}
+	}
+
+	// The repositories report their errors in the run
+	_ = executor.Wait()
+}
+
+// cleanRepoState processes the issues of a repository changed since its high-water mark and adds the outcome to the run report.
+// The high-water mark is only advanced if the changed issues were processed without errors, otherwise they are processed again by the next run.
+// The comments of all issues are migrated once per version.
+// Linking a pull request does not always change the issue, the eligible comment of such an issue is only updated by its next change.
+func (gH *githubHandler) cleanRepoState(ctx context.Context, owner string, repoName string, run *storage.CleanStateRun) {
+	run.ReposScanned++
+	gH.reconcileLabels(ctx, owner, repoName)
+
+	since, found, err := gH.store.GetHighWaterMark(owner, repoName)
+	if err != nil {
+		// Processing the repository entirely is always correct
+		log.Error().Err(err).Msgf("[cleanRepoState] error while reading high-water mark of %s/%s", owner, repoName)
+		since, found = time.Time{}, false
+	}
+
+	migrate := gH.commentsOutdated(owner, repoName)
+	if migrate {
+		since = time.Time{}
+	}
+
+	// The mark is taken before fetching to not miss issues changed while the repository is processed
+	mark := gH.now()
+	issues, comments, err := gH.githubInstallationClient.GetEnrichedIssuesWithComments(ctx, owner, repoName, famedModel.All, since)
+	if err != nil {
+		log.Error().Err(err).Msgf("[cleanRepoState] error while fetching issues for %s/%s", owner, repoName)
+		run.Errors = append(run.Errors, fmt.Sprintf("%s/%s: %v", owner, repoName, err))
+		return
+	}
+	run.IssuesScanned += len(issues)
+
+	updates := NewSafeIssueCommentsUpdates()
+	if migrate {
+		// The comments are shared with newCommentsIssues, the following updates see the migrated bodies
+		err := gH.migrateComments(ctx, owner, repoName, newCommentsIssues(issues, comments), updates)
+		run.CommentsChanged += updates.actionCount()
+		if err != nil {
+			log.Error().Err(err).Msgf("[cleanRepoState] error while migrating comments for %s/%s", owner, repoName)
+			run.Errors = append(run.Errors, fmt.Sprintf("%s/%s: %v", owner, repoName, err))
+			return
+		}
+		gH.putCommentsVersion(owner, repoName)
+	}
+
+	if found && len(issues) == 0 {
+		gH.putHighWaterMark(owner, repoName, mark)
+		return
+	}
+
+	gH.updateBoards(ctx, owner, repoName, issues, since.IsZero())
 
-			go func(owner string, repoName string, issues map[*famedModel.EnrichedIssue][]famedModel.IssueComment) {
-				gH.updateRewardComments(ctx, owner, repoName, commentsIssues, nil)
-			}(installation.Account.Login, repoName, commentsIssues)
-			go func(owner string, repoName string, issues map[*famedModel.EnrichedIssue][]famedModel.IssueComment) {
-				gH.updateEligibleComments(ctx, owner, repoName, commentsIssues, nil)
-			}(installation.Account.Login, repoName, commentsIssues)
+	// Only the comments of open issues are kept up to date, closed issues are handled by their close event
+	openIssues := make(map[int]famedModel.EnrichedIssue, len(issues))
+	for number, issue := range issues {
+		if issue.ClosedAt == nil {
+			openIssues[number] = issue
 		}
 	}
+
+	updates = NewSafeIssueCommentsUpdates()
+	err = gH.updateComments(ctx, owner, repoName, newCommentsIssues(openIssues, comments), updates)
+	run.CommentsChanged += updates.actionCount()
+	if err != nil {
+		log.Error().Err(err).Msgf("[cleanRepoState] error while updating comments for %s/%s", owner, repoName)
+		run.Errors = append(run.Errors, fmt.Sprintf("%s/%s: %v", owner, repoName, err))
+		return
+	}
+
+	gH.putHighWaterMark(owner, repoName, mark)
+}
+
+// putHighWaterMark stores the high-water mark of a repository, errors are logged since the repository is processed again by the next run.
+func (gH *githubHandler) putHighWaterMark(owner string, repoName string, mark time.Time) {
+	if err := gH.store.PutHighWaterMark(owner, repoName, mark); err != nil {
+		log.Error().Err(err).Msgf("[putHighWaterMark] error while storing high-water mark of %s/%s", owner, repoName)
+	}
 }
@@ -0,0 +1,19 @@
+package famed
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage"
+)
+
+// GetCleanStateRuns returns the reports of the most recent state worker runs, most recent first.
+func (gH *githubHandler) GetCleanStateRuns(c echo.Context) error {
+	runs, err := gH.store.GetCleanStateRuns()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, append([]storage.CleanStateRun{}, runs...))
+}
@@ -0,0 +1,217 @@
+package famed_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/internal/famed"
+	"github.com/morphysm/famed-github-backend/internal/famed/model/comment"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers/providersfakes"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage"
+	"github.com/morphysm/famed-github-backend/pkg/pointer"
+)
+
+func TestCleanState(t *testing.T) {
+	t.Parallel()
+
+	openIssue := model.EnrichedIssue{Issue: model.Issue{Number: 1, Title: "TestIssue", CreatedAt: Now().Add(-time.Hour)}}
+	closedIssue := model.EnrichedIssue{Issue: model.Issue{Number: 2, Title: "TestIssue", CreatedAt: Now().Add(-time.Hour), ClosedAt: pointer.Time(Now())}}
+	errGitHub := errors.New("GitHub error")
+
+	testCases := []struct {
+		Name                   string
+		HighWaterMark          *time.Time
+		CommentsVersion        string
+		Issues                 map[int]model.EnrichedIssue
+		Comments               map[int][]model.IssueComment
+		StoredIssues           map[int]model.EnrichedIssue
+		IssuesErr              error
+		ExpectedSince          time.Time
+		ExpectedStoredIssues   []int
+		ExpectedBlueTeamStored bool
+		ExpectedPostComments   int
+		ExpectedUpdateComments int
+		ExpectedHighWaterMark  *time.Time
+		ExpectedVersionStored  bool
+		ExpectedIssuesScanned  int
+		ExpectedCommentChanges int
+		ExpectedErrors         int
+	}{
+		{
+			Name:                   "First run",
+			Issues:                 map[int]model.EnrichedIssue{1: openIssue},
+			ExpectedBlueTeamStored: true,
+			ExpectedPostComments:   1,
+			ExpectedHighWaterMark:  pointer.Time(Now()),
+			ExpectedVersionStored:  true,
+			ExpectedIssuesScanned:  1,
+			ExpectedCommentChanges: 1,
+		},
+		{
+			Name:                  "No changes",
+			HighWaterMark:         pointer.Time(Now().Add(-time.Hour)),
+			CommentsVersion:       "0.0.0",
+			ExpectedSince:         Now().Add(-time.Hour),
+			ExpectedHighWaterMark: pointer.Time(Now()),
+			ExpectedVersionStored: true,
+		},
+		{
+			Name:                   "Changes",
+			HighWaterMark:          pointer.Time(Now().Add(-time.Hour)),
+			CommentsVersion:        "0.0.0",
+			Issues:                 map[int]model.EnrichedIssue{1: openIssue},
+			ExpectedSince:          Now().Add(-time.Hour),
+			ExpectedPostComments:   1,
+			ExpectedHighWaterMark:  pointer.Time(Now()),
+			ExpectedVersionStored:  true,
+			ExpectedIssuesScanned:  1,
+			ExpectedCommentChanges: 1,
+		},
+		{
+			Name:          "Outdated comments",
+			HighWaterMark: pointer.Time(Now().Add(-time.Hour)),
+			Issues:        map[int]model.EnrichedIssue{1: openIssue, 2: closedIssue},
+			Comments: map[int][]model.IssueComment{
+				1: {{ID: 1, User: model.User{Login: "bot-user[bot]"}, Body: "🤖 Assignees for Issue **TestIssue #1** are now eligible to Get Famed."}},
+				2: {
+					{ID: 2, User: model.User{Login: "contributor"}, Body: "<!--{\"type\":\"reward\",\"version\":\"TODO\"}-->"},
+					{ID: 3, User: model.User{Login: "bot-user[bot]"}, Body: "<!--{\"type\":\"reward\",\"version\":\"TODO\"}-->\n### Famed could not generate a reward suggestion."},
+				},
+			},
+			ExpectedStoredIssues:   []int{2},
+			ExpectedBlueTeamStored: true,
+			ExpectedUpdateComments: 2,
+			ExpectedHighWaterMark:  pointer.Time(Now()),
+			ExpectedVersionStored:  true,
+			ExpectedIssuesScanned:  2,
+			ExpectedCommentChanges: 2,
+		},
+		{
+			Name:                   "Reopened and closed issues",
+			HighWaterMark:          pointer.Time(Now().Add(-time.Hour)),
+			CommentsVersion:        "0.0.0",
+			StoredIssues:           map[int]model.EnrichedIssue{2: closedIssue, 3: {Issue: model.Issue{Number: 3, Title: "TestIssue", CreatedAt: Now().Add(-time.Hour), ClosedAt: pointer.Time(Now())}}},
+			Issues:                 map[int]model.EnrichedIssue{2: {Issue: model.Issue{Number: 2, Title: "TestIssue", CreatedAt: Now().Add(-time.Hour)}}, 4: {Issue: model.Issue{Number: 4, Title: "TestIssue", CreatedAt: Now().Add(-time.Hour), ClosedAt: pointer.Time(Now())}}},
+			ExpectedSince:          Now().Add(-time.Hour),
+			ExpectedStoredIssues:   []int{3, 4},
+			ExpectedBlueTeamStored: true,
+			ExpectedPostComments:   1,
+			ExpectedHighWaterMark:  pointer.Time(Now()),
+			ExpectedVersionStored:  true,
+			ExpectedIssuesScanned:  2,
+			ExpectedCommentChanges: 1,
+		},
+		{
+			Name:                  "GitHub error",
+			HighWaterMark:         pointer.Time(Now().Add(-time.Hour)),
+			CommentsVersion:       "0.0.0",
+			IssuesErr:             errGitHub,
+			ExpectedSince:         Now().Add(-time.Hour),
+			ExpectedHighWaterMark: pointer.Time(Now().Add(-time.Hour)),
+			ExpectedVersionStored: true,
+			ExpectedErrors:        1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// GIVEN
+			store, err := storage.NewBoltStore(filepath.Join(t.TempDir(), "famed.db"))
+			assert.NoError(t, err)
+			t.Cleanup(func() { _ = store.Close() })
+			if testCase.HighWaterMark != nil {
+				assert.NoError(t, store.PutHighWaterMark("testOwner", "testRepo", *testCase.HighWaterMark))
+			}
+			if testCase.CommentsVersion != "" {
+				assert.NoError(t, store.PutCommentsVersion("testOwner", "testRepo", testCase.CommentsVersion))
+			}
+			if testCase.StoredIssues != nil {
+				assert.NoError(t, store.PutIssues("testOwner", "testRepo", testCase.StoredIssues))
+				assert.NoError(t, store.PutBoard("testOwner", "testRepo", storage.BlueTeam, storage.Board{}))
+			}
+
+			fakeAppClient := &providersfakes.FakeAppClient{}
+			fakeAppClient.GetInstallationsReturns([]model.Installation{{ID: 1, Account: model.User{Login: "testOwner"}}}, nil)
+			fakeInstallationClient := &providersfakes.FakeInstallationClient{}
+			fakeInstallationClient.CheckInstallationReturns(true)
+			fakeInstallationClient.GetReposReturns([]string{"testRepo"}, nil)
+			fakeInstallationClient.GetEnrichedIssuesWithCommentsReturns(testCase.Issues, testCase.Comments, testCase.IssuesErr)
+			githubHandler := famed.NewHandler(fakeAppClient, fakeInstallationClient, store, NewTestConfig(), Now)
+
+			// WHEN
+			githubHandler.CleanState()
+
+			// THEN
+			assert.Equal(t, 1, fakeInstallationClient.GetEnrichedIssuesWithCommentsCallCount())
+			_, _, _, state, since := fakeInstallationClient.GetEnrichedIssuesWithCommentsArgsForCall(0)
+			assert.Equal(t, model.All, state)
+			assert.True(t, testCase.ExpectedSince.Equal(since))
+			// The boards are updated from the changed issues without fetching all closed issues
+			assert.Equal(t, 0, fakeInstallationClient.GetEnrichedIssuesCallCount())
+			storedIssues, err := store.GetIssues("testOwner", "testRepo")
+			assert.NoError(t, err)
+			assert.Len(t, storedIssues, len(testCase.ExpectedStoredIssues))
+			for _, number := range testCase.ExpectedStoredIssues {
+				assert.Contains(t, storedIssues, number)
+			}
+			board, found, err := store.GetBoard("testOwner", "testRepo", storage.BlueTeam)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedBlueTeamStored, found)
+			if testCase.ExpectedBlueTeamStored {
+				assert.True(t, Now().Equal(board.UpdatedAt))
+			}
+			assert.Equal(t, testCase.ExpectedPostComments, fakeInstallationClient.PostCommentCallCount())
+			assert.Equal(t, testCase.ExpectedUpdateComments, fakeInstallationClient.UpdateCommentCallCount())
+			for i := 0; i < fakeInstallationClient.UpdateCommentCallCount(); i++ {
+				_, _, _, commentID, body := fakeInstallationClient.UpdateCommentArgsForCall(i)
+				identifier, ok := comment.ParseIdentifier(body)
+				assert.True(t, ok)
+				assert.Equal(t, "0.0.0", identifier.Version)
+				assert.NotEqual(t, int64(2), commentID)
+			}
+
+			version, found, err := store.GetCommentsVersion("testOwner", "testRepo")
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedVersionStored, found)
+			if testCase.ExpectedVersionStored {
+				assert.Equal(t, "0.0.0", version)
+			}
+
+			mark, found, err := store.GetHighWaterMark("testOwner", "testRepo")
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedHighWaterMark != nil, found)
+			if testCase.ExpectedHighWaterMark != nil {
+				assert.True(t, testCase.ExpectedHighWaterMark.Equal(mark))
+			}
+
+			// WHEN
+			rec := httptest.NewRecorder()
+			ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/admin/cleanstate/runs", nil), rec)
+			err = githubHandler.GetCleanStateRuns(ctx)
+
+			// THEN
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			var runs []storage.CleanStateRun
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &runs))
+			if assert.Len(t, runs, 1) {
+				assert.True(t, Now().Equal(runs[0].StartedAt))
+				assert.Equal(t, 1, runs[0].ReposScanned)
+				assert.Equal(t, testCase.ExpectedIssuesScanned, runs[0].IssuesScanned)
+				assert.Equal(t, testCase.ExpectedCommentChanges, runs[0].CommentsChanged)
+				assert.Len(t, runs[0].Errors, testCase.ExpectedErrors)
+			}
+		})
+	}
+}
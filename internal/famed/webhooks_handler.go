@@ -0,0 +1,113 @@
+package famed
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/morphysm/famed-github-backend/internal/famed/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage"
+)
+
+type webhook struct {
+	storage.Delivery
+	Payload json.RawMessage `json:"payload"`
+}
+
+type replayReport struct {
+	DeliveryID string                 `json:"deliveryId"`
+	Comments   []model.PlannedComment `json:"comments"`
+}
+
+// GetWebhooks returns the most recent webhook deliveries with their processing status, most recent first.
+func (gH *githubHandler) GetWebhooks(c echo.Context) error {
+	deliveries, err := gH.store.GetDeliveries()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	if deliveries == nil {
+		deliveries = []storage.Delivery{}
+	}
+
+	return c.JSON(http.StatusOK, deliveries)
+}
+
+// GetWebhook returns a webhook delivery with its stored payload.
+func (gH *githubHandler) GetWebhook(c echo.Context) error {
+	deliveryID := c.Param("delivery_id")
+	if deliveryID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, model.ErrMissingDeliveryIDPathParameter.Error())
+	}
+
+	delivery, found, err := gH.store.GetDelivery(deliveryID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	payload, payloadFound, err := gH.store.GetDeliveryPayload(deliveryID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	if !found || !payloadFound {
+		return echo.NewHTTPError(http.StatusNotFound, model.ErrDeliveryNotFound.Error())
+	}
+
+	return c.JSON(http.StatusOK, webhook{Delivery: delivery, Payload: payload})
+}
+
+// PostReplayWebhook replays a stored webhook delivery through the same dispatch as PostEvent, skipping the redelivery check.
+// If the dryRun query parameter is true, the event is handled without making changes
+// and the comment changes that would have been made are returned.
+func (gH *githubHandler) PostReplayWebhook(c echo.Context) error {
+	deliveryID := c.Param("delivery_id")
+	if deliveryID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, model.ErrMissingDeliveryIDPathParameter.Error())
+	}
+
+	var (
+		dryRun bool
+		err    error
+	)
+	if param := c.QueryParam("dryRun"); param != "" {
+		dryRun, err = strconv.ParseBool(param)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, model.ErrInvalidDryRunQueryParameter.Error())
+		}
+	}
+
+	delivery, found, err := gH.store.GetDelivery(deliveryID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	payload, payloadFound, err := gH.store.GetDeliveryPayload(deliveryID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	if !found || !payloadFound {
+		return echo.NewHTTPError(http.StatusNotFound, model.ErrDeliveryNotFound.Error())
+	}
+
+	// The payload was validated when it was received
+	ctx := c.Request().Context()
+	event, err := gH.githubInstallationClient.ParseWebHookEvent(ctx, delivery.EventType, payload)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if !dryRun {
+		return gH.dispatchEvent(c, event, delivery.EventType, deliveryID, payload)
+	}
+
+	dryRunHandler, plannedComments := gH.dryRun()
+	if err := dryRunHandler.handleEvent(ctx, event); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, replayReport{DeliveryID: deliveryID, Comments: plannedComments()})
+}
@@ -0,0 +1,159 @@
+package famed_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v41/github"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/internal/famed"
+	model2 "github.com/morphysm/famed-github-backend/internal/famed/model"
+	"github.com/morphysm/famed-github-backend/internal/famed/model/comment"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage"
+	"github.com/morphysm/famed-github-backend/pkg/pointer"
+)
+
+func TestWebhooks(t *testing.T) {
+	t.Parallel()
+
+	event := &github.IssuesEvent{
+		Action: pointer.String("closed"),
+		Issue: &github.Issue{
+			ID:        pointer.Int64(0),
+			Title:     pointer.String("test"),
+			HTMLURL:   pointer.String("TestURL"),
+			Labels:    []*github.Label{{Name: pointer.String("famed")}},
+			Number:    pointer.Int(1),
+			CreatedAt: pointer.Time(time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)),
+			ClosedAt:  pointer.Time(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		Repo: &github.Repository{
+			Name:  pointer.String("test"),
+			Owner: &github.User{Login: pointer.String("test")},
+		},
+	}
+	payload, _ := json.Marshal(event)
+	errorComment, _ := comment.NewErrorRewardComment(comment.DefaultTemplates(), model2.ErrIssueMissingAssignee).String()
+	expectedDryRun, _ := json.Marshal(map[string]interface{}{
+		"deliveryId": "1",
+		"comments":   []model2.PlannedComment{model2.NewPlannedComment(model2.CommentPosted, "test", "test", 1, 0, "", errorComment)},
+	})
+
+	testCases := []struct {
+		Name                     string
+		Path                     string
+		Method                   string
+		DeliveryID               string
+		ExpectedCode             int
+		ExpectedBody             string
+		ExpectedPostCommentCalls int
+		ExpectedStatus           storage.DeliveryStatus
+	}{
+		{
+			Name:           "Show",
+			Method:         http.MethodGet,
+			Path:           "/admin/webhooks/1",
+			DeliveryID:     "1",
+			ExpectedCode:   http.StatusOK,
+			ExpectedBody:   `{"id":"1","eventType":"issues","status":"failed","lastError":"test error","receivedAt":"2022-04-20T00:00:00Z","updatedAt":"2022-04-20T00:00:00Z","payload":` + string(payload) + `}`,
+			ExpectedStatus: storage.DeliveryFailed,
+		},
+		{
+			Name:           "Show - Not found",
+			Method:         http.MethodGet,
+			Path:           "/admin/webhooks/2",
+			DeliveryID:     "2",
+			ExpectedCode:   http.StatusNotFound,
+			ExpectedStatus: storage.DeliveryFailed,
+		},
+		{
+			Name:                     "Replay",
+			Method:                   http.MethodPost,
+			Path:                     "/admin/webhooks/1/replay",
+			DeliveryID:               "1",
+			ExpectedCode:             http.StatusOK,
+			ExpectedPostCommentCalls: 1,
+			ExpectedStatus:           storage.DeliveryProcessed,
+		},
+		{
+			Name:           "Replay - Dry run",
+			Method:         http.MethodPost,
+			Path:           "/admin/webhooks/1/replay?dryRun=true",
+			DeliveryID:     "1",
+			ExpectedCode:   http.StatusOK,
+			ExpectedBody:   string(expectedDryRun),
+			ExpectedStatus: storage.DeliveryFailed,
+		},
+		{
+			Name:           "Replay - Invalid dry run",
+			Method:         http.MethodPost,
+			Path:           "/admin/webhooks/1/replay?dryRun=maybe",
+			DeliveryID:     "1",
+			ExpectedCode:   http.StatusBadRequest,
+			ExpectedStatus: storage.DeliveryFailed,
+		},
+		{
+			Name:           "Replay - Not found",
+			Method:         http.MethodPost,
+			Path:           "/admin/webhooks/2/replay",
+			DeliveryID:     "2",
+			ExpectedCode:   http.StatusNotFound,
+			ExpectedStatus: storage.DeliveryFailed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// GIVEN
+			store, err := storage.NewBoltStore(filepath.Join(t.TempDir(), "famed.db"))
+			assert.NoError(t, err)
+			t.Cleanup(func() { _ = store.Close() })
+			_, err = store.AddDelivery(storage.Delivery{ID: "1", EventType: "issues", Status: storage.DeliveryReceived, ReceivedAt: Now(), UpdatedAt: Now()}, payload, 10)
+			assert.NoError(t, err)
+			assert.NoError(t, store.SetDeliveryStatus("1", storage.DeliveryFailed, "test error", Now()))
+
+			fakeInstallationClient, _, _ := newIssuesEventTest(t, nil)
+			cl, _ := providers.NewInstallationClient("", nil, nil, "", "famed", nil)
+			fakeInstallationClient.ParseWebHookEventStub = cl.ParseWebHookEvent
+
+			rec := httptest.NewRecorder()
+			ctx := echo.New().NewContext(httptest.NewRequest(testCase.Method, testCase.Path, nil), rec)
+			ctx.SetParamNames("delivery_id")
+			ctx.SetParamValues(testCase.DeliveryID)
+			githubHandler := famed.NewHandler(nil, fakeInstallationClient, store, NewTestConfig(), Now)
+
+			// WHEN
+			if testCase.Method == http.MethodGet {
+				err = githubHandler.GetWebhook(ctx)
+			} else {
+				err = githubHandler.PostReplayWebhook(ctx)
+			}
+
+			// THEN
+			if testCase.ExpectedCode >= http.StatusBadRequest {
+				var httpErr *echo.HTTPError
+				assert.ErrorAs(t, err, &httpErr)
+				assert.Equal(t, testCase.ExpectedCode, httpErr.Code)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedCode, rec.Code)
+			}
+			if testCase.ExpectedBody != "" {
+				assert.JSONEq(t, testCase.ExpectedBody, rec.Body.String())
+			}
+			assert.Equal(t, testCase.ExpectedPostCommentCalls, fakeInstallationClient.PostCommentCallCount())
+			delivery, _, err := store.GetDelivery("1")
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedStatus, delivery.Status)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package famed
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/morphysm/famed-github-backend/internal/famed/model"
+)
+
+const dateLayout = "2006-01-02"
+
+// parseWindow returns the time window given by the optional since and until query parameters.
+// The parameters accept RFC 3339 timestamps or dates formatted as 2006-01-02.
+// Windows whose rewards series exceeds the maximum number of buckets of the famed config's granularity are rejected.
+func (gH *githubHandler) parseWindow(c echo.Context) (model.Window, error) {
+	var window model.Window
+
+	since, err := parseTimeQueryParam(c, "since")
+	if err != nil {
+		return window, model.ErrInvalidSinceQueryParameter
+	}
+
+	until, err := parseTimeQueryParam(c, "until")
+	if err != nil {
+		return window, model.ErrInvalidUntilQueryParameter
+	}
+
+	if since != nil && until != nil && !since.Before(*until) {
+		return window, model.ErrInvalidTimeWindow
+	}
+
+	window.Since = since
+	window.Until = until
+
+	if model.NewBoardOptions("", nil, gH.famedConfig.Granularity, window, gH.now()).ExceedsMaxBuckets() {
+		return model.Window{}, model.ErrTimeWindowTooLarge
+	}
+
+	return window, nil
+}
+
+// parseTimeQueryParam parses a query parameter as RFC 3339 timestamp or date.
+// If the parameter is not set, nil is returned.
+func parseTimeQueryParam(c echo.Context, name string) (*time.Time, error) {
+	value := c.QueryParam(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		parsed, err = time.Parse(dateLayout, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &parsed, nil
+}
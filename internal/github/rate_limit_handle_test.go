@@ -46,6 +46,30 @@ func TestGetRateLimits(t *testing.T) {
 			},
 			ExpectedResponse: "{\"core\":{\"limit\":1,\"remaining\":1,\"reset\":\"2022-04-20T00:00:00Z\"},\"search\":{\"limit\":1,\"remaining\":1,\"reset\":\"2022-04-20T00:00:00Z\"}}\n",
 		},
+		{
+			Name:  "Throttled",
+			Owner: "testOwner",
+			RateLimits: model.RateLimits{
+				Core: model.Rate{
+					Limit:     1,
+					Remaining: 0,
+					Reset:     testTime,
+				},
+				Search: model.Rate{
+					Limit:     1,
+					Remaining: 1,
+					Reset:     testTime,
+				},
+				Throttle: &model.Throttle{
+					Resources: map[string]model.Rate{
+						"core": {Limit: 1, Remaining: 0, Reset: testTime},
+					},
+					BlockedUntil: &testTime,
+					InFlight:     2,
+				},
+			},
+			ExpectedResponse: "{\"core\":{\"limit\":1,\"remaining\":0,\"reset\":\"2022-04-20T00:00:00Z\"},\"search\":{\"limit\":1,\"remaining\":1,\"reset\":\"2022-04-20T00:00:00Z\"},\"throttle\":{\"resources\":{\"core\":{\"limit\":1,\"remaining\":0,\"reset\":\"2022-04-20T00:00:00Z\"}},\"blockedUntil\":\"2022-04-20T00:00:00Z\",\"inFlight\":2}}\n",
+		},
 		{
 			Name:  "No Owner",
 			Owner: "",
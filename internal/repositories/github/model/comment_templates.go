@@ -0,0 +1,24 @@
+package model
+
+// CommentTemplates holds the text/template sources the bot comments are rendered with.
+// Empty templates are not set and fall back to the embedded default templates.
+type CommentTemplates struct {
+	Eligible    string `yaml:"eligible" koanf:"eligible"`
+	Reward      string `yaml:"reward" koanf:"reward"`
+	ErrorReward string `yaml:"errorReward" koanf:"errorreward"`
+}
+
+// Merge returns a copy of the templates overridden by the set templates of other.
+func (t CommentTemplates) Merge(other CommentTemplates) CommentTemplates {
+	if other.Eligible != "" {
+		t.Eligible = other.Eligible
+	}
+	if other.Reward != "" {
+		t.Reward = other.Reward
+	}
+	if other.ErrorReward != "" {
+		t.ErrorReward = other.ErrorReward
+	}
+
+	return t
+}
@@ -2,11 +2,12 @@ package model
 
 type EnrichedIssue struct {
 	Issue
-	PullRequest *string
+	// PullRequest is stored under a new key, issues stored with the URL of the pull request only are read without pull request
+	PullRequest *PullRequest `json:"linkedPullRequest"`
 	Events      []IssueEvent
 }
 
-func NewEnrichIssue(issue Issue, pullRequest *string, events []IssueEvent) EnrichedIssue {
+func NewEnrichIssue(issue Issue, pullRequest *PullRequest, events []IssueEvent) EnrichedIssue {
 	return EnrichedIssue{
 		Issue:       issue,
 		PullRequest: pullRequest,
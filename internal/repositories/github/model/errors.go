@@ -12,7 +12,11 @@ var (
 	ErrIssueMissingData            = errors.New("the issue is missing data promised by the GitHub API")
 	ErrUserMissingData             = errors.New("the user is missing data promised by the GitHub API")
 	ErrIssueCommentMissingData     = errors.New("the issue comment is missing data promised by the GitHub API")
+	ErrLabelMissingData            = errors.New("the label is missing data promised by the GitHub API")
 	ErrIssueMissingSeverityLabel   = errors.New("the issue is missing it's severity label")
 	ErrIssueMultipleSeverityLabels = errors.New("the issue has multiple severity labels")
 	ErrEventMissingData            = errors.New("the event is missing data promised by the GitHub API")
+	ErrRepoConfigInvalidDaysToFix  = errors.New("the repository config's daysToFix must be greater than 0")
+	ErrRepoConfigUnknownSeverity   = errors.New("the repository config's rewards must be keyed by info, low, medium, high or critical")
+	ErrRepoConfigNegativeReward    = errors.New("the repository config's rewards must not be negative")
 )
@@ -1,10 +1,15 @@
 package model
 
-import "github.com/google/go-github/v41/github"
+import (
+	"time"
+
+	"github.com/google/go-github/v41/github"
+)
 
 type Installation struct {
-	ID      int64
-	Account User
+	ID          int64
+	Account     User
+	SuspendedAt *time.Time
 }
 
 func NewInstallation(installation *github.Installation) (Installation, error) {
@@ -18,5 +23,10 @@ func NewInstallation(installation *github.Installation) (Installation, error) {
 		return Installation{}, err
 	}
 
-	return Installation{ID: *installation.ID, Account: account}, nil
+	compressedInstallation := Installation{ID: *installation.ID, Account: account}
+	if installation.SuspendedAt != nil {
+		compressedInstallation.SuspendedAt = &installation.SuspendedAt.Time
+	}
+
+	return compressedInstallation, nil
 }
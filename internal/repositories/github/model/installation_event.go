@@ -2,6 +2,19 @@ package model
 
 import "github.com/google/go-github/v41/github"
 
+type InstallationAction string
+
+const (
+	InstallationCreated                InstallationAction = "created"
+	InstallationDeleted                InstallationAction = "deleted"
+	InstallationSuspend                InstallationAction = "suspend"
+	InstallationUnsuspend              InstallationAction = "unsuspend"
+	InstallationNewPermissionsAccepted InstallationAction = "new_permissions_accepted"
+
+	InstallationRepositoriesAdded   InstallationAction = "added"
+	InstallationRepositoriesRemoved InstallationAction = "removed"
+)
+
 type InstallationEvent struct {
 	Action       string
 	Repositories []Repository
@@ -3,9 +3,10 @@ package model
 import "github.com/google/go-github/v41/github"
 
 type InstallationRepositoriesEvent struct {
-	Action            string
-	Installation      RepositoriesInstallation
-	RepositoriesAdded []Repository
+	Action              string
+	Installation        RepositoriesInstallation
+	RepositoriesAdded   []Repository
+	RepositoriesRemoved []Repository
 }
 
 type RepositoriesInstallation struct {
@@ -37,5 +38,9 @@ func NewInstallationRepositoriesEvent(event *github.InstallationRepositoriesEven
 		compressedEvent.RepositoriesAdded = append(compressedEvent.RepositoriesAdded, Repository{Name: *repository.Name})
 	}
 
+	for _, repository := range event.RepositoriesRemoved {
+		compressedEvent.RepositoriesRemoved = append(compressedEvent.RepositoriesRemoved, Repository{Name: *repository.Name})
+	}
+
 	return compressedEvent, nil
 }
@@ -15,15 +15,19 @@ import (
 type IssueState string
 
 const (
-	All        IssueState = "all"
-	Opened     IssueState = "opened"
-	Closed     IssueState = "closed"
-	Reopened   IssueState = "reopened"
-	Edited     IssueState = "edited"
-	Assigned   IssueState = "assigned"
-	Unassigned IssueState = "unassigned"
-	Labeled    IssueState = "labeled"
-	Unlabeled  IssueState = "unlabeled"
+	All          IssueState = "all"
+	Opened       IssueState = "opened"
+	Closed       IssueState = "closed"
+	Reopened     IssueState = "reopened"
+	Edited       IssueState = "edited"
+	Assigned     IssueState = "assigned"
+	Unassigned   IssueState = "unassigned"
+	Labeled      IssueState = "labeled"
+	Unlabeled    IssueState = "unlabeled"
+	Deleted      IssueState = "deleted"
+	Transferred  IssueState = "transferred"
+	Milestoned   IssueState = "milestoned"
+	Demilestoned IssueState = "demilestoned"
 )
 
 type Issue struct {
@@ -35,6 +39,8 @@ type Issue struct {
 	ClosedAt     *time.Time
 	Assignees    []User
 	Severities   []IssueSeverity
+	CVSSScore    *float64
+	Milestone    *string
 	Migrated     bool
 	RedTeam      []User
 	BountyPoints *int
@@ -60,6 +66,16 @@ func NewIssue(issue *github.Issue, owner string, repoName string) (Issue, error)
 		CreatedAt:  *issue.CreatedAt,
 		ClosedAt:   issue.ClosedAt,
 		Severities: newSeverity(issue.Labels),
+		CVSSScore:  newCVSSScore(issue.Labels, issue.Body),
+	}
+
+	if issue.Milestone != nil {
+		compressedIssue.Milestone = issue.Milestone.Title
+	}
+
+	// A CVSS vector takes precedence over severity labels
+	if compressedIssue.CVSSScore != nil {
+		compressedIssue.Severities = []IssueSeverity{NewSeverityFromScore(*compressedIssue.CVSSScore)}
 	}
 
 	for _, assignee := range issue.Assignees {
@@ -13,6 +13,7 @@ type IssueComment struct {
 // If expected data is not available an error is returned.
 func NewComment(comment *github.IssueComment) (IssueComment, error) {
 	if comment == nil ||
+		comment.ID == nil ||
 		comment.Body == nil {
 		return IssueComment{}, ErrIssueCommentMissingData
 	}
@@ -0,0 +1,58 @@
+package model
+
+import "github.com/google/go-github/v41/github"
+
+type IssueCommentEvent struct {
+	Action  string
+	Repo    Repository
+	Issue   Issue
+	Comment IssueComment
+}
+
+// NewIssueCommentEvent validates issue comment events received through the webhook.
+// Comments on pull requests and issues missing the famed label are not handled.
+func NewIssueCommentEvent(event *github.IssueCommentEvent, famedLabel string) (IssueCommentEvent, error) {
+	if event == nil ||
+		event.Action == nil ||
+		event.Issue == nil ||
+		event.Comment == nil ||
+		event.Repo == nil ||
+		event.Repo.Name == nil ||
+		event.Repo.Owner == nil ||
+		event.Repo.Owner.Login == nil {
+		return IssueCommentEvent{}, ErrEventMissingData
+	}
+
+	if event.Issue.IsPullRequest() {
+		return IssueCommentEvent{}, ErrUnhandledEventType
+	}
+
+	if !isIssueFamedLabeled(event.Issue, famedLabel) {
+		return IssueCommentEvent{}, ErrEventNotFamedLabeled
+	}
+
+	issue, err := NewIssue(event.Issue, *event.Repo.Owner.Login, *event.Repo.Name)
+	if err != nil {
+		return IssueCommentEvent{}, err
+	}
+
+	comment, err := NewComment(event.Comment)
+	if err != nil {
+		return IssueCommentEvent{}, err
+	}
+
+	owner, err := NewUser(event.Repo.Owner)
+	if err != nil {
+		return IssueCommentEvent{}, err
+	}
+
+	return IssueCommentEvent{
+		Action: *event.Action,
+		Repo: Repository{
+			Name:  *event.Repo.Name,
+			Owner: owner,
+		},
+		Issue:   issue,
+		Comment: comment,
+	}, nil
+}
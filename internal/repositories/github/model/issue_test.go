@@ -2,10 +2,13 @@ package model_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/google/go-github/v41/github"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+	"github.com/morphysm/famed-github-backend/pkg/pointer"
 )
 
 func TestIssue_Severity(t *testing.T) {
@@ -72,3 +75,83 @@ func TestIssue_Severity(t *testing.T) {
 		})
 	}
 }
+
+func TestNewIssue_CVSS(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name               string
+		Labels             []string
+		Body               *string
+		ExpectedSeverities []model.IssueSeverity
+		ExpectedScore      *float64
+	}{
+		{
+			Name:               "Severity label only",
+			Labels:             []string{"famed", "high"},
+			ExpectedSeverities: []model.IssueSeverity{model.High},
+		},
+		{
+			Name:               "Vector in body",
+			Labels:             []string{"famed", "low"},
+			Body:               pointer.String("Severity: CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"),
+			ExpectedSeverities: []model.IssueSeverity{model.Critical},
+			ExpectedScore:      pointer.Float64(9.8),
+		},
+		{
+			Name:               "Vector in label without version",
+			Labels:             []string{"famed", "cvss:AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N"},
+			Body:               pointer.String("Severity: CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N"),
+			ExpectedSeverities: []model.IssueSeverity{model.Medium},
+			ExpectedScore:      pointer.Float64(6.1),
+		},
+		{
+			Name:               "Invalid vector in body",
+			Labels:             []string{"famed", "medium"},
+			Body:               pointer.String("Severity: CVSS:3.1/AV:N/AC:L"),
+			ExpectedSeverities: []model.IssueSeverity{model.Medium},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// GIVEN
+			labels := make([]*github.Label, len(testCase.Labels))
+			for i, label := range testCase.Labels {
+				labels[i] = &github.Label{Name: pointer.String(label)}
+			}
+			createdAt := time.Date(2022, 4, 4, 0, 0, 0, 0, time.UTC)
+			issue := &github.Issue{
+				ID:        pointer.Int64(1),
+				Number:    pointer.Int(1),
+				HTMLURL:   pointer.String("TestURL"),
+				Title:     pointer.String("TestIssue"),
+				CreatedAt: &createdAt,
+				Labels:    labels,
+				Body:      testCase.Body,
+			}
+
+			// WHEN
+			result, err := model.NewIssue(issue, "testOwner", "testRepo")
+
+			// THEN
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedSeverities, result.Severities)
+			assert.Equal(t, testCase.ExpectedScore, result.CVSSScore)
+		})
+	}
+}
+
+func TestNewSeverityFromScore(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, model.Info, model.NewSeverityFromScore(0))
+	assert.Equal(t, model.Low, model.NewSeverityFromScore(0.1))
+	assert.Equal(t, model.Low, model.NewSeverityFromScore(3.9))
+	assert.Equal(t, model.Medium, model.NewSeverityFromScore(4.0))
+	assert.Equal(t, model.High, model.NewSeverityFromScore(8.9))
+	assert.Equal(t, model.Critical, model.NewSeverityFromScore(9.0))
+	assert.Equal(t, model.Critical, model.NewSeverityFromScore(10))
+}
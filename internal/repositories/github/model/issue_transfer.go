@@ -0,0 +1,54 @@
+package model
+
+import "encoding/json"
+
+// IssueTransfer represents the new location of an issue transferred to another repository.
+type IssueTransfer struct {
+	Repo    Repository
+	Number  int
+	HTMLURL string
+}
+
+// issueTransferPayload holds the changes of a transferred issues event, which are not mapped by the GitHub client library.
+type issueTransferPayload struct {
+	Changes *struct {
+		NewIssue *struct {
+			Number  *int    `json:"number"`
+			HTMLURL *string `json:"html_url"`
+		} `json:"new_issue"`
+		NewRepository *struct {
+			Name  *string `json:"name"`
+			Owner *struct {
+				Login *string `json:"login"`
+			} `json:"owner"`
+		} `json:"new_repository"`
+	} `json:"changes"`
+}
+
+// NewIssueTransfer returns the new location of a transferred issue parsed from the payload of a transferred issues event.
+func NewIssueTransfer(payload []byte) (IssueTransfer, error) {
+	var transfer issueTransferPayload
+	if err := json.Unmarshal(payload, &transfer); err != nil {
+		return IssueTransfer{}, err
+	}
+
+	if transfer.Changes == nil ||
+		transfer.Changes.NewIssue == nil ||
+		transfer.Changes.NewIssue.Number == nil ||
+		transfer.Changes.NewIssue.HTMLURL == nil ||
+		transfer.Changes.NewRepository == nil ||
+		transfer.Changes.NewRepository.Name == nil ||
+		transfer.Changes.NewRepository.Owner == nil ||
+		transfer.Changes.NewRepository.Owner.Login == nil {
+		return IssueTransfer{}, ErrEventMissingData
+	}
+
+	return IssueTransfer{
+		Repo: Repository{
+			Name:  *transfer.Changes.NewRepository.Name,
+			Owner: User{Login: *transfer.Changes.NewRepository.Owner.Login},
+		},
+		Number:  *transfer.Changes.NewIssue.Number,
+		HTMLURL: *transfer.Changes.NewIssue.HTMLURL,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+func TestNewIssueTransfer(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		Payload     string
+		Expected    model.IssueTransfer
+		ExpectedErr error
+	}{
+		{
+			Name:        "Missing changes",
+			Payload:     `{"action":"transferred"}`,
+			ExpectedErr: model.ErrEventMissingData,
+		},
+		{
+			Name:        "Missing new repository",
+			Payload:     `{"action":"transferred","changes":{"new_issue":{"number":7,"html_url":"NewURL"}}}`,
+			ExpectedErr: model.ErrEventMissingData,
+		},
+		{
+			Name:    "Valid",
+			Payload: `{"action":"transferred","changes":{"new_issue":{"number":7,"html_url":"NewURL"},"new_repository":{"name":"new","owner":{"login":"newOwner"}}}}`,
+			Expected: model.IssueTransfer{
+				Repo:    model.Repository{Name: "new", Owner: model.User{Login: "newOwner"}},
+				Number:  7,
+				HTMLURL: "NewURL",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// WHEN
+			transfer, err := model.NewIssueTransfer([]byte(testCase.Payload))
+
+			// THEN
+			assert.ErrorIs(t, err, testCase.ExpectedErr)
+			assert.Equal(t, testCase.Expected, transfer)
+		})
+	}
+}
@@ -10,6 +10,10 @@ type IssuesEvent struct {
 	Action string
 	Repo   Repository
 	Issue
+	// Transfer holds the new location of a transferred issue
+	Transfer *IssueTransfer
+	// FamedLabelRemoved is true if the event removed the famed label from the issue
+	FamedLabelRemoved bool
 }
 
 // NewIssuesEvent validates issue events received through the webhook.
@@ -25,7 +29,9 @@ func NewIssuesEvent(event *github.IssuesEvent, famedLabel string) (IssuesEvent,
 		return IssuesEvent{}, ErrEventMissingData
 	}
 
-	if !isIssueFamedLabeled(event.Issue, famedLabel) {
+	// An issue the famed label was removed from no longer carries the label but has to be removed from the boards
+	famedLabelRemoved := *event.Action == string(Unlabeled) && event.Label != nil && event.Label.GetName() == famedLabel
+	if !famedLabelRemoved && !isIssueFamedLabeled(event.Issue, famedLabel) {
 		return IssuesEvent{}, ErrEventNotFamedLabeled
 	}
 
@@ -43,6 +49,24 @@ func NewIssuesEvent(event *github.IssuesEvent, famedLabel string) (IssuesEvent,
 		fallthrough
 
 	case string(Unlabeled):
+		fallthrough
+
+	case string(Reopened):
+		fallthrough
+
+	case string(Edited):
+		fallthrough
+
+	case string(Deleted):
+		fallthrough
+
+	case string(Transferred):
+		fallthrough
+
+	case string(Milestoned):
+		fallthrough
+
+	case string(Demilestoned):
 		// TODO check if this is necessary
 		issue, err := NewIssue(event.Issue, *event.Repo.Owner.Login, *event.Repo.Name)
 		if err != nil {
@@ -60,7 +84,8 @@ func NewIssuesEvent(event *github.IssuesEvent, famedLabel string) (IssuesEvent,
 				Name:  *event.Repo.Name,
 				Owner: owner,
 			},
-			Issue: issue,
+			Issue:             issue,
+			FamedLabelRemoved: famedLabelRemoved,
 		}, nil
 
 	default:
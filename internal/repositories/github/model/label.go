@@ -1,7 +1,80 @@
 package model
 
+import (
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v41/github"
+)
+
 type Label struct {
 	Name        string
 	Color       string
 	Description string
 }
+
+// NewLabel returns a Label for a GitHub label.
+func NewLabel(label *github.Label) (Label, error) {
+	if label == nil ||
+		label.Name == nil {
+		return Label{}, ErrLabelMissingData
+	}
+
+	compressedLabel := Label{Name: *label.Name}
+	if label.Color != nil {
+		compressedLabel.Color = *label.Color
+	}
+	if label.Description != nil {
+		compressedLabel.Description = *label.Description
+	}
+
+	return compressedLabel, nil
+}
+
+// Matches returns true if the label has the same name, color and description as the other label.
+// Names are compared exactly so that a differing capitalization is updated,
+// colors are compared case-insensitively as GitHub does and a leading # of a color is ignored.
+func (l Label) Matches(other Label) bool {
+	return l.Name == other.Name &&
+		strings.EqualFold(strings.TrimPrefix(l.Color, "#"), strings.TrimPrefix(other.Color, "#")) &&
+		l.Description == other.Description
+}
+
+// LabelDiff holds the configured labels missing in a repository, the ones that drifted from the configuration and the ones that match it.
+type LabelDiff struct {
+	Missing   []Label
+	Drifted   []Label
+	Unchanged []Label
+}
+
+// DiffLabels compares the configured labels against the labels of a repository.
+// Existing labels are found by case-insensitive name as GitHub does, labels of the repository that are not configured are ignored.
+func DiffLabels(configured map[string]Label, existing []Label) LabelDiff {
+	existingByName := make(map[string]Label, len(existing))
+	for _, label := range existing {
+		existingByName[strings.ToLower(label.Name)] = label
+	}
+
+	configuredLabels := make([]Label, 0, len(configured))
+	for _, label := range configured {
+		configuredLabels = append(configuredLabels, label)
+	}
+	sort.Slice(configuredLabels, func(i, j int) bool {
+		return configuredLabels[i].Name < configuredLabels[j].Name
+	})
+
+	var diff LabelDiff
+	for _, label := range configuredLabels {
+		existingLabel, found := existingByName[strings.ToLower(label.Name)]
+		switch {
+		case !found:
+			diff.Missing = append(diff.Missing, label)
+		case !label.Matches(existingLabel):
+			diff.Drifted = append(diff.Drifted, label)
+		default:
+			diff.Unchanged = append(diff.Unchanged, label)
+		}
+	}
+
+	return diff
+}
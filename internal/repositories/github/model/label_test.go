@@ -0,0 +1,59 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+func TestDiffLabels(t *testing.T) {
+	t.Parallel()
+
+	configured := map[string]model.Label{
+		"famed":    {Name: "famed", Color: "566FDB", Description: "Famed issue"},
+		"high":     {Name: "high", Color: "ff8c00", Description: "High severity"},
+		"critical": {Name: "critical", Color: "#ff0000", Description: "Critical severity"},
+		"low":      {Name: "low", Color: "00ff00", Description: "Low severity"},
+	}
+
+	testCases := []struct {
+		Name     string
+		Existing []model.Label
+		Expected model.LabelDiff
+	}{
+		{
+			Name: "No labels",
+			Expected: model.LabelDiff{
+				Missing: []model.Label{configured["critical"], configured["famed"], configured["high"], configured["low"]},
+			},
+		},
+		{
+			Name: "Missing, drifted and unchanged labels",
+			Existing: []model.Label{
+				{Name: "Famed", Color: "566fdb", Description: "Famed issue"},
+				{Name: "high", Color: "ff8c00", Description: "Outdated description"},
+				{Name: "critical", Color: "ff0000", Description: "Critical severity"},
+				{Name: "bug", Color: "d73a4a", Description: "Something isn't working"},
+			},
+			Expected: model.LabelDiff{
+				Missing:   []model.Label{configured["low"]},
+				Drifted:   []model.Label{configured["famed"], configured["high"]},
+				Unchanged: []model.Label{configured["critical"]},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// WHEN
+			diff := model.DiffLabels(configured, testCase.Existing)
+
+			// THEN
+			assert.Equal(t, testCase.Expected, diff)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package model
+
+// Permission is the permission level of a user on a repository.
+// GitHub reports the maintain role as write and the triage role as read.
+type Permission string
+
+const (
+	PermissionAdmin Permission = "admin"
+	PermissionWrite Permission = "write"
+	PermissionRead  Permission = "read"
+	PermissionNone  Permission = "none"
+)
+
+// CanMaintain returns true if the permission allows to push to the repository.
+func (p Permission) CanMaintain() bool {
+	return p == PermissionAdmin || p == PermissionWrite
+}
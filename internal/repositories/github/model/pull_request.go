@@ -0,0 +1,7 @@
+package model
+
+// PullRequest is the pull request linked to an issue.
+type PullRequest struct {
+	URL    string
+	Merged bool
+}
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/google/go-github/v41/github"
+)
+
+type PushEvent struct {
+	Repo Repository
+	// DefaultBranch is true if the push updated the default branch of the repository.
+	DefaultBranch bool
+	// RepoConfigChanged is true if a commit of the push added, modified or removed the famed configuration file.
+	RepoConfigChanged bool
+}
+
+func NewPushEvent(event *github.PushEvent) (PushEvent, error) {
+	if event == nil ||
+		event.Ref == nil ||
+		event.Repo == nil ||
+		event.Repo.Name == nil ||
+		event.Repo.Owner == nil {
+		return PushEvent{}, ErrEventMissingData
+	}
+
+	owner, err := NewUser(event.Repo.Owner)
+	if err != nil {
+		return PushEvent{}, err
+	}
+
+	compressedEvent := PushEvent{
+		Repo: Repository{
+			Name:  *event.Repo.Name,
+			Owner: owner,
+		},
+		DefaultBranch: event.Repo.DefaultBranch != nil && strings.TrimPrefix(*event.Ref, "refs/heads/") == *event.Repo.DefaultBranch,
+	}
+
+	for _, commit := range event.Commits {
+		if commit == nil {
+			continue
+		}
+
+		for _, files := range [][]string{commit.Added, commit.Modified, commit.Removed} {
+			for _, file := range files {
+				if file == RepoConfigPath {
+					compressedEvent.RepoConfigChanged = true
+				}
+			}
+		}
+	}
+
+	return compressedEvent, nil
+}
@@ -0,0 +1,157 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v41/github"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+	"github.com/morphysm/famed-github-backend/pkg/pointer"
+)
+
+func TestNewPushEvent(t *testing.T) {
+	t.Parallel()
+
+	repo := &github.PushEventRepository{
+		Name:          pointer.String("famed"),
+		Owner:         &github.User{Login: pointer.String("morphysm")},
+		DefaultBranch: pointer.String("main"),
+	}
+
+	testCases := []struct {
+		Name        string
+		Event       *github.PushEvent
+		Expected    model.PushEvent
+		ExpectedErr error
+	}{
+		{
+			Name:        "Empty event",
+			Event:       &github.PushEvent{},
+			ExpectedErr: model.ErrEventMissingData,
+		},
+		{
+			Name: "Default branch config changed",
+			Event: &github.PushEvent{
+				Ref:  pointer.String("refs/heads/main"),
+				Repo: repo,
+				Commits: []*github.HeadCommit{
+					{Modified: []string{"README.md"}},
+					{Added: []string{model.RepoConfigPath}},
+				},
+			},
+			Expected: model.PushEvent{
+				Repo:              model.Repository{Name: "famed", Owner: model.User{Login: "morphysm"}},
+				DefaultBranch:     true,
+				RepoConfigChanged: true,
+			},
+		},
+		{
+			Name: "Other branch config unchanged",
+			Event: &github.PushEvent{
+				Ref:     pointer.String("refs/heads/feature"),
+				Repo:    repo,
+				Commits: []*github.HeadCommit{{Modified: []string{"README.md"}}},
+			},
+			Expected: model.PushEvent{
+				Repo: model.Repository{Name: "famed", Owner: model.User{Login: "morphysm"}},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// WHEN
+			event, err := model.NewPushEvent(testCase.Event)
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedErr, err)
+			assert.Equal(t, testCase.Expected, event)
+		})
+	}
+}
+
+func TestNewRepoConfig(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	content := `currency: USD
+daysToFix: 30
+rewards:
+  critical: 5000
+labels:
+  famed:
+    name: bounty
+    color: ff0000
+    description: Eligible for a bounty
+templates:
+  errorReward: "Famed could not reward this issue: {{ .Reason }}"
+language: ja
+eligibilityRules:
+  - assignee
+  - pullRequestMerged
+`
+
+	// WHEN
+	repoConfig, err := model.NewRepoConfig(content)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, model.RepoConfig{
+		Currency:  pointer.String("USD"),
+		DaysToFix: pointer.Int(30),
+		Rewards:   map[model.IssueSeverity]float64{model.Critical: 5000},
+		Labels: map[string]model.Label{
+			"famed": {Name: "bounty", Color: "ff0000", Description: "Eligible for a bounty"},
+		},
+		Templates:        model.CommentTemplates{ErrorReward: "Famed could not reward this issue: {{ .Reason }}"},
+		Language:         pointer.String("ja"),
+		EligibilityRules: []string{"assignee", "pullRequestMerged"},
+	}, repoConfig)
+}
+
+func TestNewRepoConfig_Invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		Content     string
+		ExpectedErr error
+	}{
+		{
+			Name:        "Zero daysToFix",
+			Content:     "daysToFix: 0\n",
+			ExpectedErr: model.ErrRepoConfigInvalidDaysToFix,
+		},
+		{
+			Name:        "Negative daysToFix",
+			Content:     "daysToFix: -1\n",
+			ExpectedErr: model.ErrRepoConfigInvalidDaysToFix,
+		},
+		{
+			Name:        "Negative reward",
+			Content:     "rewards:\n  high: -100\n",
+			ExpectedErr: model.ErrRepoConfigNegativeReward,
+		},
+		{
+			Name:        "Unknown severity",
+			Content:     "rewards:\n  severe: 100\n",
+			ExpectedErr: model.ErrRepoConfigUnknownSeverity,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// WHEN
+			repoConfig, err := model.NewRepoConfig(testCase.Content)
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedErr, err)
+			assert.Equal(t, model.RepoConfig{}, repoConfig)
+		})
+	}
+}
@@ -7,8 +7,9 @@ import (
 )
 
 type RateLimits struct {
-	Core   Rate `json:"core"`
-	Search Rate `json:"search"`
+	Core     Rate      `json:"core"`
+	Search   Rate      `json:"search"`
+	Throttle *Throttle `json:"throttle,omitempty"`
 }
 
 type Rate struct {
@@ -17,6 +18,15 @@ type Rate struct {
 	Reset     time.Time `json:"reset"`
 }
 
+// Throttle represents the state of the throttling of the requests of an installation.
+// Resources holds the rate limit budget last reported by GitHub per API resource,
+// while BlockedUntil is set if requests are paused after a rate limited response.
+type Throttle struct {
+	Resources    map[string]Rate `json:"resources"`
+	BlockedUntil *time.Time      `json:"blockedUntil,omitempty"`
+	InFlight     int             `json:"inFlight"`
+}
+
 func NewRateLimit(rateLimits *github.RateLimits) (RateLimits, error) {
 	if rateLimits == nil ||
 		rateLimits.Core == nil ||
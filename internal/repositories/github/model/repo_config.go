@@ -0,0 +1,51 @@
+package model
+
+import "gopkg.in/yaml.v3"
+
+// RepoConfigPath is the path of the famed configuration file within a repository.
+const RepoConfigPath = ".github/famed.yml"
+
+// RepoConfig represents the famed configuration committed to a repository.
+// Values that are not set are nil and do not override the global configuration.
+type RepoConfig struct {
+	Currency         *string                   `yaml:"currency"`
+	Rewards          map[IssueSeverity]float64 `yaml:"rewards"`
+	Labels           map[string]Label          `yaml:"labels"`
+	DaysToFix        *int                      `yaml:"daysToFix"`
+	Templates        CommentTemplates          `yaml:"templates"`
+	Language         *string                   `yaml:"language"`
+	EligibilityRules []string                  `yaml:"eligibilityRules"`
+}
+
+// NewRepoConfig parses and validates the content of a famed configuration file.
+func NewRepoConfig(content string) (RepoConfig, error) {
+	var repoConfig RepoConfig
+	if err := yaml.Unmarshal([]byte(content), &repoConfig); err != nil {
+		return RepoConfig{}, err
+	}
+
+	if err := repoConfig.validate(); err != nil {
+		return RepoConfig{}, err
+	}
+
+	return repoConfig, nil
+}
+
+// validate returns an error if a set value is invalid.
+// Templates, language and eligibility rules are validated when they are used.
+func (c RepoConfig) validate() error {
+	if c.DaysToFix != nil && *c.DaysToFix <= 0 {
+		return ErrRepoConfigInvalidDaysToFix
+	}
+
+	for severity, reward := range c.Rewards {
+		if !severity.IsValid() {
+			return ErrRepoConfigUnknownSeverity
+		}
+		if reward < 0 {
+			return ErrRepoConfigNegativeReward
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,13 @@
 package model
 
-import "github.com/google/go-github/v41/github"
+import (
+	"strings"
+
+	"github.com/google/go-github/v41/github"
+	"github.com/phuslu/log"
+
+	"github.com/morphysm/famed-github-backend/pkg/cvss"
+)
 
 type IssueSeverity string
 
@@ -17,6 +24,16 @@ const (
 	Critical IssueSeverity = "critical"
 )
 
+// IsValid returns true if the severity is one of info, low, medium, high or critical.
+func (s IssueSeverity) IsValid() bool {
+	switch s {
+	case Info, Low, Medium, High, Critical:
+		return true
+	default:
+		return false
+	}
+}
+
 // newSeverity returns the issue severity by matching labels against CVSS
 // if no matching issue severity label can be found it returns the IssueMissingLabelErr
 // if multiple matching issue severity labels can be found it returns the IssueMultipleSeverityLabelsErr.
@@ -37,3 +54,63 @@ func newSeverity(labels []*github.Label) []IssueSeverity {
 
 	return severities
 }
+
+// cvssLabelPrefix is the prefix of labels holding a CVSS vector, e.g. "cvss:CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H".
+// Since label names are limited in length, the version prefix can be omitted, the vector is then read as CVSS v3.1.
+const cvssLabelPrefix = "cvss:"
+
+// NewSeverityFromScore returns the issue severity matching a CVSS score.
+func NewSeverityFromScore(score float64) IssueSeverity {
+	switch {
+	case score >= 9.0:
+		return Critical
+	case score >= 7.0:
+		return High
+	case score >= 4.0:
+		return Medium
+	case score >= 0.1:
+		return Low
+	default:
+		return Info
+	}
+}
+
+// newCVSSScore returns the CVSS base score of an issue.
+// The CVSS vector is read from a label prefixed with "cvss:" or, if no such label exists, from the issue body.
+// If no valid CVSS vector is found nil is returned.
+func newCVSSScore(labels []*github.Label, body *string) *float64 {
+	vector, ok := findCVSSLabelVector(labels)
+	if !ok && body != nil {
+		vector, ok = cvss.Find(*body)
+	}
+	if !ok {
+		return nil
+	}
+
+	parsed, err := cvss.Parse(vector)
+	if err != nil {
+		log.Warn().Err(err).Msgf("[newCVSSScore] invalid CVSS vector: %s", vector)
+		return nil
+	}
+
+	score := parsed.BaseScore()
+	return &score
+}
+
+// findCVSSLabelVector returns the CVSS vector of the first label prefixed with "cvss:".
+func findCVSSLabelVector(labels []*github.Label) (string, bool) {
+	for _, label := range labels {
+		if label == nil || label.Name == nil || !strings.HasPrefix(strings.ToLower(*label.Name), cvssLabelPrefix) {
+			continue
+		}
+
+		vector := strings.TrimSpace((*label.Name)[len(cvssLabelPrefix):])
+		if !strings.HasPrefix(vector, "CVSS:") {
+			vector = "CVSS:3.1/" + vector
+		}
+
+		return vector, true
+	}
+
+	return "", false
+}
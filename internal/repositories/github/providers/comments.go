@@ -10,25 +10,34 @@ import (
 
 // PostComment posts a comment to a given GitHub issue.
 func (c *githubInstallationClient) PostComment(ctx context.Context, owner string, repoName string, issueNumber int, comment string) error {
-	client, _ := c.clients.get(owner)
+	client, err := c.clients.get(owner)
+	if err != nil {
+		return err
+	}
 
-	_, _, err := client.Issues.CreateComment(ctx, owner, repoName, issueNumber, &github.IssueComment{Body: &comment})
+	_, _, err = client.Issues.CreateComment(ctx, owner, repoName, issueNumber, &github.IssueComment{Body: &comment})
 	return err
 }
 
 // UpdateComment updates a given GitHub comment.
 func (c *githubInstallationClient) UpdateComment(ctx context.Context, owner string, repoName string, commentID int64, comment string) error {
-	client, _ := c.clients.get(owner)
+	client, err := c.clients.get(owner)
+	if err != nil {
+		return err
+	}
 
-	_, _, err := client.Issues.EditComment(ctx, owner, repoName, commentID, &github.IssueComment{Body: &comment})
+	_, _, err = client.Issues.EditComment(ctx, owner, repoName, commentID, &github.IssueComment{Body: &comment})
 	return err
 }
 
 // DeleteComment delets a given GitHub comment.
 func (c *githubInstallationClient) DeleteComment(ctx context.Context, owner string, repoName string, commentID int64) error {
-	client, _ := c.clients.get(owner)
+	client, err := c.clients.get(owner)
+	if err != nil {
+		return err
+	}
 
-	_, err := client.Issues.DeleteComment(ctx, owner, repoName, commentID)
+	_, err = client.Issues.DeleteComment(ctx, owner, repoName, commentID)
 	return err
 }
 
@@ -36,7 +45,7 @@ func (c *githubInstallationClient) DeleteComment(ctx context.Context, owner stri
 func (c *githubInstallationClient) GetComments(ctx context.Context, owner string, repoName string, issueNumber int) ([]model.IssueComment, error) {
 	// GitHub does not allow get comments in an order (https://docs.github.com/en/rest/reference/issues#list-issue-comments)
 	var (
-		client, _             = c.clients.get(owner)
+		client, err           = c.clients.get(owner)
 		allComments           []*github.IssueComment
 		allCompressedComments []model.IssueComment
 		listOptions           = &github.IssueListCommentsOptions{
@@ -47,6 +56,10 @@ func (c *githubInstallationClient) GetComments(ctx context.Context, owner string
 		}
 	)
 
+	if err != nil {
+		return nil, err
+	}
+
 	for {
 		comments, resp, err := client.Issues.ListComments(ctx, owner, repoName, issueNumber, listOptions)
 		if err != nil {
@@ -3,10 +3,12 @@ package providers
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/phuslu/log"
 
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+	libSync "github.com/morphysm/famed-github-backend/pkg/sync"
 )
 
 type safeWrappedIssue struct {
@@ -20,29 +22,29 @@ func (sWI *safeWrappedIssue) Add(wI model.EnrichedIssue) {
 	sWI.enrichedIssues[wI.Number] = wI
 }
 
+// GetEnrichedIssues returns the famed labeled issues of a repository enriched with their events and linked pull request.
 func (c *githubInstallationClient) GetEnrichedIssues(ctx context.Context, owner string, repoName string, issueState model.IssueState) (map[int]model.EnrichedIssue, error) {
-	issues, err := c.GetIssuesByRepo(ctx, owner, repoName, []string{c.famedLabel}, &issueState)
-	if err != nil {
-		return nil, err
-	}
-
-	return c.EnrichIssues(ctx, owner, repoName, issues), nil
+	enrichedIssues, _, err := c.GetEnrichedIssuesWithComments(ctx, owner, repoName, issueState, time.Time{})
+	return enrichedIssues, err
 }
 
 func (c *githubInstallationClient) EnrichIssues(ctx context.Context, owner string, repoName string, issues []model.Issue) map[int]model.EnrichedIssue {
-	wg := sync.WaitGroup{}
+	// The requests of an installation are throttled to maxConcurrentRequests, more concurrent issues would only wait
+	executor := libSync.NewExecutor(ctx, maxConcurrentRequests)
 	safeIssues := safeWrappedIssue{enrichedIssues: make(map[int]model.EnrichedIssue, len(issues))}
 	for _, issue := range issues {
-		wg.Add(1)
-		go func(ctx context.Context, owner string, repoName string, issue model.Issue) {
-			defer wg.Done()
-
+		issue := issue
+		executor.Go(func(ctx context.Context) error {
 			enrichedIssue := c.EnrichIssue(ctx, owner, repoName, issue)
 			safeIssues.Add(enrichedIssue)
-		}(ctx, owner, repoName, issue)
+			return nil
+		})
+	}
+
+	if err := executor.Wait(); err != nil {
+		log.Error().Err(err).Msgf("[EnrichIssues] error while enriching issues of %s/%s", owner, repoName)
 	}
 
-	wg.Wait()
 	return safeIssues.enrichedIssues
 }
 
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/go-github/v41/github"
 	"github.com/shurcooL/githubv4"
@@ -15,9 +17,17 @@ import (
 	libHttp "github.com/morphysm/famed-github-backend/pkg/http"
 )
 
+// responseCacheSize is the number of bytes of GitHub REST responses kept to revalidate with conditional requests.
+const responseCacheSize = 32 << 20
+
+// maxConcurrentRequests is the number of concurrent requests to the GitHub API per installation,
+// higher concurrency trips the secondary rate limits.
+const maxConcurrentRequests = 4
+
 var (
-	ErrNoGithubClient    = errors.New("no github client configured for owner")
-	ErrNoGithubGQLClient = errors.New("no github gql client configured for owner")
+	ErrNoGithubClient        = errors.New("no github client configured for owner")
+	ErrNoGithubGQLClient     = errors.New("no github gql client configured for owner")
+	ErrInstallationSuspended = errors.New("github app installation of owner is suspended")
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -26,54 +36,80 @@ type InstallationClient interface {
 	GetRateLimits(ctx context.Context, owner string) (model.RateLimits, error)
 
 	GetUser(ctx context.Context, owner string, login string) (model.User, error)
+	GetPermission(ctx context.Context, owner string, repoName string, login string) (model.Permission, error)
 
 	GetRepos(ctx context.Context, owner string) ([]string, error)
 
 	GetIssuesByRepo(ctx context.Context, owner string, repoName string, labels []string, state *model.IssueState) ([]model.Issue, error)
 	GetEnrichedIssues(ctx context.Context, owner string, repoName string, state model.IssueState) (map[int]model.EnrichedIssue, error)
+	GetEnrichedIssuesWithComments(ctx context.Context, owner string, repoName string, state model.IssueState, since time.Time) (map[int]model.EnrichedIssue, map[int][]model.IssueComment, error)
 	EnrichIssues(ctx context.Context, owner string, repoName string, issues []model.Issue) map[int]model.EnrichedIssue
 	EnrichIssue(ctx context.Context, owner string, repoName string, issues model.Issue) model.EnrichedIssue
 
-	GetIssuePullRequest(ctx context.Context, owner string, repoName string, issueNumber int) (*string, error)
+	GetIssuePullRequest(ctx context.Context, owner string, repoName string, issueNumber int) (*model.PullRequest, error)
 
 	GetIssueEvents(ctx context.Context, owner string, repoName string, issueNumber int) ([]model.IssueEvent, error)
 	ValidateWebHookEvent(request *http.Request) (interface{}, error)
+	ParseWebHookEvent(ctx context.Context, eventType string, payload []byte) (interface{}, error)
 
 	GetComments(ctx context.Context, owner string, repoName string, issueNumber int) ([]model.IssueComment, error)
 	PostComment(ctx context.Context, owner string, repoName string, issueNumber int, comment string) error
 	UpdateComment(ctx context.Context, owner string, repoName string, commentID int64, comment string) error
 	DeleteComment(ctx context.Context, owner string, repoName string, commentID int64) error
 
+	GetLabels(ctx context.Context, owner string, repoName string) ([]model.Label, error)
 	PostLabel(ctx context.Context, owner string, repoName string, label model.Label) error
-	PostLabels(ctx context.Context, owner string, repoNames []string, labels map[string]model.Label) []error
+	UpdateLabel(ctx context.Context, owner string, repoName string, label model.Label) error
+
+	GetRepoConfig(ctx context.Context, owner string, repoName string) (model.RepoConfig, error)
+	InvalidateRepoConfig(owner string, repoName string)
 
 	AddInstallation(owner string, installationID int64) error
 	AddGitHubClient(owner string, client *github.Client)
+	AddGitHubGQLClient(owner string, client *githubv4.Client)
+	RemoveInstallation(owner string)
+	SuspendInstallation(owner string)
 	CheckInstallation(owner string) bool
 }
 
 // safeClientMap represents a map from owner to client.
-// The map is wrapped to avoid any capitalization errors.
+// The map is wrapped to avoid any capitalization errors and to allow concurrent access from event handlers and the state worker.
+// Clients of suspended installations are kept but not handed out until the installation is unsuspended.
 type safeClientMap struct {
-	m   map[string]*github.Client
-	qlM map[string]*githubv4.Client
+	sync.RWMutex
+	m         map[string]*github.Client
+	qlM       map[string]*githubv4.Client
+	tM        map[string]*libHttp.Throttle
+	suspended map[string]bool
 }
 
 // newSafeClientMap returns a new safeClientMap.
-func newSafeClientMap() safeClientMap {
-	return safeClientMap{
-		m:   make(map[string]*github.Client),
-		qlM: make(map[string]*githubv4.Client),
+func newSafeClientMap() *safeClientMap {
+	return &safeClientMap{
+		m:         make(map[string]*github.Client),
+		qlM:       make(map[string]*githubv4.Client),
+		tM:        make(map[string]*libHttp.Throttle),
+		suspended: make(map[string]bool),
 	}
 }
 
 // add adds an owner client pair to the safeClientMap.
-func (s safeClientMap) add(owner string, client *github.Client) {
+// Adding a client lifts a suspension of the owner's installation.
+func (s *safeClientMap) add(owner string, client *github.Client) {
+	s.Lock()
+	defer s.Unlock()
 	s.m[strings.ToLower(owner)] = client
+	delete(s.suspended, strings.ToLower(owner))
 }
 
 // get gets an owner client pair from the safeClientMap.
-func (s safeClientMap) get(owner string) (*github.Client, error) {
+func (s *safeClientMap) get(owner string) (*github.Client, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.suspended[strings.ToLower(owner)] {
+		return nil, ErrInstallationSuspended
+	}
+
 	client, ok := s.m[strings.ToLower(owner)]
 	if !ok {
 		return nil, ErrNoGithubClient
@@ -82,12 +118,20 @@ func (s safeClientMap) get(owner string) (*github.Client, error) {
 }
 
 // add adds an GraphQL owner client pair to the safeClientMap.
-func (s safeClientMap) addGql(owner string, client *githubv4.Client) {
+func (s *safeClientMap) addGql(owner string, client *githubv4.Client) {
+	s.Lock()
+	defer s.Unlock()
 	s.qlM[strings.ToLower(owner)] = client
 }
 
 // get gets an GraphQL owner client pair from the safeClientMap.
-func (s safeClientMap) getGql(owner string) (*githubv4.Client, error) {
+func (s *safeClientMap) getGql(owner string) (*githubv4.Client, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.suspended[strings.ToLower(owner)] {
+		return nil, ErrInstallationSuspended
+	}
+
 	client, ok := s.qlM[strings.ToLower(owner)]
 	if !ok {
 		return nil, ErrNoGithubGQLClient
@@ -95,6 +139,44 @@ func (s safeClientMap) getGql(owner string) (*githubv4.Client, error) {
 	return client, nil
 }
 
+// throttle returns the throttle shared by the clients of an owner, creating it if the owner has none.
+// The throttle outlives suspensions to keep track of the rate limit budget.
+func (s *safeClientMap) throttle(owner string) *libHttp.Throttle {
+	s.Lock()
+	defer s.Unlock()
+	throttle, ok := s.tM[strings.ToLower(owner)]
+	if !ok {
+		throttle = libHttp.NewThrottle(maxConcurrentRequests)
+		s.tM[strings.ToLower(owner)] = throttle
+	}
+	return throttle
+}
+
+// getThrottle returns the throttle shared by the clients of an owner.
+func (s *safeClientMap) getThrottle(owner string) (*libHttp.Throttle, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	throttle, ok := s.tM[strings.ToLower(owner)]
+	return throttle, ok
+}
+
+// remove removes the clients of an owner from the safeClientMap.
+func (s *safeClientMap) remove(owner string) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.m, strings.ToLower(owner))
+	delete(s.qlM, strings.ToLower(owner))
+	delete(s.tM, strings.ToLower(owner))
+	delete(s.suspended, strings.ToLower(owner))
+}
+
+// suspend disables the clients of an owner until they are added again.
+func (s *safeClientMap) suspend(owner string) {
+	s.Lock()
+	defer s.Unlock()
+	s.suspended[strings.ToLower(owner)] = true
+}
+
 type safeUserMap struct {
 	sync.RWMutex
 	wrappedUsers map[string]model.User
@@ -124,23 +206,29 @@ type githubInstallationClient struct {
 	baseURL       string
 	webhookSecret string
 	appClient     AppClient
-	clients       safeClientMap
+	clients       *safeClientMap
 	famedLabel    string
 	// TODO replace by cache eg. redis
-	redTeamLogins map[string]string
-	cachedRedTeam *safeUserMap
+	redTeamLogins     map[string]string
+	cachedRedTeam     *safeUserMap
+	cachedRepoConfigs *safeRepoConfigMap
+	// responseCache bounds the responses cached by the REST clients of all installations,
+	// each installation is scoped to its own keys as the responses depend on the installation's access.
+	responseCache libHttp.Cache
 }
 
 // NewInstallationClient returns a new instance of the GitHub client
 func NewInstallationClient(baseURL string, appClient AppClient, installations map[string]int64, webhookSecret string, famedLabel string, redTeamLogins map[string]string) (InstallationClient, error) {
 	client := &githubInstallationClient{
-		baseURL:       baseURL,
-		webhookSecret: webhookSecret,
-		appClient:     appClient,
-		clients:       newSafeClientMap(),
-		famedLabel:    famedLabel,
-		redTeamLogins: redTeamLogins,
-		cachedRedTeam: newSafeUserMap(),
+		baseURL:           baseURL,
+		webhookSecret:     webhookSecret,
+		appClient:         appClient,
+		clients:           newSafeClientMap(),
+		famedLabel:        famedLabel,
+		redTeamLogins:     redTeamLogins,
+		cachedRedTeam:     newSafeUserMap(),
+		cachedRepoConfigs: newSafeRepoConfigMap(),
+		responseCache:     libHttp.NewMemoryCache(responseCacheSize),
 	}
 
 	for owner, installationID := range installations {
@@ -158,8 +246,11 @@ func (c *githubInstallationClient) AddInstallation(owner string, installationID
 	ts := NewGithubTokenSource(c.appClient, installationID)
 	oAuthClient := oauth2.NewClient(context.Background(), ts)
 	loggingClient := libHttp.AddLogging(oAuthClient)
+	throttlingClient := libHttp.AddThrottling(loggingClient, c.clients.throttle(owner))
+	responseCache := libHttp.NewScopedCache(c.responseCache, strconv.FormatInt(installationID, 10))
+	cachingClient := libHttp.AddCaching(&http.Client{Transport: throttlingClient.Transport}, responseCache)
 
-	client, err := github.NewEnterpriseClient(c.baseURL, c.baseURL, loggingClient)
+	client, err := github.NewEnterpriseClient(c.baseURL, c.baseURL, cachingClient)
 	if err != nil {
 		return err
 	}
@@ -167,8 +258,8 @@ func (c *githubInstallationClient) AddInstallation(owner string, installationID
 	c.AddGitHubClient(owner, client)
 
 	// GraphQL client for missing "pull_requests" field workaround https://github.community/t/get-referenced-pull-request-from-issue/14027
-	gQLClient := githubv4.NewClient(oAuthClient)
-	c.clients.addGql(owner, gQLClient)
+	gQLClient := githubv4.NewClient(throttlingClient)
+	c.AddGitHubGQLClient(owner, gQLClient)
 
 	return nil
 }
@@ -179,8 +270,25 @@ func (c *githubInstallationClient) AddGitHubClient(owner string, client *github.
 	c.clients.add(owner, client)
 }
 
-// CheckInstallation checks if an installations is present in the githubInstallationClient.
+// AddGitHubGQLClient adds a new githubv4.Client to the githubInstallationClient map.
+// Mainly used for testing purposes.
+func (c *githubInstallationClient) AddGitHubGQLClient(owner string, client *githubv4.Client) {
+	c.clients.addGql(owner, client)
+}
+
+// RemoveInstallation removes the GitHub clients of an owner whose installation was deleted.
+func (c *githubInstallationClient) RemoveInstallation(owner string) {
+	c.clients.remove(owner)
+}
+
+// SuspendInstallation disables the GitHub clients of an owner whose installation was suspended.
+// The clients are enabled again by adding the installation.
+func (c *githubInstallationClient) SuspendInstallation(owner string) {
+	c.clients.suspend(owner)
+}
+
+// CheckInstallation checks if an installation is present and not suspended in the githubInstallationClient.
 func (c *githubInstallationClient) CheckInstallation(owner string) bool {
 	_, err := c.clients.get(owner)
-	return err != nil
+	return err == nil
 }
@@ -0,0 +1,95 @@
+package providers_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v41/github"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers/providersfakes"
+	"github.com/morphysm/famed-github-backend/pkg/pointer"
+)
+
+func TestInstallationLifecycle(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	client, err := providers.NewInstallationClient("", nil, nil, "", "famed", nil)
+	assert.NoError(t, err)
+
+	// THEN
+	assert.False(t, client.CheckInstallation("testOwner"))
+
+	// WHEN
+	client.AddGitHubClient("TestOwner", github.NewClient(nil))
+
+	// THEN
+	assert.True(t, client.CheckInstallation("testOwner"))
+
+	// WHEN
+	client.SuspendInstallation("testOwner")
+
+	// THEN
+	assert.False(t, client.CheckInstallation("testOwner"))
+	_, err = client.GetRepos(context.Background(), "testOwner")
+	assert.ErrorIs(t, err, providers.ErrInstallationSuspended)
+
+	// WHEN unsuspended
+	client.AddGitHubClient("testOwner", github.NewClient(nil))
+
+	// THEN
+	assert.True(t, client.CheckInstallation("testOwner"))
+
+	// WHEN
+	client.RemoveInstallation("testOwner")
+
+	// THEN
+	assert.False(t, client.CheckInstallation("testOwner"))
+	_, err = client.GetRepos(context.Background(), "testOwner")
+	assert.ErrorIs(t, err, providers.ErrNoGithubClient)
+}
+
+func TestInstallationResponseCache(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	// The server revalidates any request with the ETag of a previous response,
+	// a cache shared by the installations would serve the repositories of the first installation to the second one
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", `"v1"`)
+		repoName := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		_, _ = fmt.Fprintf(w, `{"total_count":1,"repositories":[{"name":%q}]}`, repoName)
+	}))
+	t.Cleanup(server.Close)
+
+	fakeAppClient := &providersfakes.FakeAppClient{}
+	fakeAppClient.GetAccessTokenStub = func(_ context.Context, installationID int64) (*github.InstallationToken, error) {
+		return &github.InstallationToken{Token: pointer.String(fmt.Sprintf("repo%d", installationID))}, nil
+	}
+	client, err := providers.NewInstallationClient(server.URL+"/", fakeAppClient, map[string]int64{"ownerA": 1, "ownerB": 2}, "", "famed", nil)
+	assert.NoError(t, err)
+
+	for _, owner := range []string{"ownerA", "ownerB", "ownerA", "ownerB"} {
+		// WHEN
+		repos, err := client.GetRepos(context.Background(), owner)
+
+		// THEN
+		assert.NoError(t, err)
+		if owner == "ownerA" {
+			assert.Equal(t, []string{"repo1"}, repos)
+		} else {
+			assert.Equal(t, []string{"repo2"}, repos)
+		}
+	}
+}
@@ -11,7 +11,7 @@ import (
 // GetIssueEvents returns all events for a given issue.
 func (c *githubInstallationClient) GetIssueEvents(ctx context.Context, owner string, repoName string, issueNumber int) ([]model.IssueEvent, error) {
 	var (
-		client, _           = c.clients.get(owner)
+		client, err         = c.clients.get(owner)
 		allEvents           []*github.IssueEvent
 		allCompressedEvents []model.IssueEvent
 		listOptions         = &github.ListOptions{
@@ -20,6 +20,10 @@ func (c *githubInstallationClient) GetIssueEvents(ctx context.Context, owner str
 		}
 	)
 
+	if err != nil {
+		return nil, err
+	}
+
 	for {
 		events, resp, err := client.Issues.ListIssueEvents(ctx, owner, repoName, issueNumber, listOptions)
 		if err != nil {
@@ -14,7 +14,7 @@ import (
 // GetIssuesByRepo returns all issues from a given repository.
 func (c *githubInstallationClient) GetIssuesByRepo(ctx context.Context, owner string, repoName string, labels []string, state *model.IssueState) ([]model.Issue, error) {
 	var (
-		client, _           = c.clients.get(owner)
+		client, err         = c.clients.get(owner)
 		allIssues           []*github.Issue
 		allCompressedIssues []model.Issue
 		listOptions         = &github.IssueListByRepoOptions{
@@ -26,6 +26,10 @@ func (c *githubInstallationClient) GetIssuesByRepo(ctx context.Context, owner st
 		}
 	)
 
+	if err != nil {
+		return nil, err
+	}
+
 	if state != nil {
 		listOptions.State = string(*state)
 	} else {
@@ -45,32 +49,42 @@ func (c *githubInstallationClient) GetIssuesByRepo(ctx context.Context, owner st
 	}
 
 	for _, issue := range allIssues {
-		compressedIssue, err := model.NewIssue(issue, owner, repoName)
+		compressedIssue, err := c.newIssue(ctx, owner, repoName, issue)
 		if err != nil {
-			log.Error().Err(err).Msgf("[GetIssuesByRepo] validation error for issue with number %d", issue.Number)
+			return nil, err
 		}
 
-		if compressedIssue.Migrated {
-			// Parse red team from issue body
-			redTeam, err := parse.FindRightOfKey(*issue.Body, "Bounty Hunter:")
-			if err != nil {
-				return nil, err
-			}
+		allCompressedIssues = append(allCompressedIssues, compressedIssue)
+	}
 
-			// Split bounty hunters if two are present separated by ", "
-			splitTeam := strings.Split(redTeam, ", ")
+	return allCompressedIssues, nil
+}
 
-			for _, pseudonym := range splitTeam {
-				redTeamer, err := c.getRedTeamer(ctx, owner, pseudonym)
-				if err != nil {
-					return nil, err
-				}
-				compressedIssue.RedTeam = append(compressedIssue.RedTeam, redTeamer)
-			}
+// newIssue returns an Issue for a GitHub issue, the red team of migrated issues is parsed from the issue body.
+func (c *githubInstallationClient) newIssue(ctx context.Context, owner string, repoName string, issue *github.Issue) (model.Issue, error) {
+	compressedIssue, err := model.NewIssue(issue, owner, repoName)
+	if err != nil {
+		log.Error().Err(err).Msgf("[newIssue] validation error for issue with number %d", issue.GetNumber())
+	}
+
+	if compressedIssue.Migrated {
+		// Parse red team from issue body
+		redTeam, err := parse.FindRightOfKey(*issue.Body, "Bounty Hunter:")
+		if err != nil {
+			return model.Issue{}, err
 		}
 
-		allCompressedIssues = append(allCompressedIssues, compressedIssue)
+		// Split bounty hunters if two are present separated by ", "
+		splitTeam := strings.Split(redTeam, ", ")
+
+		for _, pseudonym := range splitTeam {
+			redTeamer, err := c.getRedTeamer(ctx, owner, pseudonym)
+			if err != nil {
+				return model.Issue{}, err
+			}
+			compressedIssue.RedTeam = append(compressedIssue.RedTeam, redTeamer)
+		}
 	}
 
-	return allCompressedIssues, nil
+	return compressedIssue, nil
 }
@@ -1,6 +1,7 @@
 package providers
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
@@ -12,8 +13,8 @@ import (
 	"github.com/morphysm/famed-github-backend/pkg/parse"
 )
 
+// ValidateWebHookEvent validates the signature of a webhook request and parses its payload.
 func (c *githubInstallationClient) ValidateWebHookEvent(request *http.Request) (interface{}, error) {
-	var event interface{}
 	webhookSecret := []byte(c.webhookSecret)
 
 	payload, err := github.ValidatePayload(request, webhookSecret)
@@ -21,7 +22,12 @@ func (c *githubInstallationClient) ValidateWebHookEvent(request *http.Request) (
 		return nil, err
 	}
 
-	event, err = github.ParseWebHook(github.WebHookType(request), payload)
+	return c.ParseWebHookEvent(request.Context(), github.WebHookType(request), payload)
+}
+
+// ParseWebHookEvent parses the payload of a validated webhook request of the given event type.
+func (c *githubInstallationClient) ParseWebHookEvent(ctx context.Context, eventType string, payload []byte) (interface{}, error) {
+	event, err := github.ParseWebHook(eventType, payload)
 	if err != nil {
 		return nil, err
 	}
@@ -45,7 +51,7 @@ func (c *githubInstallationClient) ValidateWebHookEvent(request *http.Request) (
 			splitTeam := strings.Split(redTeam, ", ")
 
 			for _, pseudonym := range splitTeam {
-				redTeamer, err := c.getRedTeamer(request.Context(), *event.Repo.Owner.Login, pseudonym)
+				redTeamer, err := c.getRedTeamer(ctx, *event.Repo.Owner.Login, pseudonym)
 				if err != nil {
 					return nil, err
 				}
@@ -53,6 +59,15 @@ func (c *githubInstallationClient) ValidateWebHookEvent(request *http.Request) (
 			}
 		}
 
+		// The GitHub client library does not map the new location of transferred issues
+		if issuesEvent.Action == string(model.Transferred) {
+			transfer, err := model.NewIssueTransfer(payload)
+			if err != nil {
+				return nil, err
+			}
+			issuesEvent.Transfer = &transfer
+		}
+
 		return issuesEvent, err
 	case *github.InstallationRepositoriesEvent:
 		installationRepositoriesEvent, err := model.NewInstallationRepositoriesEvent(event)
@@ -68,8 +83,22 @@ func (c *githubInstallationClient) ValidateWebHookEvent(request *http.Request) (
 		}
 
 		return installationEvent, err
+	case *github.IssueCommentEvent:
+		issueCommentEvent, err := model.NewIssueCommentEvent(event, c.famedLabel)
+		if err != nil {
+			return nil, err
+		}
+
+		return issueCommentEvent, err
+	case *github.PushEvent:
+		pushEvent, err := model.NewPushEvent(event)
+		if err != nil {
+			return nil, err
+		}
+
+		return pushEvent, err
 	default:
-		log.Error().Msg("[ValidateWebHookEvent] unhandled event")
+		log.Error().Msg("[ParseWebHookEvent] unhandled event")
 		return event, model.ErrUnhandledEventType
 	}
 }
@@ -0,0 +1,359 @@
+package providers
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v41/github"
+	"github.com/phuslu/log"
+	"github.com/shurcooL/githubv4"
+
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+// botLoginSuffix is appended by the GitHub REST API to the login of GitHub Apps, the GraphQL API omits it.
+const botLoginSuffix = "[bot]"
+
+type graphQLUser struct {
+	Login     string
+	AvatarURL string
+	URL       string
+}
+
+type graphQLAssignee struct {
+	Typename string      `graphql:"__typename"`
+	User     graphQLUser `graphql:"... on User"`
+	Bot      graphQLUser `graphql:"... on Bot"`
+}
+
+type assignmentEvent struct {
+	Assignee  graphQLAssignee
+	CreatedAt time.Time
+}
+
+type issueStateEvent struct {
+	CreatedAt time.Time
+}
+
+// issueTimelineItem holds the timeline items of an issue used by famed.
+// The fields of all fragments are filled, the type of the item is given by Typename.
+type issueTimelineItem struct {
+	Typename          string          `graphql:"__typename"`
+	AssignedEvent     assignmentEvent `graphql:"... on AssignedEvent"`
+	UnassignedEvent   assignmentEvent `graphql:"... on UnassignedEvent"`
+	ClosedEvent       issueStateEvent `graphql:"... on ClosedEvent"`
+	ReopenedEvent     issueStateEvent `graphql:"... on ReopenedEvent"`
+	ConnectedEvent    connectedEvent  `graphql:"... on ConnectedEvent"`
+	DisconnectedEvent connectedEvent  `graphql:"... on DisconnectedEvent"`
+}
+
+type issueComment struct {
+	FullDatabaseID string
+	Author         struct {
+		Typename string `graphql:"__typename"`
+		graphQLUser
+	}
+	Body string
+}
+
+type pageInfo struct {
+	EndCursor   githubv4.String
+	HasNextPage bool
+}
+
+type graphQLMilestone struct {
+	Title string
+}
+
+type graphQLIssue struct {
+	FullDatabaseID string
+	Number         int
+	URL            string
+	Title          string
+	Body           string
+	CreatedAt      time.Time
+	ClosedAt       *time.Time
+	Milestone      *graphQLMilestone
+	Labels         struct {
+		Nodes []struct {
+			Name string
+		}
+	} `graphql:"labels(first: 100)"`
+	Assignees struct {
+		Nodes []graphQLUser
+	} `graphql:"assignees(first: 100)"`
+	TimelineItems struct {
+		Nodes    []issueTimelineItem
+		PageInfo pageInfo
+	} `graphql:"timelineItems(first: 100, itemTypes: [ASSIGNED_EVENT, UNASSIGNED_EVENT, CLOSED_EVENT, REOPENED_EVENT, CONNECTED_EVENT, DISCONNECTED_EVENT])"`
+	Comments struct {
+		Nodes    []issueComment
+		PageInfo pageInfo
+	} `graphql:"comments(first: 100)"`
+}
+
+// GetEnrichedIssuesWithComments returns the famed labeled issues of a repository enriched with their events and linked pull request,
+// together with the comments posted by bots on each issue by issue number.
+// The issues, timelines and comments are loaded in a single paginated GraphQL query,
+// timelines and comments of issues exceeding the first page are requested separately.
+// If since is not zero, only issues updated at or after since are returned.
+func (c *githubInstallationClient) GetEnrichedIssuesWithComments(ctx context.Context, owner string, repoName string, state model.IssueState, since time.Time) (map[int]model.EnrichedIssue, map[int][]model.IssueComment, error) {
+	var (
+		client, err    = c.clients.getGql(owner)
+		enrichedIssues = make(map[int]model.EnrichedIssue)
+		comments       = make(map[int][]model.IssueComment)
+		query          struct {
+			Repository struct {
+				Issues struct {
+					Nodes    []graphQLIssue
+					PageInfo pageInfo
+				} `graphql:"issues(first: 25, after: $issuesCursor, filterBy: $filterBy)"`
+			} `graphql:"repository(owner: $owner, name: $repoName)"`
+		}
+		variables = map[string]interface{}{
+			"owner":        githubv4.String(owner),
+			"repoName":     githubv4.String(repoName),
+			"filterBy":     newIssueFilters(c.famedLabel, state, since),
+			"issuesCursor": (*githubv4.String)(nil),
+		}
+	)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for {
+		err := client.Query(ctx, &query, variables)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, node := range query.Repository.Issues.Nodes {
+			enrichedIssue, issueComments, err := c.newEnrichedIssue(ctx, owner, repoName, node)
+			if err != nil {
+				return nil, nil, err
+			}
+			enrichedIssues[enrichedIssue.Number] = enrichedIssue
+			comments[enrichedIssue.Number] = issueComments
+		}
+
+		if !query.Repository.Issues.PageInfo.HasNextPage {
+			break
+		}
+		variables["issuesCursor"] = githubv4.NewString(query.Repository.Issues.PageInfo.EndCursor)
+	}
+
+	return enrichedIssues, comments, nil
+}
+
+// newEnrichedIssue returns the EnrichedIssue and bot comments of an issue loaded by GraphQL.
+func (c *githubInstallationClient) newEnrichedIssue(ctx context.Context, owner string, repoName string, node graphQLIssue) (model.EnrichedIssue, []model.IssueComment, error) {
+	issue, err := c.newIssue(ctx, owner, repoName, node.toGitHubIssue())
+	if err != nil {
+		return model.EnrichedIssue{}, nil, err
+	}
+
+	var enrichedIssue model.EnrichedIssue
+	if node.TimelineItems.PageInfo.HasNextPage {
+		enrichedIssue = c.EnrichIssue(ctx, owner, repoName, issue)
+	} else {
+		var events []model.IssueEvent
+		if !issue.Migrated {
+			events = newIssueEvents(node.TimelineItems.Nodes)
+		}
+		enrichedIssue = model.NewEnrichIssue(issue, newLinkedPullRequest(node.TimelineItems.Nodes), events)
+	}
+
+	if node.Comments.PageInfo.HasNextPage {
+		allComments, err := c.GetComments(ctx, owner, repoName, issue.Number)
+		if err != nil {
+			log.Error().Err(err).Msgf("[newEnrichedIssue] error while requesting comments for issue with number %d", issue.Number)
+		}
+		return enrichedIssue, botComments(allComments), nil
+	}
+
+	var comments []model.IssueComment
+	for _, comment := range node.Comments.Nodes {
+		compressedComment, err := comment.toIssueComment()
+		if err != nil {
+			continue
+		}
+		comments = append(comments, compressedComment)
+	}
+
+	return enrichedIssue, comments, nil
+}
+
+// toGitHubIssue returns the issue in the form of the REST API, to be parsed as issues requested by REST.
+func (i graphQLIssue) toGitHubIssue() *github.Issue {
+	id, err := strconv.ParseInt(i.FullDatabaseID, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Msgf("[toGitHubIssue] invalid database ID of issue with number %d", i.Number)
+	}
+
+	issue := &github.Issue{
+		ID:        &id,
+		Number:    &i.Number,
+		HTMLURL:   &i.URL,
+		Title:     &i.Title,
+		CreatedAt: &i.CreatedAt,
+		ClosedAt:  i.ClosedAt,
+		Labels:    make([]*github.Label, 0, len(i.Labels.Nodes)),
+	}
+
+	if i.Milestone != nil {
+		issue.Milestone = &github.Milestone{Title: &i.Milestone.Title}
+	}
+
+	// The REST API returns no body for issues with an empty description
+	if i.Body != "" {
+		issue.Body = &i.Body
+	}
+
+	for _, label := range i.Labels.Nodes {
+		name := label.Name
+		issue.Labels = append(issue.Labels, &github.Label{Name: &name})
+	}
+
+	for _, assignee := range i.Assignees.Nodes {
+		issue.Assignees = append(issue.Assignees, assignee.toGitHubUser(""))
+	}
+
+	return issue
+}
+
+func (u graphQLUser) toGitHubUser(loginSuffix string) *github.User {
+	login := u.Login + loginSuffix
+	avatarURL := u.AvatarURL
+	htmlURL := u.URL
+	return &github.User{Login: &login, AvatarURL: &avatarURL, HTMLURL: &htmlURL}
+}
+
+// toGitHubUser returns the assignee in the form of the REST API, assignees other than users and bots are omitted.
+func (a graphQLAssignee) toGitHubUser() *github.User {
+	switch a.Typename {
+	case "User":
+		return a.User.toGitHubUser("")
+	case "Bot":
+		return a.Bot.toGitHubUser(botLoginSuffix)
+	default:
+		return nil
+	}
+}
+
+// toIssueComment returns an IssueComment for a comment posted by a bot.
+// Comments by other authors are not used by famed and return an error.
+func (c issueComment) toIssueComment() (model.IssueComment, error) {
+	if c.Author.Typename != "Bot" {
+		return model.IssueComment{}, model.ErrIssueCommentMissingData
+	}
+
+	id, err := strconv.ParseInt(c.FullDatabaseID, 10, 64)
+	if err != nil {
+		return model.IssueComment{}, err
+	}
+
+	return model.NewComment(&github.IssueComment{
+		ID:   &id,
+		User: c.Author.toGitHubUser(botLoginSuffix),
+		Body: &c.Body,
+	})
+}
+
+// newIssueEvents returns the assignment, close and reopen events of an issue timeline.
+func newIssueEvents(items []issueTimelineItem) []model.IssueEvent {
+	var events []model.IssueEvent
+	for _, item := range items {
+		var event *github.IssueEvent
+		switch item.Typename {
+		case "AssignedEvent":
+			event = newGitHubIssueEvent(model.IssueEventActionAssigned, item.AssignedEvent.CreatedAt, item.AssignedEvent.Assignee.toGitHubUser())
+		case "UnassignedEvent":
+			event = newGitHubIssueEvent(model.IssueEventActionUnassigned, item.UnassignedEvent.CreatedAt, item.UnassignedEvent.Assignee.toGitHubUser())
+		case "ClosedEvent":
+			event = newGitHubIssueEvent(model.IssueEventActionClosed, item.ClosedEvent.CreatedAt, nil)
+		case "ReopenedEvent":
+			event = newGitHubIssueEvent(model.IssueEventActionReopened, item.ReopenedEvent.CreatedAt, nil)
+		default:
+			continue
+		}
+
+		compressedEvent, err := model.NewIssueEvent(event)
+		if err != nil {
+			continue
+		}
+		events = append(events, compressedEvent)
+	}
+
+	return events
+}
+
+// newGitHubIssueEvent returns an event in the form of the REST API.
+// Timeline events of the GraphQL API have no database ID, the ID of the event is left 0.
+func newGitHubIssueEvent(action model.IssueEventAction, createdAt time.Time, assignee *github.User) *github.IssueEvent {
+	var id int64
+	event := string(action)
+	return &github.IssueEvent{
+		ID:        &id,
+		Event:     &event,
+		CreatedAt: &createdAt,
+		Assignee:  assignee,
+	}
+}
+
+// newLinkedPullRequest returns the pull request linked to an issue by its timeline.
+func newLinkedPullRequest(items []issueTimelineItem) *model.PullRequest {
+	var connectedEvents, disconnectedEvents []connectedEvent
+	for _, item := range items {
+		switch item.Typename {
+		case "ConnectedEvent":
+			connectedEvents = append(connectedEvents, item.ConnectedEvent)
+		case "DisconnectedEvent":
+			disconnectedEvents = append(disconnectedEvents, item.DisconnectedEvent)
+		}
+	}
+
+	return linkedPullRequest(connectedEvents, disconnectedEvents)
+}
+
+// botComments returns the comments posted by bots.
+func botComments(comments []model.IssueComment) []model.IssueComment {
+	var filtered []model.IssueComment
+	for _, comment := range comments {
+		if strings.HasSuffix(comment.Login, botLoginSuffix) {
+			filtered = append(filtered, comment)
+		}
+	}
+
+	return filtered
+}
+
+// newIssueFilters returns the filters of the famed labeled issues in a state updated at or after since.
+func newIssueFilters(famedLabel string, state model.IssueState, since time.Time) githubv4.IssueFilters {
+	labels := []githubv4.String{githubv4.String(famedLabel)}
+	states := graphQLIssueStates(state)
+	filters := githubv4.IssueFilters{
+		Labels: &labels,
+		States: &states,
+	}
+
+	if !since.IsZero() {
+		filters.Since = &githubv4.DateTime{Time: since}
+	}
+
+	return filters
+}
+
+// graphQLIssueStates returns the GraphQL issue states matching an issue state of the REST API.
+func graphQLIssueStates(state model.IssueState) []githubv4.IssueState {
+	switch state {
+	case model.Opened:
+		return []githubv4.IssueState{githubv4.IssueStateOpen}
+	case model.Closed:
+		return []githubv4.IssueState{githubv4.IssueStateClosed}
+	default:
+		return []githubv4.IssueState{githubv4.IssueStateOpen, githubv4.IssueStateClosed}
+	}
+}
@@ -0,0 +1,126 @@
+package providers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers/providersfakes"
+	"github.com/morphysm/famed-github-backend/pkg/pointer"
+)
+
+const (
+	firstIssuesPage = `{"data":{"repository":{"issues":{
+		"nodes":[{
+			"fullDatabaseId":"4294967296","number":1,"url":"https://github.com/testOwner/testRepo/issues/1","title":"Test issue",
+			"body":"","createdAt":"2022-04-01T00:00:00Z","closedAt":"2022-04-03T00:00:00Z",
+			"labels":{"nodes":[{"name":"famed"},{"name":"high"}]},
+			"assignees":{"nodes":[{"login":"testUser","avatarUrl":"https://avatars/testUser","url":"https://github.com/testUser"}]},
+			"timelineItems":{"nodes":[
+				{"__typename":"AssignedEvent","assignee":{"__typename":"User","login":"testUser","avatarUrl":"https://avatars/testUser","url":"https://github.com/testUser"},"createdAt":"2022-04-01T00:00:00Z"},
+				{"__typename":"ConnectedEvent","subject":{"url":"https://github.com/testOwner/testRepo/pull/2"},"createdAt":"2022-04-02T00:00:00Z"},
+				{"__typename":"ClosedEvent","createdAt":"2022-04-03T00:00:00Z"}
+			],"pageInfo":{"endCursor":"","hasNextPage":false}},
+			"comments":{"nodes":[
+				{"fullDatabaseId":"10","author":{"__typename":"Bot","login":"famed","avatarUrl":"https://avatars/famed","url":"https://github.com/apps/famed"},"body":"reward"},
+				{"fullDatabaseId":"11","author":{"__typename":"User","login":"testUser","avatarUrl":"https://avatars/testUser","url":"https://github.com/testUser"},"body":"thanks"}
+			],"pageInfo":{"endCursor":"","hasNextPage":false}}
+		}],
+		"pageInfo":{"endCursor":"cursor","hasNextPage":true}}}}}`
+	secondIssuesPage = `{"data":{"repository":{"issues":{
+		"nodes":[{
+			"fullDatabaseId":"3","number":3,"url":"https://github.com/testOwner/testRepo/issues/3","title":"Test issue",
+			"body":"","createdAt":"2022-04-01T00:00:00Z","closedAt":null,
+			"labels":{"nodes":[{"name":"famed"}]},
+			"assignees":{"nodes":[]},
+			"timelineItems":{"nodes":[
+				{"__typename":"ConnectedEvent","subject":{"url":"https://github.com/testOwner/testRepo/pull/4"},"createdAt":"2022-04-02T00:00:00Z"},
+				{"__typename":"DisconnectedEvent","subject":{"url":"https://github.com/testOwner/testRepo/pull/4"},"createdAt":"2022-04-03T00:00:00Z"}
+			],"pageInfo":{"endCursor":"","hasNextPage":false}},
+			"comments":{"nodes":[],"pageInfo":{"endCursor":"","hasNextPage":false}}
+		}],
+		"pageInfo":{"endCursor":"","hasNextPage":false}}}}}`
+)
+
+func TestGetEnrichedIssuesWithComments(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	var (
+		requests  int32
+		variables []map[string]interface{}
+	)
+	fakeGitHubServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		variables = append(variables, body.Variables)
+
+		if atomic.AddInt32(&requests, 1) == 1 {
+			_, _ = w.Write([]byte(firstIssuesPage))
+			return
+		}
+		_, _ = w.Write([]byte(secondIssuesPage))
+	}))
+	t.Cleanup(fakeGitHubServer.Close)
+
+	githubInstallationClient, err := providers.NewInstallationClient("", &providersfakes.FakeAppClient{}, nil, "", "famed", nil)
+	assert.NoError(t, err)
+	githubInstallationClient.AddGitHubGQLClient("testOwner", githubv4.NewEnterpriseClient(fakeGitHubServer.URL, fakeGitHubServer.Client()))
+
+	testUser := model.User{Login: "testUser", AvatarURL: "https://avatars/testUser", HTMLURL: "https://github.com/testUser"}
+	expectedIssues := map[int]model.EnrichedIssue{
+		1: {
+			Issue: model.Issue{
+				ID:         4294967296,
+				Number:     1,
+				HTMLURL:    "https://github.com/testOwner/testRepo/issues/1",
+				Title:      "Test issue",
+				CreatedAt:  time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+				ClosedAt:   pointer.Time(time.Date(2022, 4, 3, 0, 0, 0, 0, time.UTC)),
+				Assignees:  []model.User{testUser},
+				Severities: []model.IssueSeverity{model.High},
+			},
+			PullRequest: &model.PullRequest{URL: "https://github.com/testOwner/testRepo/pull/2"},
+			Events: []model.IssueEvent{
+				{Event: "assigned", Assignee: &testUser, CreatedAt: time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)},
+				{Event: "closed", CreatedAt: time.Date(2022, 4, 3, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		3: {
+			Issue: model.Issue{
+				ID:        3,
+				Number:    3,
+				HTMLURL:   "https://github.com/testOwner/testRepo/issues/3",
+				Title:     "Test issue",
+				CreatedAt: time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+	expectedComments := map[int][]model.IssueComment{
+		1: {{ID: 10, User: model.User{Login: "famed[bot]", AvatarURL: "https://avatars/famed", HTMLURL: "https://github.com/apps/famed"}, Body: "reward"}},
+		3: nil,
+	}
+
+	// WHEN
+	issues, comments, err := githubInstallationClient.GetEnrichedIssuesWithComments(context.Background(), "testOwner", "testRepo", model.Closed, time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC))
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, expectedIssues, issues)
+	assert.Equal(t, expectedComments, comments)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	assert.Equal(t, map[string]interface{}{"labels": []interface{}{"famed"}, "states": []interface{}{"CLOSED"}, "since": "2022-03-01T00:00:00Z"}, variables[0]["filterBy"])
+	assert.Nil(t, variables[0]["issuesCursor"])
+	assert.Equal(t, "cursor", variables[1]["issuesCursor"])
+}
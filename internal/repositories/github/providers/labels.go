@@ -8,10 +8,54 @@ import (
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 )
 
+// GetLabels returns all labels of a repository.
+func (c *githubInstallationClient) GetLabels(ctx context.Context, owner string, repoName string) ([]model.Label, error) {
+	var (
+		client, err         = c.clients.get(owner)
+		allLabels           []*github.Label
+		allCompressedLabels []model.Label
+		listOptions         = &github.ListOptions{
+			Page:    1,
+			PerPage: 100,
+		}
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		labels, resp, err := client.Issues.ListLabels(ctx, owner, repoName, listOptions)
+		if err != nil {
+			return allCompressedLabels, err
+		}
+		allLabels = append(allLabels, labels...)
+		if resp.NextPage == 0 {
+			break
+		}
+		listOptions.Page = resp.NextPage
+	}
+
+	for _, label := range allLabels {
+		compressedLabel, err := model.NewLabel(label)
+		if err != nil {
+			continue
+		}
+
+		allCompressedLabels = append(allCompressedLabels, compressedLabel)
+	}
+
+	return allCompressedLabels, nil
+}
+
+// PostLabel creates a label in a repository.
 func (c *githubInstallationClient) PostLabel(ctx context.Context, owner string, repoName string, label model.Label) error {
-	client, _ := c.clients.get(owner)
+	client, err := c.clients.get(owner)
+	if err != nil {
+		return err
+	}
 
-	_, _, err := client.Issues.CreateLabel(ctx, owner, repoName, &github.Label{
+	_, _, err = client.Issues.CreateLabel(ctx, owner, repoName, &github.Label{
 		Name:        &label.Name,
 		Color:       &label.Color,
 		Description: &label.Description,
@@ -19,17 +63,18 @@ func (c *githubInstallationClient) PostLabel(ctx context.Context, owner string,
 	return err
 }
 
-func (c *githubInstallationClient) PostLabels(ctx context.Context, owner string, repoNames []string, labels map[string]model.Label) []error {
-	var errors []error
-
-	for _, repo := range repoNames {
-		for _, label := range labels {
-			err := c.PostLabel(ctx, owner, repo, label)
-			if err != nil {
-				errors = append(errors, err)
-			}
-		}
+// UpdateLabel updates the color and description of the label of a repository with the same name.
+// GitHub matches the name case-insensitively, the name's capitalization is updated as well.
+func (c *githubInstallationClient) UpdateLabel(ctx context.Context, owner string, repoName string, label model.Label) error {
+	client, err := c.clients.get(owner)
+	if err != nil {
+		return err
 	}
 
-	return errors
+	_, _, err = client.Issues.EditLabel(ctx, owner, repoName, label.Name, &github.Label{
+		Name:        &label.Name,
+		Color:       &label.Color,
+		Description: &label.Description,
+	})
+	return err
 }
@@ -0,0 +1,26 @@
+package providers
+
+import (
+	"context"
+
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+// GetPermission returns the permission level of a GitHub user on a repository.
+func (c *githubInstallationClient) GetPermission(ctx context.Context, owner string, repoName string, login string) (model.Permission, error) {
+	client, err := c.clients.get(owner)
+	if err != nil {
+		return model.PermissionNone, err
+	}
+
+	permissionLevel, _, err := client.Repositories.GetPermissionLevel(ctx, owner, repoName, login)
+	if err != nil {
+		return model.PermissionNone, err
+	}
+
+	if permissionLevel == nil || permissionLevel.Permission == nil {
+		return model.PermissionNone, nil
+	}
+
+	return model.Permission(*permissionLevel.Permission), nil
+}
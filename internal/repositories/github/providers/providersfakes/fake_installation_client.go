@@ -5,10 +5,12 @@ import (
 	"context"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/google/go-github/v41/github"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers"
+	"github.com/shurcooL/githubv4"
 )
 
 type FakeInstallationClient struct {
@@ -18,6 +20,12 @@ type FakeInstallationClient struct {
 		arg1 string
 		arg2 *github.Client
 	}
+	AddGitHubGQLClientStub        func(string, *githubv4.Client)
+	addGitHubGQLClientMutex       sync.RWMutex
+	addGitHubGQLClientArgsForCall []struct {
+		arg1 string
+		arg2 *githubv4.Client
+	}
 	AddInstallationStub        func(string, int64) error
 	addInstallationMutex       sync.RWMutex
 	addInstallationArgsForCall []struct {
@@ -115,6 +123,25 @@ type FakeInstallationClient struct {
 		result1 map[int]model.EnrichedIssue
 		result2 error
 	}
+	GetEnrichedIssuesWithCommentsStub        func(context.Context, string, string, model.IssueState, time.Time) (map[int]model.EnrichedIssue, map[int][]model.IssueComment, error)
+	getEnrichedIssuesWithCommentsMutex       sync.RWMutex
+	getEnrichedIssuesWithCommentsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 model.IssueState
+		arg5 time.Time
+	}
+	getEnrichedIssuesWithCommentsReturns struct {
+		result1 map[int]model.EnrichedIssue
+		result2 map[int][]model.IssueComment
+		result3 error
+	}
+	getEnrichedIssuesWithCommentsReturnsOnCall map[int]struct {
+		result1 map[int]model.EnrichedIssue
+		result2 map[int][]model.IssueComment
+		result3 error
+	}
 	GetIssueEventsStub        func(context.Context, string, string, int) ([]model.IssueEvent, error)
 	getIssueEventsMutex       sync.RWMutex
 	getIssueEventsArgsForCall []struct {
@@ -131,7 +158,7 @@ type FakeInstallationClient struct {
 		result1 []model.IssueEvent
 		result2 error
 	}
-	GetIssuePullRequestStub        func(context.Context, string, string, int) (*string, error)
+	GetIssuePullRequestStub        func(context.Context, string, string, int) (*model.PullRequest, error)
 	getIssuePullRequestMutex       sync.RWMutex
 	getIssuePullRequestArgsForCall []struct {
 		arg1 context.Context
@@ -140,11 +167,11 @@ type FakeInstallationClient struct {
 		arg4 int
 	}
 	getIssuePullRequestReturns struct {
-		result1 *string
+		result1 *model.PullRequest
 		result2 error
 	}
 	getIssuePullRequestReturnsOnCall map[int]struct {
-		result1 *string
+		result1 *model.PullRequest
 		result2 error
 	}
 	GetIssuesByRepoStub        func(context.Context, string, string, []string, *model.IssueState) ([]model.Issue, error)
@@ -164,6 +191,37 @@ type FakeInstallationClient struct {
 		result1 []model.Issue
 		result2 error
 	}
+	GetLabelsStub        func(context.Context, string, string) ([]model.Label, error)
+	getLabelsMutex       sync.RWMutex
+	getLabelsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	getLabelsReturns struct {
+		result1 []model.Label
+		result2 error
+	}
+	getLabelsReturnsOnCall map[int]struct {
+		result1 []model.Label
+		result2 error
+	}
+	GetPermissionStub        func(context.Context, string, string, string) (model.Permission, error)
+	getPermissionMutex       sync.RWMutex
+	getPermissionArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	getPermissionReturns struct {
+		result1 model.Permission
+		result2 error
+	}
+	getPermissionReturnsOnCall map[int]struct {
+		result1 model.Permission
+		result2 error
+	}
 	GetRateLimitsStub        func(context.Context, string) (model.RateLimits, error)
 	getRateLimitsMutex       sync.RWMutex
 	getRateLimitsArgsForCall []struct {
@@ -178,6 +236,21 @@ type FakeInstallationClient struct {
 		result1 model.RateLimits
 		result2 error
 	}
+	GetRepoConfigStub        func(context.Context, string, string) (model.RepoConfig, error)
+	getRepoConfigMutex       sync.RWMutex
+	getRepoConfigArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	getRepoConfigReturns struct {
+		result1 model.RepoConfig
+		result2 error
+	}
+	getRepoConfigReturnsOnCall map[int]struct {
+		result1 model.RepoConfig
+		result2 error
+	}
 	GetReposStub        func(context.Context, string) ([]string, error)
 	getReposMutex       sync.RWMutex
 	getReposArgsForCall []struct {
@@ -207,6 +280,27 @@ type FakeInstallationClient struct {
 		result1 model.User
 		result2 error
 	}
+	InvalidateRepoConfigStub        func(string, string)
+	invalidateRepoConfigMutex       sync.RWMutex
+	invalidateRepoConfigArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	ParseWebHookEventStub        func(context.Context, string, []byte) (interface{}, error)
+	parseWebHookEventMutex       sync.RWMutex
+	parseWebHookEventArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []byte
+	}
+	parseWebHookEventReturns struct {
+		result1 interface{}
+		result2 error
+	}
+	parseWebHookEventReturnsOnCall map[int]struct {
+		result1 interface{}
+		result2 error
+	}
 	PostCommentStub        func(context.Context, string, string, int, string) error
 	postCommentMutex       sync.RWMutex
 	postCommentArgsForCall []struct {
@@ -236,19 +330,15 @@ type FakeInstallationClient struct {
 	postLabelReturnsOnCall map[int]struct {
 		result1 error
 	}
-	PostLabelsStub        func(context.Context, string, []string, map[string]model.Label) []error
-	postLabelsMutex       sync.RWMutex
-	postLabelsArgsForCall []struct {
-		arg1 context.Context
-		arg2 string
-		arg3 []string
-		arg4 map[string]model.Label
-	}
-	postLabelsReturns struct {
-		result1 []error
+	RemoveInstallationStub        func(string)
+	removeInstallationMutex       sync.RWMutex
+	removeInstallationArgsForCall []struct {
+		arg1 string
 	}
-	postLabelsReturnsOnCall map[int]struct {
-		result1 []error
+	SuspendInstallationStub        func(string)
+	suspendInstallationMutex       sync.RWMutex
+	suspendInstallationArgsForCall []struct {
+		arg1 string
 	}
 	UpdateCommentStub        func(context.Context, string, string, int64, string) error
 	updateCommentMutex       sync.RWMutex
@@ -265,6 +355,20 @@ type FakeInstallationClient struct {
 	updateCommentReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpdateLabelStub        func(context.Context, string, string, model.Label) error
+	updateLabelMutex       sync.RWMutex
+	updateLabelArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 model.Label
+	}
+	updateLabelReturns struct {
+		result1 error
+	}
+	updateLabelReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ValidateWebHookEventStub        func(*http.Request) (interface{}, error)
 	validateWebHookEventMutex       sync.RWMutex
 	validateWebHookEventArgsForCall []struct {
@@ -315,6 +419,39 @@ func (fake *FakeInstallationClient) AddGitHubClientArgsForCall(i int) (string, *
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeInstallationClient) AddGitHubGQLClient(arg1 string, arg2 *githubv4.Client) {
+	fake.addGitHubGQLClientMutex.Lock()
+	fake.addGitHubGQLClientArgsForCall = append(fake.addGitHubGQLClientArgsForCall, struct {
+		arg1 string
+		arg2 *githubv4.Client
+	}{arg1, arg2})
+	stub := fake.AddGitHubGQLClientStub
+	fake.recordInvocation("AddGitHubGQLClient", []interface{}{arg1, arg2})
+	fake.addGitHubGQLClientMutex.Unlock()
+	if stub != nil {
+		fake.AddGitHubGQLClientStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeInstallationClient) AddGitHubGQLClientCallCount() int {
+	fake.addGitHubGQLClientMutex.RLock()
+	defer fake.addGitHubGQLClientMutex.RUnlock()
+	return len(fake.addGitHubGQLClientArgsForCall)
+}
+
+func (fake *FakeInstallationClient) AddGitHubGQLClientCalls(stub func(string, *githubv4.Client)) {
+	fake.addGitHubGQLClientMutex.Lock()
+	defer fake.addGitHubGQLClientMutex.Unlock()
+	fake.AddGitHubGQLClientStub = stub
+}
+
+func (fake *FakeInstallationClient) AddGitHubGQLClientArgsForCall(i int) (string, *githubv4.Client) {
+	fake.addGitHubGQLClientMutex.RLock()
+	defer fake.addGitHubGQLClientMutex.RUnlock()
+	argsForCall := fake.addGitHubGQLClientArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeInstallationClient) AddInstallation(arg1 string, arg2 int64) error {
 	fake.addInstallationMutex.Lock()
 	ret, specificReturn := fake.addInstallationReturnsOnCall[len(fake.addInstallationArgsForCall)]
@@ -769,6 +906,77 @@ func (fake *FakeInstallationClient) GetEnrichedIssuesReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
+func (fake *FakeInstallationClient) GetEnrichedIssuesWithComments(arg1 context.Context, arg2 string, arg3 string, arg4 model.IssueState, arg5 time.Time) (map[int]model.EnrichedIssue, map[int][]model.IssueComment, error) {
+	fake.getEnrichedIssuesWithCommentsMutex.Lock()
+	ret, specificReturn := fake.getEnrichedIssuesWithCommentsReturnsOnCall[len(fake.getEnrichedIssuesWithCommentsArgsForCall)]
+	fake.getEnrichedIssuesWithCommentsArgsForCall = append(fake.getEnrichedIssuesWithCommentsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 model.IssueState
+		arg5 time.Time
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.GetEnrichedIssuesWithCommentsStub
+	fakeReturns := fake.getEnrichedIssuesWithCommentsReturns
+	fake.recordInvocation("GetEnrichedIssuesWithComments", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.getEnrichedIssuesWithCommentsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInstallationClient) GetEnrichedIssuesWithCommentsCallCount() int {
+	fake.getEnrichedIssuesWithCommentsMutex.RLock()
+	defer fake.getEnrichedIssuesWithCommentsMutex.RUnlock()
+	return len(fake.getEnrichedIssuesWithCommentsArgsForCall)
+}
+
+func (fake *FakeInstallationClient) GetEnrichedIssuesWithCommentsCalls(stub func(context.Context, string, string, model.IssueState, time.Time) (map[int]model.EnrichedIssue, map[int][]model.IssueComment, error)) {
+	fake.getEnrichedIssuesWithCommentsMutex.Lock()
+	defer fake.getEnrichedIssuesWithCommentsMutex.Unlock()
+	fake.GetEnrichedIssuesWithCommentsStub = stub
+}
+
+func (fake *FakeInstallationClient) GetEnrichedIssuesWithCommentsArgsForCall(i int) (context.Context, string, string, model.IssueState, time.Time) {
+	fake.getEnrichedIssuesWithCommentsMutex.RLock()
+	defer fake.getEnrichedIssuesWithCommentsMutex.RUnlock()
+	argsForCall := fake.getEnrichedIssuesWithCommentsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeInstallationClient) GetEnrichedIssuesWithCommentsReturns(result1 map[int]model.EnrichedIssue, result2 map[int][]model.IssueComment, result3 error) {
+	fake.getEnrichedIssuesWithCommentsMutex.Lock()
+	defer fake.getEnrichedIssuesWithCommentsMutex.Unlock()
+	fake.GetEnrichedIssuesWithCommentsStub = nil
+	fake.getEnrichedIssuesWithCommentsReturns = struct {
+		result1 map[int]model.EnrichedIssue
+		result2 map[int][]model.IssueComment
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInstallationClient) GetEnrichedIssuesWithCommentsReturnsOnCall(i int, result1 map[int]model.EnrichedIssue, result2 map[int][]model.IssueComment, result3 error) {
+	fake.getEnrichedIssuesWithCommentsMutex.Lock()
+	defer fake.getEnrichedIssuesWithCommentsMutex.Unlock()
+	fake.GetEnrichedIssuesWithCommentsStub = nil
+	if fake.getEnrichedIssuesWithCommentsReturnsOnCall == nil {
+		fake.getEnrichedIssuesWithCommentsReturnsOnCall = make(map[int]struct {
+			result1 map[int]model.EnrichedIssue
+			result2 map[int][]model.IssueComment
+			result3 error
+		})
+	}
+	fake.getEnrichedIssuesWithCommentsReturnsOnCall[i] = struct {
+		result1 map[int]model.EnrichedIssue
+		result2 map[int][]model.IssueComment
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeInstallationClient) GetIssueEvents(arg1 context.Context, arg2 string, arg3 string, arg4 int) ([]model.IssueEvent, error) {
 	fake.getIssueEventsMutex.Lock()
 	ret, specificReturn := fake.getIssueEventsReturnsOnCall[len(fake.getIssueEventsArgsForCall)]
@@ -836,7 +1044,7 @@ func (fake *FakeInstallationClient) GetIssueEventsReturnsOnCall(i int, result1 [
 	}{result1, result2}
 }
 
-func (fake *FakeInstallationClient) GetIssuePullRequest(arg1 context.Context, arg2 string, arg3 string, arg4 int) (*string, error) {
+func (fake *FakeInstallationClient) GetIssuePullRequest(arg1 context.Context, arg2 string, arg3 string, arg4 int) (*model.PullRequest, error) {
 	fake.getIssuePullRequestMutex.Lock()
 	ret, specificReturn := fake.getIssuePullRequestReturnsOnCall[len(fake.getIssuePullRequestArgsForCall)]
 	fake.getIssuePullRequestArgsForCall = append(fake.getIssuePullRequestArgsForCall, struct {
@@ -864,7 +1072,7 @@ func (fake *FakeInstallationClient) GetIssuePullRequestCallCount() int {
 	return len(fake.getIssuePullRequestArgsForCall)
 }
 
-func (fake *FakeInstallationClient) GetIssuePullRequestCalls(stub func(context.Context, string, string, int) (*string, error)) {
+func (fake *FakeInstallationClient) GetIssuePullRequestCalls(stub func(context.Context, string, string, int) (*model.PullRequest, error)) {
 	fake.getIssuePullRequestMutex.Lock()
 	defer fake.getIssuePullRequestMutex.Unlock()
 	fake.GetIssuePullRequestStub = stub
@@ -877,28 +1085,28 @@ func (fake *FakeInstallationClient) GetIssuePullRequestArgsForCall(i int) (conte
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakeInstallationClient) GetIssuePullRequestReturns(result1 *string, result2 error) {
+func (fake *FakeInstallationClient) GetIssuePullRequestReturns(result1 *model.PullRequest, result2 error) {
 	fake.getIssuePullRequestMutex.Lock()
 	defer fake.getIssuePullRequestMutex.Unlock()
 	fake.GetIssuePullRequestStub = nil
 	fake.getIssuePullRequestReturns = struct {
-		result1 *string
+		result1 *model.PullRequest
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeInstallationClient) GetIssuePullRequestReturnsOnCall(i int, result1 *string, result2 error) {
+func (fake *FakeInstallationClient) GetIssuePullRequestReturnsOnCall(i int, result1 *model.PullRequest, result2 error) {
 	fake.getIssuePullRequestMutex.Lock()
 	defer fake.getIssuePullRequestMutex.Unlock()
 	fake.GetIssuePullRequestStub = nil
 	if fake.getIssuePullRequestReturnsOnCall == nil {
 		fake.getIssuePullRequestReturnsOnCall = make(map[int]struct {
-			result1 *string
+			result1 *model.PullRequest
 			result2 error
 		})
 	}
 	fake.getIssuePullRequestReturnsOnCall[i] = struct {
-		result1 *string
+		result1 *model.PullRequest
 		result2 error
 	}{result1, result2}
 }
@@ -976,6 +1184,139 @@ func (fake *FakeInstallationClient) GetIssuesByRepoReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeInstallationClient) GetLabels(arg1 context.Context, arg2 string, arg3 string) ([]model.Label, error) {
+	fake.getLabelsMutex.Lock()
+	ret, specificReturn := fake.getLabelsReturnsOnCall[len(fake.getLabelsArgsForCall)]
+	fake.getLabelsArgsForCall = append(fake.getLabelsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetLabelsStub
+	fakeReturns := fake.getLabelsReturns
+	fake.recordInvocation("GetLabels", []interface{}{arg1, arg2, arg3})
+	fake.getLabelsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInstallationClient) GetLabelsCallCount() int {
+	fake.getLabelsMutex.RLock()
+	defer fake.getLabelsMutex.RUnlock()
+	return len(fake.getLabelsArgsForCall)
+}
+
+func (fake *FakeInstallationClient) GetLabelsCalls(stub func(context.Context, string, string) ([]model.Label, error)) {
+	fake.getLabelsMutex.Lock()
+	defer fake.getLabelsMutex.Unlock()
+	fake.GetLabelsStub = stub
+}
+
+func (fake *FakeInstallationClient) GetLabelsArgsForCall(i int) (context.Context, string, string) {
+	fake.getLabelsMutex.RLock()
+	defer fake.getLabelsMutex.RUnlock()
+	argsForCall := fake.getLabelsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInstallationClient) GetLabelsReturns(result1 []model.Label, result2 error) {
+	fake.getLabelsMutex.Lock()
+	defer fake.getLabelsMutex.Unlock()
+	fake.GetLabelsStub = nil
+	fake.getLabelsReturns = struct {
+		result1 []model.Label
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInstallationClient) GetLabelsReturnsOnCall(i int, result1 []model.Label, result2 error) {
+	fake.getLabelsMutex.Lock()
+	defer fake.getLabelsMutex.Unlock()
+	fake.GetLabelsStub = nil
+	if fake.getLabelsReturnsOnCall == nil {
+		fake.getLabelsReturnsOnCall = make(map[int]struct {
+			result1 []model.Label
+			result2 error
+		})
+	}
+	fake.getLabelsReturnsOnCall[i] = struct {
+		result1 []model.Label
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInstallationClient) GetPermission(arg1 context.Context, arg2 string, arg3 string, arg4 string) (model.Permission, error) {
+	fake.getPermissionMutex.Lock()
+	ret, specificReturn := fake.getPermissionReturnsOnCall[len(fake.getPermissionArgsForCall)]
+	fake.getPermissionArgsForCall = append(fake.getPermissionArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetPermissionStub
+	fakeReturns := fake.getPermissionReturns
+	fake.recordInvocation("GetPermission", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getPermissionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInstallationClient) GetPermissionCallCount() int {
+	fake.getPermissionMutex.RLock()
+	defer fake.getPermissionMutex.RUnlock()
+	return len(fake.getPermissionArgsForCall)
+}
+
+func (fake *FakeInstallationClient) GetPermissionCalls(stub func(context.Context, string, string, string) (model.Permission, error)) {
+	fake.getPermissionMutex.Lock()
+	defer fake.getPermissionMutex.Unlock()
+	fake.GetPermissionStub = stub
+}
+
+func (fake *FakeInstallationClient) GetPermissionArgsForCall(i int) (context.Context, string, string, string) {
+	fake.getPermissionMutex.RLock()
+	defer fake.getPermissionMutex.RUnlock()
+	argsForCall := fake.getPermissionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInstallationClient) GetPermissionReturns(result1 model.Permission, result2 error) {
+	fake.getPermissionMutex.Lock()
+	defer fake.getPermissionMutex.Unlock()
+	fake.GetPermissionStub = nil
+	fake.getPermissionReturns = struct {
+		result1 model.Permission
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInstallationClient) GetPermissionReturnsOnCall(i int, result1 model.Permission, result2 error) {
+	fake.getPermissionMutex.Lock()
+	defer fake.getPermissionMutex.Unlock()
+	fake.GetPermissionStub = nil
+	if fake.getPermissionReturnsOnCall == nil {
+		fake.getPermissionReturnsOnCall = make(map[int]struct {
+			result1 model.Permission
+			result2 error
+		})
+	}
+	fake.getPermissionReturnsOnCall[i] = struct {
+		result1 model.Permission
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInstallationClient) GetRateLimits(arg1 context.Context, arg2 string) (model.RateLimits, error) {
 	fake.getRateLimitsMutex.Lock()
 	ret, specificReturn := fake.getRateLimitsReturnsOnCall[len(fake.getRateLimitsArgsForCall)]
@@ -1041,6 +1382,72 @@ func (fake *FakeInstallationClient) GetRateLimitsReturnsOnCall(i int, result1 mo
 	}{result1, result2}
 }
 
+func (fake *FakeInstallationClient) GetRepoConfig(arg1 context.Context, arg2 string, arg3 string) (model.RepoConfig, error) {
+	fake.getRepoConfigMutex.Lock()
+	ret, specificReturn := fake.getRepoConfigReturnsOnCall[len(fake.getRepoConfigArgsForCall)]
+	fake.getRepoConfigArgsForCall = append(fake.getRepoConfigArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetRepoConfigStub
+	fakeReturns := fake.getRepoConfigReturns
+	fake.recordInvocation("GetRepoConfig", []interface{}{arg1, arg2, arg3})
+	fake.getRepoConfigMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInstallationClient) GetRepoConfigCallCount() int {
+	fake.getRepoConfigMutex.RLock()
+	defer fake.getRepoConfigMutex.RUnlock()
+	return len(fake.getRepoConfigArgsForCall)
+}
+
+func (fake *FakeInstallationClient) GetRepoConfigCalls(stub func(context.Context, string, string) (model.RepoConfig, error)) {
+	fake.getRepoConfigMutex.Lock()
+	defer fake.getRepoConfigMutex.Unlock()
+	fake.GetRepoConfigStub = stub
+}
+
+func (fake *FakeInstallationClient) GetRepoConfigArgsForCall(i int) (context.Context, string, string) {
+	fake.getRepoConfigMutex.RLock()
+	defer fake.getRepoConfigMutex.RUnlock()
+	argsForCall := fake.getRepoConfigArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInstallationClient) GetRepoConfigReturns(result1 model.RepoConfig, result2 error) {
+	fake.getRepoConfigMutex.Lock()
+	defer fake.getRepoConfigMutex.Unlock()
+	fake.GetRepoConfigStub = nil
+	fake.getRepoConfigReturns = struct {
+		result1 model.RepoConfig
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInstallationClient) GetRepoConfigReturnsOnCall(i int, result1 model.RepoConfig, result2 error) {
+	fake.getRepoConfigMutex.Lock()
+	defer fake.getRepoConfigMutex.Unlock()
+	fake.GetRepoConfigStub = nil
+	if fake.getRepoConfigReturnsOnCall == nil {
+		fake.getRepoConfigReturnsOnCall = make(map[int]struct {
+			result1 model.RepoConfig
+			result2 error
+		})
+	}
+	fake.getRepoConfigReturnsOnCall[i] = struct {
+		result1 model.RepoConfig
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInstallationClient) GetRepos(arg1 context.Context, arg2 string) ([]string, error) {
 	fake.getReposMutex.Lock()
 	ret, specificReturn := fake.getReposReturnsOnCall[len(fake.getReposArgsForCall)]
@@ -1172,6 +1579,110 @@ func (fake *FakeInstallationClient) GetUserReturnsOnCall(i int, result1 model.Us
 	}{result1, result2}
 }
 
+func (fake *FakeInstallationClient) InvalidateRepoConfig(arg1 string, arg2 string) {
+	fake.invalidateRepoConfigMutex.Lock()
+	fake.invalidateRepoConfigArgsForCall = append(fake.invalidateRepoConfigArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.InvalidateRepoConfigStub
+	fake.recordInvocation("InvalidateRepoConfig", []interface{}{arg1, arg2})
+	fake.invalidateRepoConfigMutex.Unlock()
+	if stub != nil {
+		fake.InvalidateRepoConfigStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeInstallationClient) InvalidateRepoConfigCallCount() int {
+	fake.invalidateRepoConfigMutex.RLock()
+	defer fake.invalidateRepoConfigMutex.RUnlock()
+	return len(fake.invalidateRepoConfigArgsForCall)
+}
+
+func (fake *FakeInstallationClient) InvalidateRepoConfigCalls(stub func(string, string)) {
+	fake.invalidateRepoConfigMutex.Lock()
+	defer fake.invalidateRepoConfigMutex.Unlock()
+	fake.InvalidateRepoConfigStub = stub
+}
+
+func (fake *FakeInstallationClient) InvalidateRepoConfigArgsForCall(i int) (string, string) {
+	fake.invalidateRepoConfigMutex.RLock()
+	defer fake.invalidateRepoConfigMutex.RUnlock()
+	argsForCall := fake.invalidateRepoConfigArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInstallationClient) ParseWebHookEvent(arg1 context.Context, arg2 string, arg3 []byte) (interface{}, error) {
+	var arg3Copy []byte
+	if arg3 != nil {
+		arg3Copy = make([]byte, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.parseWebHookEventMutex.Lock()
+	ret, specificReturn := fake.parseWebHookEventReturnsOnCall[len(fake.parseWebHookEventArgsForCall)]
+	fake.parseWebHookEventArgsForCall = append(fake.parseWebHookEventArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []byte
+	}{arg1, arg2, arg3Copy})
+	stub := fake.ParseWebHookEventStub
+	fakeReturns := fake.parseWebHookEventReturns
+	fake.recordInvocation("ParseWebHookEvent", []interface{}{arg1, arg2, arg3Copy})
+	fake.parseWebHookEventMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInstallationClient) ParseWebHookEventCallCount() int {
+	fake.parseWebHookEventMutex.RLock()
+	defer fake.parseWebHookEventMutex.RUnlock()
+	return len(fake.parseWebHookEventArgsForCall)
+}
+
+func (fake *FakeInstallationClient) ParseWebHookEventCalls(stub func(context.Context, string, []byte) (interface{}, error)) {
+	fake.parseWebHookEventMutex.Lock()
+	defer fake.parseWebHookEventMutex.Unlock()
+	fake.ParseWebHookEventStub = stub
+}
+
+func (fake *FakeInstallationClient) ParseWebHookEventArgsForCall(i int) (context.Context, string, []byte) {
+	fake.parseWebHookEventMutex.RLock()
+	defer fake.parseWebHookEventMutex.RUnlock()
+	argsForCall := fake.parseWebHookEventArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInstallationClient) ParseWebHookEventReturns(result1 interface{}, result2 error) {
+	fake.parseWebHookEventMutex.Lock()
+	defer fake.parseWebHookEventMutex.Unlock()
+	fake.ParseWebHookEventStub = nil
+	fake.parseWebHookEventReturns = struct {
+		result1 interface{}
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInstallationClient) ParseWebHookEventReturnsOnCall(i int, result1 interface{}, result2 error) {
+	fake.parseWebHookEventMutex.Lock()
+	defer fake.parseWebHookEventMutex.Unlock()
+	fake.ParseWebHookEventStub = nil
+	if fake.parseWebHookEventReturnsOnCall == nil {
+		fake.parseWebHookEventReturnsOnCall = make(map[int]struct {
+			result1 interface{}
+			result2 error
+		})
+	}
+	fake.parseWebHookEventReturnsOnCall[i] = struct {
+		result1 interface{}
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInstallationClient) PostComment(arg1 context.Context, arg2 string, arg3 string, arg4 int, arg5 string) error {
 	fake.postCommentMutex.Lock()
 	ret, specificReturn := fake.postCommentReturnsOnCall[len(fake.postCommentArgsForCall)]
@@ -1301,73 +1812,68 @@ func (fake *FakeInstallationClient) PostLabelReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
-func (fake *FakeInstallationClient) PostLabels(arg1 context.Context, arg2 string, arg3 []string, arg4 map[string]model.Label) []error {
-	var arg3Copy []string
-	if arg3 != nil {
-		arg3Copy = make([]string, len(arg3))
-		copy(arg3Copy, arg3)
-	}
-	fake.postLabelsMutex.Lock()
-	ret, specificReturn := fake.postLabelsReturnsOnCall[len(fake.postLabelsArgsForCall)]
-	fake.postLabelsArgsForCall = append(fake.postLabelsArgsForCall, struct {
-		arg1 context.Context
-		arg2 string
-		arg3 []string
-		arg4 map[string]model.Label
-	}{arg1, arg2, arg3Copy, arg4})
-	stub := fake.PostLabelsStub
-	fakeReturns := fake.postLabelsReturns
-	fake.recordInvocation("PostLabels", []interface{}{arg1, arg2, arg3Copy, arg4})
-	fake.postLabelsMutex.Unlock()
+func (fake *FakeInstallationClient) RemoveInstallation(arg1 string) {
+	fake.removeInstallationMutex.Lock()
+	fake.removeInstallationArgsForCall = append(fake.removeInstallationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RemoveInstallationStub
+	fake.recordInvocation("RemoveInstallation", []interface{}{arg1})
+	fake.removeInstallationMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		fake.RemoveInstallationStub(arg1)
 	}
-	if specificReturn {
-		return ret.result1
-	}
-	return fakeReturns.result1
 }
 
-func (fake *FakeInstallationClient) PostLabelsCallCount() int {
-	fake.postLabelsMutex.RLock()
-	defer fake.postLabelsMutex.RUnlock()
-	return len(fake.postLabelsArgsForCall)
+func (fake *FakeInstallationClient) RemoveInstallationCallCount() int {
+	fake.removeInstallationMutex.RLock()
+	defer fake.removeInstallationMutex.RUnlock()
+	return len(fake.removeInstallationArgsForCall)
 }
 
-func (fake *FakeInstallationClient) PostLabelsCalls(stub func(context.Context, string, []string, map[string]model.Label) []error) {
-	fake.postLabelsMutex.Lock()
-	defer fake.postLabelsMutex.Unlock()
-	fake.PostLabelsStub = stub
+func (fake *FakeInstallationClient) RemoveInstallationCalls(stub func(string)) {
+	fake.removeInstallationMutex.Lock()
+	defer fake.removeInstallationMutex.Unlock()
+	fake.RemoveInstallationStub = stub
 }
 
-func (fake *FakeInstallationClient) PostLabelsArgsForCall(i int) (context.Context, string, []string, map[string]model.Label) {
-	fake.postLabelsMutex.RLock()
-	defer fake.postLabelsMutex.RUnlock()
-	argsForCall := fake.postLabelsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+func (fake *FakeInstallationClient) RemoveInstallationArgsForCall(i int) string {
+	fake.removeInstallationMutex.RLock()
+	defer fake.removeInstallationMutex.RUnlock()
+	argsForCall := fake.removeInstallationArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeInstallationClient) PostLabelsReturns(result1 []error) {
-	fake.postLabelsMutex.Lock()
-	defer fake.postLabelsMutex.Unlock()
-	fake.PostLabelsStub = nil
-	fake.postLabelsReturns = struct {
-		result1 []error
-	}{result1}
+func (fake *FakeInstallationClient) SuspendInstallation(arg1 string) {
+	fake.suspendInstallationMutex.Lock()
+	fake.suspendInstallationArgsForCall = append(fake.suspendInstallationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SuspendInstallationStub
+	fake.recordInvocation("SuspendInstallation", []interface{}{arg1})
+	fake.suspendInstallationMutex.Unlock()
+	if stub != nil {
+		fake.SuspendInstallationStub(arg1)
+	}
 }
 
-func (fake *FakeInstallationClient) PostLabelsReturnsOnCall(i int, result1 []error) {
-	fake.postLabelsMutex.Lock()
-	defer fake.postLabelsMutex.Unlock()
-	fake.PostLabelsStub = nil
-	if fake.postLabelsReturnsOnCall == nil {
-		fake.postLabelsReturnsOnCall = make(map[int]struct {
-			result1 []error
-		})
-	}
-	fake.postLabelsReturnsOnCall[i] = struct {
-		result1 []error
-	}{result1}
+func (fake *FakeInstallationClient) SuspendInstallationCallCount() int {
+	fake.suspendInstallationMutex.RLock()
+	defer fake.suspendInstallationMutex.RUnlock()
+	return len(fake.suspendInstallationArgsForCall)
+}
+
+func (fake *FakeInstallationClient) SuspendInstallationCalls(stub func(string)) {
+	fake.suspendInstallationMutex.Lock()
+	defer fake.suspendInstallationMutex.Unlock()
+	fake.SuspendInstallationStub = stub
+}
+
+func (fake *FakeInstallationClient) SuspendInstallationArgsForCall(i int) string {
+	fake.suspendInstallationMutex.RLock()
+	defer fake.suspendInstallationMutex.RUnlock()
+	argsForCall := fake.suspendInstallationArgsForCall[i]
+	return argsForCall.arg1
 }
 
 func (fake *FakeInstallationClient) UpdateComment(arg1 context.Context, arg2 string, arg3 string, arg4 int64, arg5 string) error {
@@ -1435,6 +1941,70 @@ func (fake *FakeInstallationClient) UpdateCommentReturnsOnCall(i int, result1 er
 	}{result1}
 }
 
+func (fake *FakeInstallationClient) UpdateLabel(arg1 context.Context, arg2 string, arg3 string, arg4 model.Label) error {
+	fake.updateLabelMutex.Lock()
+	ret, specificReturn := fake.updateLabelReturnsOnCall[len(fake.updateLabelArgsForCall)]
+	fake.updateLabelArgsForCall = append(fake.updateLabelArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 model.Label
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateLabelStub
+	fakeReturns := fake.updateLabelReturns
+	fake.recordInvocation("UpdateLabel", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateLabelMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInstallationClient) UpdateLabelCallCount() int {
+	fake.updateLabelMutex.RLock()
+	defer fake.updateLabelMutex.RUnlock()
+	return len(fake.updateLabelArgsForCall)
+}
+
+func (fake *FakeInstallationClient) UpdateLabelCalls(stub func(context.Context, string, string, model.Label) error) {
+	fake.updateLabelMutex.Lock()
+	defer fake.updateLabelMutex.Unlock()
+	fake.UpdateLabelStub = stub
+}
+
+func (fake *FakeInstallationClient) UpdateLabelArgsForCall(i int) (context.Context, string, string, model.Label) {
+	fake.updateLabelMutex.RLock()
+	defer fake.updateLabelMutex.RUnlock()
+	argsForCall := fake.updateLabelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInstallationClient) UpdateLabelReturns(result1 error) {
+	fake.updateLabelMutex.Lock()
+	defer fake.updateLabelMutex.Unlock()
+	fake.UpdateLabelStub = nil
+	fake.updateLabelReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInstallationClient) UpdateLabelReturnsOnCall(i int, result1 error) {
+	fake.updateLabelMutex.Lock()
+	defer fake.updateLabelMutex.Unlock()
+	fake.UpdateLabelStub = nil
+	if fake.updateLabelReturnsOnCall == nil {
+		fake.updateLabelReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateLabelReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInstallationClient) ValidateWebHookEvent(arg1 *http.Request) (interface{}, error) {
 	fake.validateWebHookEventMutex.Lock()
 	ret, specificReturn := fake.validateWebHookEventReturnsOnCall[len(fake.validateWebHookEventArgsForCall)]
@@ -1504,6 +2074,8 @@ func (fake *FakeInstallationClient) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.addGitHubClientMutex.RLock()
 	defer fake.addGitHubClientMutex.RUnlock()
+	fake.addGitHubGQLClientMutex.RLock()
+	defer fake.addGitHubGQLClientMutex.RUnlock()
 	fake.addInstallationMutex.RLock()
 	defer fake.addInstallationMutex.RUnlock()
 	fake.checkInstallationMutex.RLock()
@@ -1518,26 +2090,42 @@ func (fake *FakeInstallationClient) Invocations() map[string][][]interface{} {
 	defer fake.getCommentsMutex.RUnlock()
 	fake.getEnrichedIssuesMutex.RLock()
 	defer fake.getEnrichedIssuesMutex.RUnlock()
+	fake.getEnrichedIssuesWithCommentsMutex.RLock()
+	defer fake.getEnrichedIssuesWithCommentsMutex.RUnlock()
 	fake.getIssueEventsMutex.RLock()
 	defer fake.getIssueEventsMutex.RUnlock()
 	fake.getIssuePullRequestMutex.RLock()
 	defer fake.getIssuePullRequestMutex.RUnlock()
 	fake.getIssuesByRepoMutex.RLock()
 	defer fake.getIssuesByRepoMutex.RUnlock()
+	fake.getLabelsMutex.RLock()
+	defer fake.getLabelsMutex.RUnlock()
+	fake.getPermissionMutex.RLock()
+	defer fake.getPermissionMutex.RUnlock()
 	fake.getRateLimitsMutex.RLock()
 	defer fake.getRateLimitsMutex.RUnlock()
+	fake.getRepoConfigMutex.RLock()
+	defer fake.getRepoConfigMutex.RUnlock()
 	fake.getReposMutex.RLock()
 	defer fake.getReposMutex.RUnlock()
 	fake.getUserMutex.RLock()
 	defer fake.getUserMutex.RUnlock()
+	fake.invalidateRepoConfigMutex.RLock()
+	defer fake.invalidateRepoConfigMutex.RUnlock()
+	fake.parseWebHookEventMutex.RLock()
+	defer fake.parseWebHookEventMutex.RUnlock()
 	fake.postCommentMutex.RLock()
 	defer fake.postCommentMutex.RUnlock()
 	fake.postLabelMutex.RLock()
 	defer fake.postLabelMutex.RUnlock()
-	fake.postLabelsMutex.RLock()
-	defer fake.postLabelsMutex.RUnlock()
+	fake.removeInstallationMutex.RLock()
+	defer fake.removeInstallationMutex.RUnlock()
+	fake.suspendInstallationMutex.RLock()
+	defer fake.suspendInstallationMutex.RUnlock()
 	fake.updateCommentMutex.RLock()
 	defer fake.updateCommentMutex.RUnlock()
+	fake.updateLabelMutex.RLock()
+	defer fake.updateLabelMutex.RUnlock()
 	fake.validateWebHookEventMutex.RLock()
 	defer fake.validateWebHookEventMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
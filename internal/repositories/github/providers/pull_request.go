@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/shurcooL/githubv4"
+
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
 )
 
 type issueTimelineDisconnectionItem struct {
@@ -23,29 +25,25 @@ type connectedEvent struct {
 }
 
 type pullRequest struct {
-	URL string
+	URL    string
+	Merged bool
 }
 
 // GetIssuePullRequest returns a pull request if a linked pull request for the given issue can be found.
 // This is a workaround for the missing "pull_request" field in the event and issue objects provided by the REST GitHub API.
 // https://github.community/t/get-referenced-pull-request-from-issue/14027
-func (c *githubInstallationClient) GetIssuePullRequest(ctx context.Context, owner string, repoName string, issueNumber int) (*string, error) {
+func (c *githubInstallationClient) GetIssuePullRequest(ctx context.Context, owner string, repoName string, issueNumber int) (*model.PullRequest, error) {
 	allTimelineItemsConnected, err := c.getConnectedEvents(ctx, owner, repoName, issueNumber)
 	if err != nil {
 		return nil, err
 	}
 
-	var lastConnectedEvent *issueTimelineConnectionItem
+	connectedEvents := make([]connectedEvent, 0, len(allTimelineItemsConnected))
 	for _, node := range allTimelineItemsConnected {
-		if node.ConnectedEvent.Subject.PullRequest.URL != "" &&
-			(lastConnectedEvent == nil || lastConnectedEvent.ConnectedEvent.CreatedAt.Before(node.ConnectedEvent.CreatedAt)) {
-			// Last pull request connected event
-			tmpN := node
-			lastConnectedEvent = &tmpN
-		}
+		connectedEvents = append(connectedEvents, node.ConnectedEvent)
 	}
 
-	if lastConnectedEvent == nil {
+	if lastConnectedPullRequest(connectedEvents) == nil {
 		return nil, nil
 	}
 
@@ -54,22 +52,54 @@ func (c *githubInstallationClient) GetIssuePullRequest(ctx context.Context, owne
 		return nil, err
 	}
 
+	disconnectedEvents := make([]connectedEvent, 0, len(allTimelineItemsDisconnected))
 	for _, node := range allTimelineItemsDisconnected {
-		if node.DisconnectedEvent.Subject.PullRequest.URL != "" &&
-			lastConnectedEvent.ConnectedEvent.CreatedAt.Before(node.DisconnectedEvent.CreatedAt) {
+		disconnectedEvents = append(disconnectedEvents, node.DisconnectedEvent)
+	}
+
+	return linkedPullRequest(connectedEvents, disconnectedEvents), nil
+}
+
+// lastConnectedPullRequest returns the last event connecting a pull request.
+func lastConnectedPullRequest(connectedEvents []connectedEvent) *connectedEvent {
+	var lastConnectedEvent *connectedEvent
+	for _, event := range connectedEvents {
+		if event.Subject.PullRequest.URL != "" &&
+			(lastConnectedEvent == nil || lastConnectedEvent.CreatedAt.Before(event.CreatedAt)) {
+			tmpE := event
+			lastConnectedEvent = &tmpE
+		}
+	}
+
+	return lastConnectedEvent
+}
+
+// linkedPullRequest returns the pull request connected last, unless a pull request was disconnected afterwards.
+func linkedPullRequest(connectedEvents []connectedEvent, disconnectedEvents []connectedEvent) *model.PullRequest {
+	lastConnectedEvent := lastConnectedPullRequest(connectedEvents)
+	if lastConnectedEvent == nil {
+		return nil
+	}
+
+	for _, event := range disconnectedEvents {
+		if event.Subject.PullRequest.URL != "" &&
+			lastConnectedEvent.CreatedAt.Before(event.CreatedAt) {
 			// Pull request disconnected after last connected event
-			return nil, nil
+			return nil
 		}
 	}
 
-	return &lastConnectedEvent.ConnectedEvent.Subject.PullRequest.URL, nil
+	return &model.PullRequest{
+		URL:    lastConnectedEvent.Subject.PullRequest.URL,
+		Merged: lastConnectedEvent.Subject.PullRequest.Merged,
+	}
 }
 
 // getDisconnectedEvents returns all IssueTimelineDisconnectionItems for a given issue.
 // This is used as a workaround for the missing "pull_request" field in the event and issue objects provided by the REST GitHub API.
 func (c *githubInstallationClient) getDisconnectedEvents(ctx context.Context, owner string, repoName string, issueNumber int) ([]issueTimelineDisconnectionItem, error) {
 	var (
-		client, _        = c.clients.getGql(owner)
+		client, err      = c.clients.getGql(owner)
 		allTimelineItems []issueTimelineDisconnectionItem
 		query            struct {
 			Repository struct {
@@ -92,6 +122,10 @@ func (c *githubInstallationClient) getDisconnectedEvents(ctx context.Context, ow
 		}
 	)
 
+	if err != nil {
+		return nil, err
+	}
+
 	for {
 		err := client.Query(ctx, &query, variables)
 		if err != nil {
@@ -112,7 +146,7 @@ func (c *githubInstallationClient) getDisconnectedEvents(ctx context.Context, ow
 // This is used as a workaround for the missing "pull_request" field in the event and issue objects provided by the REST GitHub API.
 func (c *githubInstallationClient) getConnectedEvents(ctx context.Context, owner string, repoName string, issueNumber int) ([]issueTimelineConnectionItem, error) {
 	var (
-		client, _        = c.clients.getGql(owner)
+		client, err      = c.clients.getGql(owner)
 		allTimelineItems []issueTimelineConnectionItem
 		query            struct {
 			Repository struct {
@@ -135,6 +169,10 @@ func (c *githubInstallationClient) getConnectedEvents(ctx context.Context, owner
 		}
 	)
 
+	if err != nil {
+		return nil, err
+	}
+
 	for {
 		err := client.Query(ctx, &query, variables)
 		if err != nil {
@@ -2,11 +2,14 @@ package providers
 
 import (
 	"context"
+	"time"
 
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+	libHttp "github.com/morphysm/famed-github-backend/pkg/http"
 )
 
-// GetRateLimits returns the GitHub rate limits of a user or organization that installed the Famed app.
+// GetRateLimits returns the GitHub rate limits of a user or organization that installed the Famed app
+// and the state of the throttling of its requests.
 func (c *githubInstallationClient) GetRateLimits(ctx context.Context, owner string) (model.RateLimits, error) {
 	client, err := c.clients.get(owner)
 	if err != nil {
@@ -23,5 +26,32 @@ func (c *githubInstallationClient) GetRateLimits(ctx context.Context, owner stri
 		return model.RateLimits{}, err
 	}
 
+	if throttle, ok := c.clients.getThrottle(owner); ok {
+		compressedRateLimit.Throttle = newThrottle(throttle.State(), time.Now())
+	}
+
 	return compressedRateLimit, nil
 }
+
+// newThrottle returns the model of a throttle state, a pause that has ended is omitted.
+func newThrottle(state libHttp.ThrottleState, now time.Time) *model.Throttle {
+	throttle := &model.Throttle{
+		Resources: make(map[string]model.Rate, len(state.Resources)),
+		InFlight:  state.InFlight,
+	}
+
+	for name, resource := range state.Resources {
+		throttle.Resources[name] = model.Rate{
+			Limit:     resource.Limit,
+			Remaining: resource.Remaining,
+			Reset:     resource.Reset,
+		}
+	}
+
+	if state.BlockedUntil.After(now) {
+		blockedUntil := state.BlockedUntil
+		throttle.BlockedUntil = &blockedUntil
+	}
+
+	return throttle
+}
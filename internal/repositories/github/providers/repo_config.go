@@ -0,0 +1,99 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/google/go-github/v41/github"
+
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+// safeRepoConfigMap represents a cache of repository configurations keyed by owner and repository name.
+// Configurations that failed to parse are cached with their error.
+type safeRepoConfigMap struct {
+	sync.RWMutex
+	repoConfigs map[string]cachedRepoConfig
+}
+
+type cachedRepoConfig struct {
+	repoConfig model.RepoConfig
+	err        error
+}
+
+func newSafeRepoConfigMap() *safeRepoConfigMap {
+	return &safeRepoConfigMap{
+		repoConfigs: make(map[string]cachedRepoConfig),
+	}
+}
+
+func (s *safeRepoConfigMap) add(owner string, repoName string, repoConfig model.RepoConfig, err error) {
+	s.Lock()
+	defer s.Unlock()
+	s.repoConfigs[repoConfigKey(owner, repoName)] = cachedRepoConfig{repoConfig: repoConfig, err: err}
+}
+
+func (s *safeRepoConfigMap) get(owner string, repoName string) (cachedRepoConfig, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	cached, ok := s.repoConfigs[repoConfigKey(owner, repoName)]
+	return cached, ok
+}
+
+func (s *safeRepoConfigMap) delete(owner string, repoName string) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.repoConfigs, repoConfigKey(owner, repoName))
+}
+
+func repoConfigKey(owner string, repoName string) string {
+	return strings.ToLower(owner + "/" + repoName)
+}
+
+// GetRepoConfig returns the famed configuration committed to the default branch of a repository.
+// If the repository has no configuration file, an empty configuration is returned.
+// Configurations and the errors of invalid configurations are cached until invalidated by InvalidateRepoConfig.
+func (c *githubInstallationClient) GetRepoConfig(ctx context.Context, owner string, repoName string) (model.RepoConfig, error) {
+	if cached, ok := c.cachedRepoConfigs.get(owner, repoName); ok {
+		return cached.repoConfig, cached.err
+	}
+
+	client, err := c.clients.get(owner)
+	if err != nil {
+		return model.RepoConfig{}, err
+	}
+
+	file, _, _, err := client.Repositories.GetContents(ctx, owner, repoName, model.RepoConfigPath, nil)
+	if err != nil {
+		var errResponse *github.ErrorResponse
+		if errors.As(err, &errResponse) && errResponse.Response != nil && errResponse.Response.StatusCode == http.StatusNotFound {
+			c.cachedRepoConfigs.add(owner, repoName, model.RepoConfig{}, nil)
+			return model.RepoConfig{}, nil
+		}
+
+		return model.RepoConfig{}, err
+	}
+
+	if file == nil {
+		c.cachedRepoConfigs.add(owner, repoName, model.RepoConfig{}, nil)
+		return model.RepoConfig{}, nil
+	}
+
+	content, err := file.GetContent()
+	if err != nil {
+		return model.RepoConfig{}, err
+	}
+
+	repoConfig, err := model.NewRepoConfig(content)
+	c.cachedRepoConfigs.add(owner, repoName, repoConfig, err)
+
+	return repoConfig, err
+}
+
+// InvalidateRepoConfig removes the cached configuration of a repository.
+func (c *githubInstallationClient) InvalidateRepoConfig(owner string, repoName string) {
+	c.cachedRepoConfigs.delete(owner, repoName)
+}
@@ -10,7 +10,7 @@ import (
 
 func (c *githubInstallationClient) GetRepos(ctx context.Context, owner string) ([]string, error) {
 	var (
-		client, _          = c.clients.get(owner)
+		client, err        = c.clients.get(owner)
 		allRepos           []*github.Repository
 		allCompressedRepos []string
 		listOptions        = &github.ListOptions{
@@ -19,6 +19,10 @@ func (c *githubInstallationClient) GetRepos(ctx context.Context, owner string) (
 		}
 	)
 
+	if err != nil {
+		return nil, err
+	}
+
 	for {
 		repoList, resp, err := client.Apps.ListRepos(ctx, listOptions)
 		if err != nil {
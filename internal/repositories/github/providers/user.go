@@ -8,7 +8,10 @@ import (
 
 // GetUser returns a GitHub user for a given login.
 func (c *githubInstallationClient) GetUser(ctx context.Context, owner string, login string) (model.User, error) {
-	client, _ := c.clients.get(owner)
+	client, err := c.clients.get(owner)
+	if err != nil {
+		return model.User{}, err
+	}
 
 	user, _, err := client.Users.Get(ctx, login)
 	if err != nil {
@@ -0,0 +1,708 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	famedModel "github.com/morphysm/famed-github-backend/internal/famed/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+var (
+	issuesBucket     = []byte("issues")
+	boardsBucket     = []byte("boards")
+	ledgerBucket     = []byte("ledger")
+	overridesBucket  = []byte("overrides")
+	hiddenBucket     = []byte("hidden")
+	jobsBucket       = []byte("jobs")
+	deadJobsBucket   = []byte("deadjobs")
+	deliveriesBucket = []byte("deliveries")
+	payloadsBucket   = []byte("payloads")
+	// deliveryOrderBucket maps the sequence number of a delivery to its ID to evict the oldest deliveries
+	deliveryOrderBucket  = []byte("deliveryorder")
+	highWaterMarksBucket = []byte("highwatermarks")
+	cleanStateRunsBucket = []byte("cleanstateruns")
+	// commentsVersionsBucket maps a repository to the version its bot comments were migrated to
+	commentsVersionsBucket = []byte("commentsversions")
+)
+
+// boltStore is a Store backed by an embedded bbolt database file.
+type boltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore opens (or creates) the bbolt database at path and returns a Store using it.
+func NewBoltStore(path string) (Store, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{issuesBucket, boardsBucket, ledgerBucket, overridesBucket, hiddenBucket, jobsBucket, deadJobsBucket, deliveriesBucket, payloadsBucket, deliveryOrderBucket, highWaterMarksBucket, cleanStateRunsBucket, commentsVersionsBucket} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return &boltStore{db: db}, nil
+}
+
+// GetIssues returns all stored issues of a repository mapped by issue number.
+func (s *boltStore) GetIssues(owner string, repoName string) (map[int]model.EnrichedIssue, error) {
+	issues := make(map[int]model.EnrichedIssue)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		repoBucket := tx.Bucket(issuesBucket).Bucket(repoKey(owner, repoName))
+		if repoBucket == nil {
+			return nil
+		}
+
+		return repoBucket.ForEach(func(_, value []byte) error {
+			var issue model.EnrichedIssue
+			if err := json.Unmarshal(value, &issue); err != nil {
+				return err
+			}
+			issues[issue.Number] = issue
+			return nil
+		})
+	})
+
+	return issues, err
+}
+
+// PutIssues replaces all stored issues of a repository.
+func (s *boltStore) PutIssues(owner string, repoName string, issues map[int]model.EnrichedIssue) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		key := repoKey(owner, repoName)
+		bucket := tx.Bucket(issuesBucket)
+		if bucket.Bucket(key) != nil {
+			if err := bucket.DeleteBucket(key); err != nil {
+				return err
+			}
+		}
+
+		repoBucket, err := bucket.CreateBucket(key)
+		if err != nil {
+			return err
+		}
+
+		for _, issue := range issues {
+			if err := putJSON(repoBucket, issueKey(issue.Number), issue); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// PutIssue adds or replaces a single issue of a repository.
+func (s *boltStore) PutIssue(owner string, repoName string, issue model.EnrichedIssue) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		repoBucket, err := tx.Bucket(issuesBucket).CreateBucketIfNotExists(repoKey(owner, repoName))
+		if err != nil {
+			return err
+		}
+
+		return putJSON(repoBucket, issueKey(issue.Number), issue)
+	})
+}
+
+// DeleteIssue removes a single issue of a repository, removing an issue that is not stored is not an error.
+func (s *boltStore) DeleteIssue(owner string, repoName string, issueNumber int) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		repoBucket := tx.Bucket(issuesBucket).Bucket(repoKey(owner, repoName))
+		if repoBucket == nil {
+			return nil
+		}
+
+		return repoBucket.Delete(issueKey(issueNumber))
+	})
+}
+
+// GetBoard returns the stored board of a repository and whether it was found.
+func (s *boltStore) GetBoard(owner string, repoName string, team Team) (Board, bool, error) {
+	var (
+		board Board
+		found bool
+	)
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(boardsBucket).Get(boardKey(owner, repoName, team))
+		if value == nil {
+			return nil
+		}
+
+		found = true
+		return json.Unmarshal(value, &board)
+	})
+	if err != nil {
+		return Board{}, false, err
+	}
+
+	return board, found, nil
+}
+
+// PutBoard adds or replaces the board of a repository.
+func (s *boltStore) PutBoard(owner string, repoName string, team Team, board Board) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return putJSON(tx.Bucket(boardsBucket), boardKey(owner, repoName, team), board)
+	})
+}
+
+// GetLedgerEntries returns all ledger entries grouped by repository.
+func (s *boltStore) GetLedgerEntries() ([]famedModel.LedgerEntry, error) {
+	entries := []famedModel.LedgerEntry{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(ledgerBucket).ForEach(func(_, value []byte) error {
+			var entry famedModel.LedgerEntry
+			if err := json.Unmarshal(value, &entry); err != nil {
+				return err
+			}
+			entries = append(entries, entry)
+			return nil
+		})
+	})
+
+	return entries, err
+}
+
+// GetIssueLedgerEntries returns the ledger entries of an issue mapped by the contributor's login.
+func (s *boltStore) GetIssueLedgerEntries(owner string, repoName string, issueNumber int) (map[string]famedModel.LedgerEntry, error) {
+	entries := make(map[string]famedModel.LedgerEntry)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		prefix := ledgerIssuePrefix(owner, repoName, issueNumber)
+		cursor := tx.Bucket(ledgerBucket).Cursor()
+		for key, value := cursor.Seek(prefix); key != nil && bytes.HasPrefix(key, prefix); key, value = cursor.Next() {
+			var entry famedModel.LedgerEntry
+			if err := json.Unmarshal(value, &entry); err != nil {
+				return err
+			}
+			entries[entry.Login] = entry
+		}
+		return nil
+	})
+
+	return entries, err
+}
+
+// GetLedgerEntry returns the ledger entry of a contributor for an issue and whether it was found.
+func (s *boltStore) GetLedgerEntry(owner string, repoName string, issueNumber int, login string) (famedModel.LedgerEntry, bool, error) {
+	var (
+		entry famedModel.LedgerEntry
+		found bool
+	)
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(ledgerBucket).Get(ledgerKey(owner, repoName, issueNumber, login))
+		if value == nil {
+			return nil
+		}
+
+		found = true
+		return json.Unmarshal(value, &entry)
+	})
+	if err != nil {
+		return famedModel.LedgerEntry{}, false, err
+	}
+
+	return entry, found, nil
+}
+
+// PutLedgerEntry adds or replaces a ledger entry.
+func (s *boltStore) PutLedgerEntry(entry famedModel.LedgerEntry) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return putJSON(tx.Bucket(ledgerBucket), ledgerKey(entry.Owner, entry.RepoName, entry.IssueNumber, entry.Login), entry)
+	})
+}
+
+// DeleteLedgerEntry removes a ledger entry.
+func (s *boltStore) DeleteLedgerEntry(owner string, repoName string, issueNumber int, login string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(ledgerBucket).Delete(ledgerKey(owner, repoName, issueNumber, login))
+	})
+}
+
+// GetOverrides returns the stored reward overrides of a repository mapped by issue number.
+func (s *boltStore) GetOverrides(owner string, repoName string) (map[int]famedModel.RewardOverrides, error) {
+	overrides := make(map[int]famedModel.RewardOverrides)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		repoBucket := tx.Bucket(overridesBucket).Bucket(repoKey(owner, repoName))
+		if repoBucket == nil {
+			return nil
+		}
+
+		return repoBucket.ForEach(func(key, value []byte) error {
+			issueNumber, err := strconv.Atoi(string(key))
+			if err != nil {
+				return err
+			}
+
+			var issueOverrides famedModel.RewardOverrides
+			if err := json.Unmarshal(value, &issueOverrides); err != nil {
+				return err
+			}
+			overrides[issueNumber] = issueOverrides
+			return nil
+		})
+	})
+
+	return overrides, err
+}
+
+// PutOverrides adds or replaces the reward overrides of an issue.
+func (s *boltStore) PutOverrides(owner string, repoName string, issueNumber int, overrides famedModel.RewardOverrides) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		repoBucket, err := tx.Bucket(overridesBucket).CreateBucketIfNotExists(repoKey(owner, repoName))
+		if err != nil {
+			return err
+		}
+
+		return putJSON(repoBucket, issueKey(issueNumber), overrides)
+	})
+}
+
+// DeleteOverrides removes the reward overrides of an issue.
+func (s *boltStore) DeleteOverrides(owner string, repoName string, issueNumber int) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		repoBucket := tx.Bucket(overridesBucket).Bucket(repoKey(owner, repoName))
+		if repoBucket == nil {
+			return nil
+		}
+
+		return repoBucket.Delete(issueKey(issueNumber))
+	})
+}
+
+// DeleteRepo removes the stored issues, boards, high-water mark and comments version of a repository.
+// The ledger and the reward overrides are kept since they cannot be recomputed from GitHub.
+func (s *boltStore) DeleteRepo(owner string, repoName string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		err := tx.Bucket(issuesBucket).DeleteBucket(repoKey(owner, repoName))
+		if err != nil && err != bolt.ErrBucketNotFound {
+			return err
+		}
+
+		boards := tx.Bucket(boardsBucket)
+		for _, team := range []Team{BlueTeam, RedTeam} {
+			if err := boards.Delete(boardKey(owner, repoName, team)); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Bucket(highWaterMarksBucket).Delete(repoKey(owner, repoName)); err != nil {
+			return err
+		}
+
+		return tx.Bucket(commentsVersionsBucket).Delete(repoKey(owner, repoName))
+	})
+}
+
+// IsRepoHidden returns whether the boards of a repository are hidden.
+func (s *boltStore) IsRepoHidden(owner string, repoName string) (bool, error) {
+	var hidden bool
+	err := s.db.View(func(tx *bolt.Tx) error {
+		hidden = tx.Bucket(hiddenBucket).Get(repoKey(owner, repoName)) != nil
+		return nil
+	})
+
+	return hidden, err
+}
+
+// SetRepoHidden hides or shows the boards of a repository.
+func (s *boltStore) SetRepoHidden(owner string, repoName string, hidden bool) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if !hidden {
+			return tx.Bucket(hiddenBucket).Delete(repoKey(owner, repoName))
+		}
+
+		return tx.Bucket(hiddenBucket).Put(repoKey(owner, repoName), []byte{1})
+	})
+}
+
+// GetJobs returns all queued jobs in the order they were enqueued.
+func (s *boltStore) GetJobs() ([]Job, error) {
+	return s.getJobs(jobsBucket)
+}
+
+// PutJob adds or replaces a queued job.
+// A job without an ID is assigned the next ID of the queue, the stored job is returned.
+func (s *boltStore) PutJob(job Job) (Job, error) {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(jobsBucket)
+		if job.ID == 0 {
+			id, err := bucket.NextSequence()
+			if err != nil {
+				return err
+			}
+			job.ID = id
+		}
+
+		return putJSON(bucket, sequenceKey(job.ID), job)
+	})
+
+	return job, err
+}
+
+// DeleteJob removes a queued job, removing a job that is not queued is not an error.
+func (s *boltStore) DeleteJob(id uint64) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(jobsBucket).Delete(sequenceKey(id))
+	})
+}
+
+// DeadLetterJob moves a job from the queue to the dead letters.
+// Only the limit most recently enqueued dead-lettered jobs are kept.
+func (s *boltStore) DeadLetterJob(job Job, limit int) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(jobsBucket).Delete(sequenceKey(job.ID)); err != nil {
+			return err
+		}
+
+		deadJobs := tx.Bucket(deadJobsBucket)
+		if err := putJSON(deadJobs, sequenceKey(job.ID), job); err != nil {
+			return err
+		}
+
+		// Evict the dead-lettered jobs that fell out of the limit, the oldest have the lowest IDs
+		cursor := deadJobs.Cursor()
+		n := 0
+		for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+			n++
+		}
+		for ; n > limit; n-- {
+			cursor.First()
+			if err := cursor.Delete(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetDeadJobs returns all dead-lettered jobs in the order they were enqueued.
+func (s *boltStore) GetDeadJobs() ([]Job, error) {
+	return s.getJobs(deadJobsBucket)
+}
+
+// AddDelivery records a delivery and its payload unless a delivery with the same ID is recorded and returns whether it was added.
+// A recorded delivery that failed or was dead-lettered is replaced, so that its redelivery is processed again.
+// Only the limit most recent deliveries are kept.
+func (s *boltStore) AddDelivery(delivery Delivery, payload []byte, limit int) (bool, error) {
+	var added bool
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		deliveries := tx.Bucket(deliveriesBucket)
+		if value := deliveries.Get([]byte(delivery.ID)); value != nil {
+			var recorded Delivery
+			if err := json.Unmarshal(value, &recorded); err != nil {
+				return err
+			}
+			if recorded.Status != DeliveryFailed && recorded.Status != DeliveryDeadLettered {
+				return nil
+			}
+
+			// The delivery keeps its position in the delivery order
+			if err := putJSON(deliveries, []byte(delivery.ID), delivery); err != nil {
+				return err
+			}
+			if err := tx.Bucket(payloadsBucket).Put([]byte(delivery.ID), payload); err != nil {
+				return err
+			}
+			added = true
+
+			return nil
+		}
+
+		order := tx.Bucket(deliveryOrderBucket)
+		seq, err := order.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		if err := order.Put(sequenceKey(seq), []byte(delivery.ID)); err != nil {
+			return err
+		}
+		if err := putJSON(deliveries, []byte(delivery.ID), delivery); err != nil {
+			return err
+		}
+		payloads := tx.Bucket(payloadsBucket)
+		if err := payloads.Put([]byte(delivery.ID), payload); err != nil {
+			return err
+		}
+		added = true
+
+		// Evict the deliveries that fell out of the limit
+		if seq <= uint64(limit) {
+			return nil
+		}
+		cutoff := sequenceKey(seq - uint64(limit))
+		cursor := order.Cursor()
+		for key, id := cursor.First(); key != nil && bytes.Compare(key, cutoff) <= 0; key, id = cursor.First() {
+			if err := deliveries.Delete(id); err != nil {
+				return err
+			}
+			if err := payloads.Delete(id); err != nil {
+				return err
+			}
+			if err := cursor.Delete(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return added, err
+}
+
+// SetDeliveryStatus updates the status of a recorded delivery, updating a delivery that is not recorded is not an error.
+func (s *boltStore) SetDeliveryStatus(id string, status DeliveryStatus, lastError string, updatedAt time.Time) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		deliveries := tx.Bucket(deliveriesBucket)
+		value := deliveries.Get([]byte(id))
+		if value == nil {
+			return nil
+		}
+
+		var delivery Delivery
+		if err := json.Unmarshal(value, &delivery); err != nil {
+			return err
+		}
+		delivery.Status = status
+		delivery.LastError = lastError
+		delivery.UpdatedAt = updatedAt
+
+		return putJSON(deliveries, []byte(id), delivery)
+	})
+}
+
+// GetDeliveries returns the recorded deliveries, most recent first.
+func (s *boltStore) GetDeliveries() ([]Delivery, error) {
+	var deliveries []Delivery
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(deliveriesBucket)
+		cursor := tx.Bucket(deliveryOrderBucket).Cursor()
+		for key, id := cursor.Last(); key != nil; key, id = cursor.Prev() {
+			var delivery Delivery
+			if err := json.Unmarshal(bucket.Get(id), &delivery); err != nil {
+				return err
+			}
+			deliveries = append(deliveries, delivery)
+		}
+
+		return nil
+	})
+
+	return deliveries, err
+}
+
+// GetDelivery returns a recorded delivery.
+func (s *boltStore) GetDelivery(id string) (Delivery, bool, error) {
+	var (
+		delivery Delivery
+		found    bool
+	)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(deliveriesBucket).Get([]byte(id))
+		if value == nil {
+			return nil
+		}
+
+		found = true
+		return json.Unmarshal(value, &delivery)
+	})
+
+	return delivery, found, err
+}
+
+// GetDeliveryPayload returns the payload of a recorded delivery.
+func (s *boltStore) GetDeliveryPayload(id string) ([]byte, bool, error) {
+	var payload []byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(payloadsBucket).Get([]byte(id))
+		if value != nil {
+			// Values are only valid during the transaction
+			payload = append([]byte{}, value...)
+		}
+		return nil
+	})
+
+	return payload, payload != nil, err
+}
+
+// GetHighWaterMark returns the time the state worker last processed a repository.
+func (s *boltStore) GetHighWaterMark(owner string, repoName string) (time.Time, bool, error) {
+	var (
+		mark  time.Time
+		found bool
+	)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(highWaterMarksBucket).Get(repoKey(owner, repoName))
+		if value == nil {
+			return nil
+		}
+
+		found = true
+		return json.Unmarshal(value, &mark)
+	})
+
+	return mark, found, err
+}
+
+// PutHighWaterMark stores the time the state worker last processed a repository.
+func (s *boltStore) PutHighWaterMark(owner string, repoName string, mark time.Time) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return putJSON(tx.Bucket(highWaterMarksBucket), repoKey(owner, repoName), mark)
+	})
+}
+
+// GetCommentsVersion returns the version the bot comments of a repository were last migrated to.
+func (s *boltStore) GetCommentsVersion(owner string, repoName string) (string, bool, error) {
+	var (
+		version string
+		found   bool
+	)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(commentsVersionsBucket).Get(repoKey(owner, repoName))
+		if value == nil {
+			return nil
+		}
+
+		found = true
+		return json.Unmarshal(value, &version)
+	})
+
+	return version, found, err
+}
+
+// PutCommentsVersion stores the version the bot comments of a repository were migrated to.
+func (s *boltStore) PutCommentsVersion(owner string, repoName string, version string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return putJSON(tx.Bucket(commentsVersionsBucket), repoKey(owner, repoName), version)
+	})
+}
+
+// AddCleanStateRun records the report of a state worker run, only the limit most recent reports are kept.
+func (s *boltStore) AddCleanStateRun(run CleanStateRun, limit int) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(cleanStateRunsBucket)
+		seq, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		if err := putJSON(bucket, sequenceKey(seq), run); err != nil {
+			return err
+		}
+
+		// Evict the reports that fell out of the limit
+		if seq <= uint64(limit) {
+			return nil
+		}
+		cutoff := sequenceKey(seq - uint64(limit))
+		cursor := bucket.Cursor()
+		for key, _ := cursor.First(); key != nil && bytes.Compare(key, cutoff) <= 0; key, _ = cursor.First() {
+			if err := cursor.Delete(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetCleanStateRuns returns the recorded state worker reports, most recent first.
+func (s *boltStore) GetCleanStateRuns() ([]CleanStateRun, error) {
+	var runs []CleanStateRun
+	err := s.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(cleanStateRunsBucket).Cursor()
+		for key, value := cursor.Last(); key != nil; key, value = cursor.Prev() {
+			var run CleanStateRun
+			if err := json.Unmarshal(value, &run); err != nil {
+				return err
+			}
+			runs = append(runs, run)
+		}
+
+		return nil
+	})
+
+	return runs, err
+}
+
+func (s *boltStore) getJobs(bucket []byte) ([]Job, error) {
+	var jobs []Job
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).ForEach(func(_, value []byte) error {
+			var job Job
+			if err := json.Unmarshal(value, &job); err != nil {
+				return err
+			}
+			jobs = append(jobs, job)
+			return nil
+		})
+	})
+
+	return jobs, err
+}
+
+// Close closes the underlying database.
+func (s *boltStore) Close() error {
+	return s.db.Close()
+}
+
+// putJSON stores the JSON encoding of value at key in bucket.
+func putJSON(bucket *bolt.Bucket, key []byte, value interface{}) error {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return bucket.Put(key, b)
+}
+
+// repoKey returns the key of a repository.
+// Owner and repository names are lowercased because GitHub treats them case-insensitively.
+func repoKey(owner string, repoName string) []byte {
+	return []byte(strings.ToLower(owner + "/" + repoName))
+}
+
+func issueKey(issueNumber int) []byte {
+	return []byte(strconv.Itoa(issueNumber))
+}
+
+func boardKey(owner string, repoName string, team Team) []byte {
+	return append(repoKey(owner, repoName), []byte("/"+string(team))...)
+}
+
+// ledgerIssuePrefix returns the prefix shared by the ledger keys of an issue.
+// The trailing separator keeps issue 1 from matching the entries of issue 10.
+func ledgerIssuePrefix(owner string, repoName string, issueNumber int) []byte {
+	return append(repoKey(owner, repoName), []byte(fmt.Sprintf("#%d/", issueNumber))...)
+}
+
+func ledgerKey(owner string, repoName string, issueNumber int, login string) []byte {
+	return append(ledgerIssuePrefix(owner, repoName, issueNumber), []byte(strings.ToLower(login))...)
+}
+
+// sequenceKey returns the key of a job, of a delivery sequence number or of a state worker report.
+// Keys are big-endian so that iterating a bucket yields them in the order they were added.
+func sequenceKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
@@ -0,0 +1,441 @@
+package storage_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	famedModel "github.com/morphysm/famed-github-backend/internal/famed/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage"
+)
+
+var testTime = time.Date(2022, 4, 20, 0, 0, 0, 0, time.UTC)
+
+func newTestStore(t *testing.T) storage.Store {
+	t.Helper()
+
+	store, err := storage.NewBoltStore(filepath.Join(t.TempDir(), "famed.db"))
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	return store
+}
+
+func TestIssues(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	store := newTestStore(t)
+	closedAt := testTime.Add(24 * time.Hour)
+	issue := model.EnrichedIssue{
+		Issue: model.Issue{
+			ID:         1,
+			Number:     2,
+			HTMLURL:    "TestURL",
+			Title:      "TestIssue",
+			CreatedAt:  testTime,
+			ClosedAt:   &closedAt,
+			Assignees:  []model.User{{Login: "testUser"}},
+			Severities: []model.IssueSeverity{model.Low},
+		},
+		Events: []model.IssueEvent{{ID: 3, Event: "assigned", Assignee: &model.User{Login: "testUser"}, CreatedAt: testTime}},
+	}
+
+	// WHEN
+	err := store.PutIssues("testOwner", "testRepo", map[int]model.EnrichedIssue{2: issue})
+	assert.NoError(t, err)
+	err = store.PutIssue("TestOwner", "TestRepo", model.EnrichedIssue{Issue: model.Issue{Number: 3, CreatedAt: testTime}})
+	assert.NoError(t, err)
+	issues, err := store.GetIssues("testowner", "testrepo")
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Len(t, issues, 2)
+	assert.Equal(t, issue, issues[2])
+
+	// WHEN deleting an issue
+	err = store.DeleteIssue("testOwner", "testRepo", 3)
+	assert.NoError(t, err)
+	issues, err = store.GetIssues("testOwner", "testRepo")
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Len(t, issues, 1)
+
+	// WHEN replacing all issues
+	err = store.PutIssues("testOwner", "testRepo", map[int]model.EnrichedIssue{})
+	assert.NoError(t, err)
+	issues, err = store.GetIssues("testOwner", "testRepo")
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Empty(t, issues)
+}
+
+func TestBoard(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	store := newTestStore(t)
+	board := storage.Board{
+		Contributors: []*famedModel.Contributor{{
+			Login:           "testUser",
+			FixCount:        1,
+			Rewards:         []famedModel.RewardEvent{{Date: testTime, Reward: 100, URL: "TestURL"}},
+			RewardSum:       100,
+			Currency:        "POINTS",
+			RewardsLastYear: famedModel.NewRewardsLastYear(testTime),
+			Severities:      map[model.IssueSeverity]int{model.Low: 1},
+		}},
+		UpdatedAt: testTime,
+	}
+
+	// WHEN
+	_, found, err := store.GetBoard("testOwner", "testRepo", storage.BlueTeam)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	// WHEN
+	err = store.PutBoard("testOwner", "testRepo", storage.BlueTeam, board)
+	assert.NoError(t, err)
+	storedBoard, found, err := store.GetBoard("testOwner", "testRepo", storage.BlueTeam)
+	_, redFound, redErr := store.GetBoard("testOwner", "testRepo", storage.RedTeam)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, board, storedBoard)
+	assert.NoError(t, redErr)
+	assert.False(t, redFound)
+}
+
+func TestLedger(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	store := newTestStore(t)
+	entry := famedModel.NewLedgerEntry("testOwner", "testRepo", 1, "TestURL", "testUser", 100, "POINTS", testTime)
+	otherIssueEntry := famedModel.NewLedgerEntry("testOwner", "testRepo", 10, "TestURL", "testUser", 200, "POINTS", testTime)
+
+	// WHEN
+	_, found, err := store.GetLedgerEntry("testOwner", "testRepo", 1, "testUser")
+
+	// THEN
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	// WHEN
+	assert.NoError(t, store.PutLedgerEntry(entry))
+	assert.NoError(t, store.PutLedgerEntry(otherIssueEntry))
+	storedEntry, found, err := store.GetLedgerEntry("TestOwner", "TestRepo", 1, "TestUser")
+
+	// THEN
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, entry, storedEntry)
+
+	// WHEN
+	issueEntries, err := store.GetIssueLedgerEntries("testOwner", "testRepo", 1)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]famedModel.LedgerEntry{"testUser": entry}, issueEntries)
+
+	// WHEN
+	entries, err := store.GetLedgerEntries()
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	// WHEN
+	assert.NoError(t, store.DeleteLedgerEntry("testOwner", "testRepo", 1, "testUser"))
+	_, found, err = store.GetLedgerEntry("testOwner", "testRepo", 1, "testUser")
+
+	// THEN
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestOverrides(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	store := newTestStore(t)
+	severity := model.High
+	overrides := famedModel.RewardOverrides{
+		Split:    map[string]float64{"alice": 60, "bob": 40},
+		Exclude:  []string{"bot"},
+		Severity: &severity,
+	}
+
+	// WHEN
+	err := store.PutOverrides("testOwner", "testRepo", 1, overrides)
+	assert.NoError(t, err)
+	storedOverrides, err := store.GetOverrides("TestOwner", "TestRepo")
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]famedModel.RewardOverrides{1: overrides}, storedOverrides)
+
+	// WHEN
+	err = store.DeleteOverrides("testOwner", "testRepo", 1)
+	assert.NoError(t, err)
+	storedOverrides, err = store.GetOverrides("testOwner", "testRepo")
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Empty(t, storedOverrides)
+}
+
+func TestRepo(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	store := newTestStore(t)
+	assert.NoError(t, store.PutIssue("testOwner", "testRepo", model.EnrichedIssue{Issue: model.Issue{Number: 1, CreatedAt: testTime}}))
+	assert.NoError(t, store.PutBoard("testOwner", "testRepo", storage.BlueTeam, storage.Board{UpdatedAt: testTime}))
+	assert.NoError(t, store.PutOverrides("testOwner", "testRepo", 1, famedModel.RewardOverrides{Exclude: []string{"bot"}}))
+
+	// WHEN
+	err := store.DeleteRepo("TestOwner", "TestRepo")
+	assert.NoError(t, err)
+
+	// THEN
+	issues, err := store.GetIssues("testOwner", "testRepo")
+	assert.NoError(t, err)
+	assert.Empty(t, issues)
+	_, found, err := store.GetBoard("testOwner", "testRepo", storage.BlueTeam)
+	assert.NoError(t, err)
+	assert.False(t, found)
+	overrides, err := store.GetOverrides("testOwner", "testRepo")
+	assert.NoError(t, err)
+	assert.Len(t, overrides, 1)
+
+	// WHEN deleting a repository that is not stored
+	err = store.DeleteRepo("testOwner", "otherRepo")
+
+	// THEN
+	assert.NoError(t, err)
+
+	// WHEN
+	assert.NoError(t, store.SetRepoHidden("testOwner", "testRepo", true))
+	hidden, err := store.IsRepoHidden("TestOwner", "TestRepo")
+
+	// THEN
+	assert.NoError(t, err)
+	assert.True(t, hidden)
+
+	// WHEN
+	assert.NoError(t, store.SetRepoHidden("testOwner", "testRepo", false))
+	hidden, err = store.IsRepoHidden("testOwner", "testRepo")
+
+	// THEN
+	assert.NoError(t, err)
+	assert.False(t, hidden)
+}
+
+func TestJobs(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	store := newTestStore(t)
+	first, err := store.PutJob(storage.Job{Key: "1", EventType: "issues", Payload: []byte(`{"action":"closed"}`), CreatedAt: testTime})
+	assert.NoError(t, err)
+	second, err := store.PutJob(storage.Job{Key: "2", EventType: "issue_comment", Payload: []byte(`{}`), CreatedAt: testTime})
+	assert.NoError(t, err)
+
+	// WHEN
+	jobs, err := store.GetJobs()
+
+	// THEN
+	assert.NoError(t, err)
+	assert.Equal(t, []storage.Job{first, second}, jobs)
+	assert.Less(t, first.ID, second.ID)
+
+	// WHEN
+	first.Attempts = 1
+	first.LastError = "test error"
+	_, err = store.PutJob(first)
+	assert.NoError(t, err)
+	assert.NoError(t, store.DeadLetterJob(first, 1))
+	assert.NoError(t, store.DeleteJob(second.ID))
+
+	// THEN
+	jobs, err = store.GetJobs()
+	assert.NoError(t, err)
+	assert.Empty(t, jobs)
+	deadJobs, err := store.GetDeadJobs()
+	assert.NoError(t, err)
+	assert.Equal(t, []storage.Job{first}, deadJobs)
+
+	// WHEN
+	third, err := store.PutJob(storage.Job{Key: "3", EventType: "issues", Payload: []byte(`{}`), CreatedAt: testTime})
+	assert.NoError(t, err)
+	assert.NoError(t, store.DeadLetterJob(third, 1))
+
+	// THEN
+	deadJobs, err = store.GetDeadJobs()
+	assert.NoError(t, err)
+	assert.Equal(t, []storage.Job{third}, deadJobs)
+}
+
+func TestDeliveries(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	store := newTestStore(t)
+	for _, id := range []string{"1", "2", "3"} {
+		added, err := store.AddDelivery(storage.Delivery{ID: id, EventType: "issues", Status: storage.DeliveryReceived, ReceivedAt: testTime}, []byte(`{"id":`+id+`}`), 2)
+		assert.NoError(t, err)
+		assert.True(t, added)
+	}
+
+	// WHEN adding a delivery that is recorded
+	added, err := store.AddDelivery(storage.Delivery{ID: "3", EventType: "issues", Status: storage.DeliveryReceived, ReceivedAt: testTime}, []byte(`{}`), 2)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.False(t, added)
+
+	// WHEN
+	assert.NoError(t, store.SetDeliveryStatus("3", storage.DeliveryFailed, "test error", testTime.Add(time.Minute)))
+	assert.NoError(t, store.SetDeliveryStatus("1", storage.DeliveryProcessed, "", testTime))
+	deliveries, err := store.GetDeliveries()
+
+	// THEN the oldest delivery is evicted
+	assert.NoError(t, err)
+	assert.Equal(t, []storage.Delivery{
+		{ID: "3", EventType: "issues", Status: storage.DeliveryFailed, LastError: "test error", ReceivedAt: testTime, UpdatedAt: testTime.Add(time.Minute)},
+		{ID: "2", EventType: "issues", Status: storage.DeliveryReceived, ReceivedAt: testTime},
+	}, deliveries)
+	_, found, err := store.GetDelivery("1")
+	assert.NoError(t, err)
+	assert.False(t, found)
+	_, found, err = store.GetDeliveryPayload("1")
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	// WHEN
+	delivery, found, err := store.GetDelivery("3")
+	assert.NoError(t, err)
+	payload, payloadFound, payloadErr := store.GetDeliveryPayload("3")
+
+	// THEN
+	assert.True(t, found)
+	assert.Equal(t, storage.DeliveryFailed, delivery.Status)
+	assert.NoError(t, payloadErr)
+	assert.True(t, payloadFound)
+	assert.Equal(t, []byte(`{"id":3}`), payload)
+
+	// WHEN adding a failed delivery
+	added, err = store.AddDelivery(storage.Delivery{ID: "3", EventType: "issues", Status: storage.DeliveryReceived, ReceivedAt: testTime.Add(time.Hour)}, []byte(`{"id":4}`), 2)
+
+	// THEN it is replaced
+	assert.NoError(t, err)
+	assert.True(t, added)
+	delivery, found, err = store.GetDelivery("3")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, storage.Delivery{ID: "3", EventType: "issues", Status: storage.DeliveryReceived, ReceivedAt: testTime.Add(time.Hour)}, delivery)
+	payload, _, err = store.GetDeliveryPayload("3")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`{"id":4}`), payload)
+
+	// WHEN adding a dead-lettered delivery
+	assert.NoError(t, store.SetDeliveryStatus("2", storage.DeliveryDeadLettered, "test error", testTime))
+	added, err = store.AddDelivery(storage.Delivery{ID: "2", EventType: "issues", Status: storage.DeliveryReceived, ReceivedAt: testTime}, []byte(`{}`), 2)
+
+	// THEN it is replaced without evicting another delivery
+	assert.NoError(t, err)
+	assert.True(t, added)
+	deliveries, err = store.GetDeliveries()
+	assert.NoError(t, err)
+	assert.Len(t, deliveries, 2)
+
+	// WHEN adding an evicted delivery
+	added, err = store.AddDelivery(storage.Delivery{ID: "1", EventType: "issues", Status: storage.DeliveryReceived, ReceivedAt: testTime}, []byte(`{}`), 2)
+
+	// THEN
+	assert.NoError(t, err)
+	assert.True(t, added)
+}
+
+func TestHighWaterMark(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	store := newTestStore(t)
+	_, found, err := store.GetHighWaterMark("testOwner", "testRepo")
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	// WHEN
+	assert.NoError(t, store.PutHighWaterMark("testOwner", "testRepo", testTime))
+	mark, found, err := store.GetHighWaterMark("TestOwner", "TestRepo")
+
+	// THEN
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.True(t, testTime.Equal(mark))
+
+	// WHEN the repository is deleted
+	assert.NoError(t, store.DeleteRepo("testOwner", "testRepo"))
+	_, found, err = store.GetHighWaterMark("testOwner", "testRepo")
+
+	// THEN
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestCommentsVersion(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	store := newTestStore(t)
+	_, found, err := store.GetCommentsVersion("testOwner", "testRepo")
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	// WHEN
+	assert.NoError(t, store.PutCommentsVersion("testOwner", "testRepo", "1.2.0"))
+	version, found, err := store.GetCommentsVersion("TestOwner", "TestRepo")
+
+	// THEN
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "1.2.0", version)
+
+	// WHEN the repository is deleted
+	assert.NoError(t, store.DeleteRepo("testOwner", "testRepo"))
+	_, found, err = store.GetCommentsVersion("testOwner", "testRepo")
+
+	// THEN
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestCleanStateRuns(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	store := newTestStore(t)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, store.AddCleanStateRun(storage.CleanStateRun{StartedAt: testTime.Add(time.Duration(i) * time.Hour), IssuesScanned: i, Errors: []string{}}, 2))
+	}
+
+	// WHEN
+	runs, err := store.GetCleanStateRuns()
+
+	// THEN the oldest run is evicted
+	assert.NoError(t, err)
+	assert.Equal(t, []storage.CleanStateRun{
+		{StartedAt: testTime.Add(2 * time.Hour), IssuesScanned: 2, Errors: []string{}},
+		{StartedAt: testTime.Add(time.Hour), IssuesScanned: 1, Errors: []string{}},
+	}, runs)
+}
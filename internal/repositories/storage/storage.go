@@ -0,0 +1,127 @@
+package storage
+
+import (
+	"encoding/json"
+	"time"
+
+	famedModel "github.com/morphysm/famed-github-backend/internal/famed/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+)
+
+// Team identifies a board kept in the store.
+type Team string
+
+const (
+	BlueTeam Team = "blue"
+	RedTeam  Team = "red"
+)
+
+// Board represents a computed board of contributors and the time it was computed at.
+type Board struct {
+	Contributors []*famedModel.Contributor `json:"contributors"`
+	UpdatedAt    time.Time                 `json:"updatedAt"`
+}
+
+// Job represents a webhook event waiting to be processed.
+// Jobs sharing a key are processed in the order they were enqueued.
+type Job struct {
+	ID            uint64          `json:"id"`
+	Key           string          `json:"key"`
+	EventType     string          `json:"eventType"`
+	Payload       json.RawMessage `json:"payload"`
+	Attempts      int             `json:"attempts"`
+	NextAttemptAt time.Time       `json:"nextAttemptAt"`
+	LastError     string          `json:"lastError,omitempty"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	// DeliveryID is the X-GitHub-Delivery header of the webhook request the job was enqueued for.
+	DeliveryID string `json:"deliveryId,omitempty"`
+}
+
+// DeliveryStatus represents the processing state of a webhook delivery.
+type DeliveryStatus string
+
+const (
+	DeliveryReceived     DeliveryStatus = "received"
+	DeliveryQueued       DeliveryStatus = "queued"
+	DeliveryRetrying     DeliveryStatus = "retrying"
+	DeliveryProcessed    DeliveryStatus = "processed"
+	DeliveryFailed       DeliveryStatus = "failed"
+	DeliveryDeadLettered DeliveryStatus = "deadLettered"
+)
+
+// Delivery represents a received webhook delivery identified by its X-GitHub-Delivery header.
+type Delivery struct {
+	ID         string         `json:"id"`
+	EventType  string         `json:"eventType"`
+	Status     DeliveryStatus `json:"status"`
+	LastError  string         `json:"lastError,omitempty"`
+	ReceivedAt time.Time      `json:"receivedAt"`
+	UpdatedAt  time.Time      `json:"updatedAt"`
+}
+
+// CleanStateRun reports a run of the state worker updating the comments and boards of the repositories.
+type CleanStateRun struct {
+	StartedAt       time.Time `json:"startedAt"`
+	DurationSeconds float64   `json:"durationSeconds"`
+	ReposScanned    int       `json:"reposScanned"`
+	IssuesScanned   int       `json:"issuesScanned"`
+	CommentsChanged int       `json:"commentsChanged"`
+	Errors          []string  `json:"errors"`
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+//counterfeiter:generate . Store
+
+// Store persists the issues tracked by Famed and the boards computed from them,
+// so that boards do not have to be rebuilt from GitHub on every request.
+// It further keeps the ledger of reward payouts, which cannot be recomputed from GitHub,
+// and the reward overrides set by maintainers through commands.
+// Boards of repositories removed from an installation are hidden.
+// Webhook events are queued as jobs until they have been processed or given up on,
+// and the most recent webhook deliveries are recorded with their payload to skip redeliveries and replay them.
+// The state worker keeps the time of its last run per repository as high-water mark together with reports of its most recent runs,
+// and the version the bot comments of a repository were migrated to.
+type Store interface {
+	GetIssues(owner string, repoName string) (map[int]model.EnrichedIssue, error)
+	PutIssues(owner string, repoName string, issues map[int]model.EnrichedIssue) error
+	PutIssue(owner string, repoName string, issue model.EnrichedIssue) error
+	DeleteIssue(owner string, repoName string, issueNumber int) error
+
+	GetBoard(owner string, repoName string, team Team) (Board, bool, error)
+	PutBoard(owner string, repoName string, team Team, board Board) error
+
+	GetLedgerEntries() ([]famedModel.LedgerEntry, error)
+	GetIssueLedgerEntries(owner string, repoName string, issueNumber int) (map[string]famedModel.LedgerEntry, error)
+	GetLedgerEntry(owner string, repoName string, issueNumber int, login string) (famedModel.LedgerEntry, bool, error)
+	PutLedgerEntry(entry famedModel.LedgerEntry) error
+	DeleteLedgerEntry(owner string, repoName string, issueNumber int, login string) error
+
+	GetOverrides(owner string, repoName string) (map[int]famedModel.RewardOverrides, error)
+	PutOverrides(owner string, repoName string, issueNumber int, overrides famedModel.RewardOverrides) error
+	DeleteOverrides(owner string, repoName string, issueNumber int) error
+
+	DeleteRepo(owner string, repoName string) error
+	IsRepoHidden(owner string, repoName string) (bool, error)
+	SetRepoHidden(owner string, repoName string, hidden bool) error
+
+	GetJobs() ([]Job, error)
+	PutJob(job Job) (Job, error)
+	DeleteJob(id uint64) error
+	DeadLetterJob(job Job, limit int) error
+	GetDeadJobs() ([]Job, error)
+
+	AddDelivery(delivery Delivery, payload []byte, limit int) (bool, error)
+	SetDeliveryStatus(id string, status DeliveryStatus, lastError string, updatedAt time.Time) error
+	GetDeliveries() ([]Delivery, error)
+	GetDelivery(id string) (Delivery, bool, error)
+	GetDeliveryPayload(id string) ([]byte, bool, error)
+
+	GetHighWaterMark(owner string, repoName string) (time.Time, bool, error)
+	PutHighWaterMark(owner string, repoName string, mark time.Time) error
+	GetCommentsVersion(owner string, repoName string) (string, bool, error)
+	PutCommentsVersion(owner string, repoName string, version string) error
+	AddCleanStateRun(run CleanStateRun, limit int) error
+	GetCleanStateRuns() ([]CleanStateRun, error)
+
+	Close() error
+}
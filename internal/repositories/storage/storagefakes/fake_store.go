@@ -0,0 +1,2708 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package storagefakes
+
+import (
+	"sync"
+	"time"
+
+	"github.com/morphysm/famed-github-backend/internal/famed/model"
+	modela "github.com/morphysm/famed-github-backend/internal/repositories/github/model"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage"
+)
+
+type FakeStore struct {
+	AddCleanStateRunStub        func(storage.CleanStateRun, int) error
+	addCleanStateRunMutex       sync.RWMutex
+	addCleanStateRunArgsForCall []struct {
+		arg1 storage.CleanStateRun
+		arg2 int
+	}
+	addCleanStateRunReturns struct {
+		result1 error
+	}
+	addCleanStateRunReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddDeliveryStub        func(storage.Delivery, []byte, int) (bool, error)
+	addDeliveryMutex       sync.RWMutex
+	addDeliveryArgsForCall []struct {
+		arg1 storage.Delivery
+		arg2 []byte
+		arg3 int
+	}
+	addDeliveryReturns struct {
+		result1 bool
+		result2 error
+	}
+	addDeliveryReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	CloseStub        func() error
+	closeMutex       sync.RWMutex
+	closeArgsForCall []struct {
+	}
+	closeReturns struct {
+		result1 error
+	}
+	closeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeadLetterJobStub        func(storage.Job, int) error
+	deadLetterJobMutex       sync.RWMutex
+	deadLetterJobArgsForCall []struct {
+		arg1 storage.Job
+		arg2 int
+	}
+	deadLetterJobReturns struct {
+		result1 error
+	}
+	deadLetterJobReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteIssueStub        func(string, string, int) error
+	deleteIssueMutex       sync.RWMutex
+	deleteIssueArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}
+	deleteIssueReturns struct {
+		result1 error
+	}
+	deleteIssueReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteJobStub        func(uint64) error
+	deleteJobMutex       sync.RWMutex
+	deleteJobArgsForCall []struct {
+		arg1 uint64
+	}
+	deleteJobReturns struct {
+		result1 error
+	}
+	deleteJobReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteLedgerEntryStub        func(string, string, int, string) error
+	deleteLedgerEntryMutex       sync.RWMutex
+	deleteLedgerEntryArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+		arg4 string
+	}
+	deleteLedgerEntryReturns struct {
+		result1 error
+	}
+	deleteLedgerEntryReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteOverridesStub        func(string, string, int) error
+	deleteOverridesMutex       sync.RWMutex
+	deleteOverridesArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}
+	deleteOverridesReturns struct {
+		result1 error
+	}
+	deleteOverridesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteRepoStub        func(string, string) error
+	deleteRepoMutex       sync.RWMutex
+	deleteRepoArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	deleteRepoReturns struct {
+		result1 error
+	}
+	deleteRepoReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetBoardStub        func(string, string, storage.Team) (storage.Board, bool, error)
+	getBoardMutex       sync.RWMutex
+	getBoardArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 storage.Team
+	}
+	getBoardReturns struct {
+		result1 storage.Board
+		result2 bool
+		result3 error
+	}
+	getBoardReturnsOnCall map[int]struct {
+		result1 storage.Board
+		result2 bool
+		result3 error
+	}
+	GetCleanStateRunsStub        func() ([]storage.CleanStateRun, error)
+	getCleanStateRunsMutex       sync.RWMutex
+	getCleanStateRunsArgsForCall []struct {
+	}
+	getCleanStateRunsReturns struct {
+		result1 []storage.CleanStateRun
+		result2 error
+	}
+	getCleanStateRunsReturnsOnCall map[int]struct {
+		result1 []storage.CleanStateRun
+		result2 error
+	}
+	GetCommentsVersionStub        func(string, string) (string, bool, error)
+	getCommentsVersionMutex       sync.RWMutex
+	getCommentsVersionArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getCommentsVersionReturns struct {
+		result1 string
+		result2 bool
+		result3 error
+	}
+	getCommentsVersionReturnsOnCall map[int]struct {
+		result1 string
+		result2 bool
+		result3 error
+	}
+	GetDeadJobsStub        func() ([]storage.Job, error)
+	getDeadJobsMutex       sync.RWMutex
+	getDeadJobsArgsForCall []struct {
+	}
+	getDeadJobsReturns struct {
+		result1 []storage.Job
+		result2 error
+	}
+	getDeadJobsReturnsOnCall map[int]struct {
+		result1 []storage.Job
+		result2 error
+	}
+	GetDeliveriesStub        func() ([]storage.Delivery, error)
+	getDeliveriesMutex       sync.RWMutex
+	getDeliveriesArgsForCall []struct {
+	}
+	getDeliveriesReturns struct {
+		result1 []storage.Delivery
+		result2 error
+	}
+	getDeliveriesReturnsOnCall map[int]struct {
+		result1 []storage.Delivery
+		result2 error
+	}
+	GetDeliveryStub        func(string) (storage.Delivery, bool, error)
+	getDeliveryMutex       sync.RWMutex
+	getDeliveryArgsForCall []struct {
+		arg1 string
+	}
+	getDeliveryReturns struct {
+		result1 storage.Delivery
+		result2 bool
+		result3 error
+	}
+	getDeliveryReturnsOnCall map[int]struct {
+		result1 storage.Delivery
+		result2 bool
+		result3 error
+	}
+	GetDeliveryPayloadStub        func(string) ([]byte, bool, error)
+	getDeliveryPayloadMutex       sync.RWMutex
+	getDeliveryPayloadArgsForCall []struct {
+		arg1 string
+	}
+	getDeliveryPayloadReturns struct {
+		result1 []byte
+		result2 bool
+		result3 error
+	}
+	getDeliveryPayloadReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 bool
+		result3 error
+	}
+	GetHighWaterMarkStub        func(string, string) (time.Time, bool, error)
+	getHighWaterMarkMutex       sync.RWMutex
+	getHighWaterMarkArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getHighWaterMarkReturns struct {
+		result1 time.Time
+		result2 bool
+		result3 error
+	}
+	getHighWaterMarkReturnsOnCall map[int]struct {
+		result1 time.Time
+		result2 bool
+		result3 error
+	}
+	GetIssueLedgerEntriesStub        func(string, string, int) (map[string]model.LedgerEntry, error)
+	getIssueLedgerEntriesMutex       sync.RWMutex
+	getIssueLedgerEntriesArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}
+	getIssueLedgerEntriesReturns struct {
+		result1 map[string]model.LedgerEntry
+		result2 error
+	}
+	getIssueLedgerEntriesReturnsOnCall map[int]struct {
+		result1 map[string]model.LedgerEntry
+		result2 error
+	}
+	GetIssuesStub        func(string, string) (map[int]modela.EnrichedIssue, error)
+	getIssuesMutex       sync.RWMutex
+	getIssuesArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getIssuesReturns struct {
+		result1 map[int]modela.EnrichedIssue
+		result2 error
+	}
+	getIssuesReturnsOnCall map[int]struct {
+		result1 map[int]modela.EnrichedIssue
+		result2 error
+	}
+	GetJobsStub        func() ([]storage.Job, error)
+	getJobsMutex       sync.RWMutex
+	getJobsArgsForCall []struct {
+	}
+	getJobsReturns struct {
+		result1 []storage.Job
+		result2 error
+	}
+	getJobsReturnsOnCall map[int]struct {
+		result1 []storage.Job
+		result2 error
+	}
+	GetLedgerEntriesStub        func() ([]model.LedgerEntry, error)
+	getLedgerEntriesMutex       sync.RWMutex
+	getLedgerEntriesArgsForCall []struct {
+	}
+	getLedgerEntriesReturns struct {
+		result1 []model.LedgerEntry
+		result2 error
+	}
+	getLedgerEntriesReturnsOnCall map[int]struct {
+		result1 []model.LedgerEntry
+		result2 error
+	}
+	GetLedgerEntryStub        func(string, string, int, string) (model.LedgerEntry, bool, error)
+	getLedgerEntryMutex       sync.RWMutex
+	getLedgerEntryArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+		arg4 string
+	}
+	getLedgerEntryReturns struct {
+		result1 model.LedgerEntry
+		result2 bool
+		result3 error
+	}
+	getLedgerEntryReturnsOnCall map[int]struct {
+		result1 model.LedgerEntry
+		result2 bool
+		result3 error
+	}
+	GetOverridesStub        func(string, string) (map[int]model.RewardOverrides, error)
+	getOverridesMutex       sync.RWMutex
+	getOverridesArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getOverridesReturns struct {
+		result1 map[int]model.RewardOverrides
+		result2 error
+	}
+	getOverridesReturnsOnCall map[int]struct {
+		result1 map[int]model.RewardOverrides
+		result2 error
+	}
+	IsRepoHiddenStub        func(string, string) (bool, error)
+	isRepoHiddenMutex       sync.RWMutex
+	isRepoHiddenArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	isRepoHiddenReturns struct {
+		result1 bool
+		result2 error
+	}
+	isRepoHiddenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	PutBoardStub        func(string, string, storage.Team, storage.Board) error
+	putBoardMutex       sync.RWMutex
+	putBoardArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 storage.Team
+		arg4 storage.Board
+	}
+	putBoardReturns struct {
+		result1 error
+	}
+	putBoardReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PutCommentsVersionStub        func(string, string, string) error
+	putCommentsVersionMutex       sync.RWMutex
+	putCommentsVersionArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	putCommentsVersionReturns struct {
+		result1 error
+	}
+	putCommentsVersionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PutHighWaterMarkStub        func(string, string, time.Time) error
+	putHighWaterMarkMutex       sync.RWMutex
+	putHighWaterMarkArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 time.Time
+	}
+	putHighWaterMarkReturns struct {
+		result1 error
+	}
+	putHighWaterMarkReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PutIssueStub        func(string, string, modela.EnrichedIssue) error
+	putIssueMutex       sync.RWMutex
+	putIssueArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 modela.EnrichedIssue
+	}
+	putIssueReturns struct {
+		result1 error
+	}
+	putIssueReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PutIssuesStub        func(string, string, map[int]modela.EnrichedIssue) error
+	putIssuesMutex       sync.RWMutex
+	putIssuesArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[int]modela.EnrichedIssue
+	}
+	putIssuesReturns struct {
+		result1 error
+	}
+	putIssuesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PutJobStub        func(storage.Job) (storage.Job, error)
+	putJobMutex       sync.RWMutex
+	putJobArgsForCall []struct {
+		arg1 storage.Job
+	}
+	putJobReturns struct {
+		result1 storage.Job
+		result2 error
+	}
+	putJobReturnsOnCall map[int]struct {
+		result1 storage.Job
+		result2 error
+	}
+	PutLedgerEntryStub        func(model.LedgerEntry) error
+	putLedgerEntryMutex       sync.RWMutex
+	putLedgerEntryArgsForCall []struct {
+		arg1 model.LedgerEntry
+	}
+	putLedgerEntryReturns struct {
+		result1 error
+	}
+	putLedgerEntryReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PutOverridesStub        func(string, string, int, model.RewardOverrides) error
+	putOverridesMutex       sync.RWMutex
+	putOverridesArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+		arg4 model.RewardOverrides
+	}
+	putOverridesReturns struct {
+		result1 error
+	}
+	putOverridesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetDeliveryStatusStub        func(string, storage.DeliveryStatus, string, time.Time) error
+	setDeliveryStatusMutex       sync.RWMutex
+	setDeliveryStatusArgsForCall []struct {
+		arg1 string
+		arg2 storage.DeliveryStatus
+		arg3 string
+		arg4 time.Time
+	}
+	setDeliveryStatusReturns struct {
+		result1 error
+	}
+	setDeliveryStatusReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetRepoHiddenStub        func(string, string, bool) error
+	setRepoHiddenMutex       sync.RWMutex
+	setRepoHiddenArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}
+	setRepoHiddenReturns struct {
+		result1 error
+	}
+	setRepoHiddenReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeStore) AddCleanStateRun(arg1 storage.CleanStateRun, arg2 int) error {
+	fake.addCleanStateRunMutex.Lock()
+	ret, specificReturn := fake.addCleanStateRunReturnsOnCall[len(fake.addCleanStateRunArgsForCall)]
+	fake.addCleanStateRunArgsForCall = append(fake.addCleanStateRunArgsForCall, struct {
+		arg1 storage.CleanStateRun
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.AddCleanStateRunStub
+	fakeReturns := fake.addCleanStateRunReturns
+	fake.recordInvocation("AddCleanStateRun", []interface{}{arg1, arg2})
+	fake.addCleanStateRunMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) AddCleanStateRunCallCount() int {
+	fake.addCleanStateRunMutex.RLock()
+	defer fake.addCleanStateRunMutex.RUnlock()
+	return len(fake.addCleanStateRunArgsForCall)
+}
+
+func (fake *FakeStore) AddCleanStateRunCalls(stub func(storage.CleanStateRun, int) error) {
+	fake.addCleanStateRunMutex.Lock()
+	defer fake.addCleanStateRunMutex.Unlock()
+	fake.AddCleanStateRunStub = stub
+}
+
+func (fake *FakeStore) AddCleanStateRunArgsForCall(i int) (storage.CleanStateRun, int) {
+	fake.addCleanStateRunMutex.RLock()
+	defer fake.addCleanStateRunMutex.RUnlock()
+	argsForCall := fake.addCleanStateRunArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) AddCleanStateRunReturns(result1 error) {
+	fake.addCleanStateRunMutex.Lock()
+	defer fake.addCleanStateRunMutex.Unlock()
+	fake.AddCleanStateRunStub = nil
+	fake.addCleanStateRunReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) AddCleanStateRunReturnsOnCall(i int, result1 error) {
+	fake.addCleanStateRunMutex.Lock()
+	defer fake.addCleanStateRunMutex.Unlock()
+	fake.AddCleanStateRunStub = nil
+	if fake.addCleanStateRunReturnsOnCall == nil {
+		fake.addCleanStateRunReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addCleanStateRunReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) AddDelivery(arg1 storage.Delivery, arg2 []byte, arg3 int) (bool, error) {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.addDeliveryMutex.Lock()
+	ret, specificReturn := fake.addDeliveryReturnsOnCall[len(fake.addDeliveryArgsForCall)]
+	fake.addDeliveryArgsForCall = append(fake.addDeliveryArgsForCall, struct {
+		arg1 storage.Delivery
+		arg2 []byte
+		arg3 int
+	}{arg1, arg2Copy, arg3})
+	stub := fake.AddDeliveryStub
+	fakeReturns := fake.addDeliveryReturns
+	fake.recordInvocation("AddDelivery", []interface{}{arg1, arg2Copy, arg3})
+	fake.addDeliveryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) AddDeliveryCallCount() int {
+	fake.addDeliveryMutex.RLock()
+	defer fake.addDeliveryMutex.RUnlock()
+	return len(fake.addDeliveryArgsForCall)
+}
+
+func (fake *FakeStore) AddDeliveryCalls(stub func(storage.Delivery, []byte, int) (bool, error)) {
+	fake.addDeliveryMutex.Lock()
+	defer fake.addDeliveryMutex.Unlock()
+	fake.AddDeliveryStub = stub
+}
+
+func (fake *FakeStore) AddDeliveryArgsForCall(i int) (storage.Delivery, []byte, int) {
+	fake.addDeliveryMutex.RLock()
+	defer fake.addDeliveryMutex.RUnlock()
+	argsForCall := fake.addDeliveryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) AddDeliveryReturns(result1 bool, result2 error) {
+	fake.addDeliveryMutex.Lock()
+	defer fake.addDeliveryMutex.Unlock()
+	fake.AddDeliveryStub = nil
+	fake.addDeliveryReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) AddDeliveryReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.addDeliveryMutex.Lock()
+	defer fake.addDeliveryMutex.Unlock()
+	fake.AddDeliveryStub = nil
+	if fake.addDeliveryReturnsOnCall == nil {
+		fake.addDeliveryReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.addDeliveryReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) Close() error {
+	fake.closeMutex.Lock()
+	ret, specificReturn := fake.closeReturnsOnCall[len(fake.closeArgsForCall)]
+	fake.closeArgsForCall = append(fake.closeArgsForCall, struct {
+	}{})
+	stub := fake.CloseStub
+	fakeReturns := fake.closeReturns
+	fake.recordInvocation("Close", []interface{}{})
+	fake.closeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) CloseCallCount() int {
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	return len(fake.closeArgsForCall)
+}
+
+func (fake *FakeStore) CloseCalls(stub func() error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = stub
+}
+
+func (fake *FakeStore) CloseReturns(result1 error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = nil
+	fake.closeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CloseReturnsOnCall(i int, result1 error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = nil
+	if fake.closeReturnsOnCall == nil {
+		fake.closeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.closeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeadLetterJob(arg1 storage.Job, arg2 int) error {
+	fake.deadLetterJobMutex.Lock()
+	ret, specificReturn := fake.deadLetterJobReturnsOnCall[len(fake.deadLetterJobArgsForCall)]
+	fake.deadLetterJobArgsForCall = append(fake.deadLetterJobArgsForCall, struct {
+		arg1 storage.Job
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.DeadLetterJobStub
+	fakeReturns := fake.deadLetterJobReturns
+	fake.recordInvocation("DeadLetterJob", []interface{}{arg1, arg2})
+	fake.deadLetterJobMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) DeadLetterJobCallCount() int {
+	fake.deadLetterJobMutex.RLock()
+	defer fake.deadLetterJobMutex.RUnlock()
+	return len(fake.deadLetterJobArgsForCall)
+}
+
+func (fake *FakeStore) DeadLetterJobCalls(stub func(storage.Job, int) error) {
+	fake.deadLetterJobMutex.Lock()
+	defer fake.deadLetterJobMutex.Unlock()
+	fake.DeadLetterJobStub = stub
+}
+
+func (fake *FakeStore) DeadLetterJobArgsForCall(i int) (storage.Job, int) {
+	fake.deadLetterJobMutex.RLock()
+	defer fake.deadLetterJobMutex.RUnlock()
+	argsForCall := fake.deadLetterJobArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) DeadLetterJobReturns(result1 error) {
+	fake.deadLetterJobMutex.Lock()
+	defer fake.deadLetterJobMutex.Unlock()
+	fake.DeadLetterJobStub = nil
+	fake.deadLetterJobReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeadLetterJobReturnsOnCall(i int, result1 error) {
+	fake.deadLetterJobMutex.Lock()
+	defer fake.deadLetterJobMutex.Unlock()
+	fake.DeadLetterJobStub = nil
+	if fake.deadLetterJobReturnsOnCall == nil {
+		fake.deadLetterJobReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deadLetterJobReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteIssue(arg1 string, arg2 string, arg3 int) error {
+	fake.deleteIssueMutex.Lock()
+	ret, specificReturn := fake.deleteIssueReturnsOnCall[len(fake.deleteIssueArgsForCall)]
+	fake.deleteIssueArgsForCall = append(fake.deleteIssueArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteIssueStub
+	fakeReturns := fake.deleteIssueReturns
+	fake.recordInvocation("DeleteIssue", []interface{}{arg1, arg2, arg3})
+	fake.deleteIssueMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) DeleteIssueCallCount() int {
+	fake.deleteIssueMutex.RLock()
+	defer fake.deleteIssueMutex.RUnlock()
+	return len(fake.deleteIssueArgsForCall)
+}
+
+func (fake *FakeStore) DeleteIssueCalls(stub func(string, string, int) error) {
+	fake.deleteIssueMutex.Lock()
+	defer fake.deleteIssueMutex.Unlock()
+	fake.DeleteIssueStub = stub
+}
+
+func (fake *FakeStore) DeleteIssueArgsForCall(i int) (string, string, int) {
+	fake.deleteIssueMutex.RLock()
+	defer fake.deleteIssueMutex.RUnlock()
+	argsForCall := fake.deleteIssueArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) DeleteIssueReturns(result1 error) {
+	fake.deleteIssueMutex.Lock()
+	defer fake.deleteIssueMutex.Unlock()
+	fake.DeleteIssueStub = nil
+	fake.deleteIssueReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteIssueReturnsOnCall(i int, result1 error) {
+	fake.deleteIssueMutex.Lock()
+	defer fake.deleteIssueMutex.Unlock()
+	fake.DeleteIssueStub = nil
+	if fake.deleteIssueReturnsOnCall == nil {
+		fake.deleteIssueReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteIssueReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteJob(arg1 uint64) error {
+	fake.deleteJobMutex.Lock()
+	ret, specificReturn := fake.deleteJobReturnsOnCall[len(fake.deleteJobArgsForCall)]
+	fake.deleteJobArgsForCall = append(fake.deleteJobArgsForCall, struct {
+		arg1 uint64
+	}{arg1})
+	stub := fake.DeleteJobStub
+	fakeReturns := fake.deleteJobReturns
+	fake.recordInvocation("DeleteJob", []interface{}{arg1})
+	fake.deleteJobMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) DeleteJobCallCount() int {
+	fake.deleteJobMutex.RLock()
+	defer fake.deleteJobMutex.RUnlock()
+	return len(fake.deleteJobArgsForCall)
+}
+
+func (fake *FakeStore) DeleteJobCalls(stub func(uint64) error) {
+	fake.deleteJobMutex.Lock()
+	defer fake.deleteJobMutex.Unlock()
+	fake.DeleteJobStub = stub
+}
+
+func (fake *FakeStore) DeleteJobArgsForCall(i int) uint64 {
+	fake.deleteJobMutex.RLock()
+	defer fake.deleteJobMutex.RUnlock()
+	argsForCall := fake.deleteJobArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStore) DeleteJobReturns(result1 error) {
+	fake.deleteJobMutex.Lock()
+	defer fake.deleteJobMutex.Unlock()
+	fake.DeleteJobStub = nil
+	fake.deleteJobReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteJobReturnsOnCall(i int, result1 error) {
+	fake.deleteJobMutex.Lock()
+	defer fake.deleteJobMutex.Unlock()
+	fake.DeleteJobStub = nil
+	if fake.deleteJobReturnsOnCall == nil {
+		fake.deleteJobReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteJobReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteLedgerEntry(arg1 string, arg2 string, arg3 int, arg4 string) error {
+	fake.deleteLedgerEntryMutex.Lock()
+	ret, specificReturn := fake.deleteLedgerEntryReturnsOnCall[len(fake.deleteLedgerEntryArgsForCall)]
+	fake.deleteLedgerEntryArgsForCall = append(fake.deleteLedgerEntryArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DeleteLedgerEntryStub
+	fakeReturns := fake.deleteLedgerEntryReturns
+	fake.recordInvocation("DeleteLedgerEntry", []interface{}{arg1, arg2, arg3, arg4})
+	fake.deleteLedgerEntryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) DeleteLedgerEntryCallCount() int {
+	fake.deleteLedgerEntryMutex.RLock()
+	defer fake.deleteLedgerEntryMutex.RUnlock()
+	return len(fake.deleteLedgerEntryArgsForCall)
+}
+
+func (fake *FakeStore) DeleteLedgerEntryCalls(stub func(string, string, int, string) error) {
+	fake.deleteLedgerEntryMutex.Lock()
+	defer fake.deleteLedgerEntryMutex.Unlock()
+	fake.DeleteLedgerEntryStub = stub
+}
+
+func (fake *FakeStore) DeleteLedgerEntryArgsForCall(i int) (string, string, int, string) {
+	fake.deleteLedgerEntryMutex.RLock()
+	defer fake.deleteLedgerEntryMutex.RUnlock()
+	argsForCall := fake.deleteLedgerEntryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStore) DeleteLedgerEntryReturns(result1 error) {
+	fake.deleteLedgerEntryMutex.Lock()
+	defer fake.deleteLedgerEntryMutex.Unlock()
+	fake.DeleteLedgerEntryStub = nil
+	fake.deleteLedgerEntryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteLedgerEntryReturnsOnCall(i int, result1 error) {
+	fake.deleteLedgerEntryMutex.Lock()
+	defer fake.deleteLedgerEntryMutex.Unlock()
+	fake.DeleteLedgerEntryStub = nil
+	if fake.deleteLedgerEntryReturnsOnCall == nil {
+		fake.deleteLedgerEntryReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteLedgerEntryReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteOverrides(arg1 string, arg2 string, arg3 int) error {
+	fake.deleteOverridesMutex.Lock()
+	ret, specificReturn := fake.deleteOverridesReturnsOnCall[len(fake.deleteOverridesArgsForCall)]
+	fake.deleteOverridesArgsForCall = append(fake.deleteOverridesArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteOverridesStub
+	fakeReturns := fake.deleteOverridesReturns
+	fake.recordInvocation("DeleteOverrides", []interface{}{arg1, arg2, arg3})
+	fake.deleteOverridesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) DeleteOverridesCallCount() int {
+	fake.deleteOverridesMutex.RLock()
+	defer fake.deleteOverridesMutex.RUnlock()
+	return len(fake.deleteOverridesArgsForCall)
+}
+
+func (fake *FakeStore) DeleteOverridesCalls(stub func(string, string, int) error) {
+	fake.deleteOverridesMutex.Lock()
+	defer fake.deleteOverridesMutex.Unlock()
+	fake.DeleteOverridesStub = stub
+}
+
+func (fake *FakeStore) DeleteOverridesArgsForCall(i int) (string, string, int) {
+	fake.deleteOverridesMutex.RLock()
+	defer fake.deleteOverridesMutex.RUnlock()
+	argsForCall := fake.deleteOverridesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) DeleteOverridesReturns(result1 error) {
+	fake.deleteOverridesMutex.Lock()
+	defer fake.deleteOverridesMutex.Unlock()
+	fake.DeleteOverridesStub = nil
+	fake.deleteOverridesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteOverridesReturnsOnCall(i int, result1 error) {
+	fake.deleteOverridesMutex.Lock()
+	defer fake.deleteOverridesMutex.Unlock()
+	fake.DeleteOverridesStub = nil
+	if fake.deleteOverridesReturnsOnCall == nil {
+		fake.deleteOverridesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteOverridesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteRepo(arg1 string, arg2 string) error {
+	fake.deleteRepoMutex.Lock()
+	ret, specificReturn := fake.deleteRepoReturnsOnCall[len(fake.deleteRepoArgsForCall)]
+	fake.deleteRepoArgsForCall = append(fake.deleteRepoArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteRepoStub
+	fakeReturns := fake.deleteRepoReturns
+	fake.recordInvocation("DeleteRepo", []interface{}{arg1, arg2})
+	fake.deleteRepoMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) DeleteRepoCallCount() int {
+	fake.deleteRepoMutex.RLock()
+	defer fake.deleteRepoMutex.RUnlock()
+	return len(fake.deleteRepoArgsForCall)
+}
+
+func (fake *FakeStore) DeleteRepoCalls(stub func(string, string) error) {
+	fake.deleteRepoMutex.Lock()
+	defer fake.deleteRepoMutex.Unlock()
+	fake.DeleteRepoStub = stub
+}
+
+func (fake *FakeStore) DeleteRepoArgsForCall(i int) (string, string) {
+	fake.deleteRepoMutex.RLock()
+	defer fake.deleteRepoMutex.RUnlock()
+	argsForCall := fake.deleteRepoArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) DeleteRepoReturns(result1 error) {
+	fake.deleteRepoMutex.Lock()
+	defer fake.deleteRepoMutex.Unlock()
+	fake.DeleteRepoStub = nil
+	fake.deleteRepoReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteRepoReturnsOnCall(i int, result1 error) {
+	fake.deleteRepoMutex.Lock()
+	defer fake.deleteRepoMutex.Unlock()
+	fake.DeleteRepoStub = nil
+	if fake.deleteRepoReturnsOnCall == nil {
+		fake.deleteRepoReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteRepoReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) GetBoard(arg1 string, arg2 string, arg3 storage.Team) (storage.Board, bool, error) {
+	fake.getBoardMutex.Lock()
+	ret, specificReturn := fake.getBoardReturnsOnCall[len(fake.getBoardArgsForCall)]
+	fake.getBoardArgsForCall = append(fake.getBoardArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 storage.Team
+	}{arg1, arg2, arg3})
+	stub := fake.GetBoardStub
+	fakeReturns := fake.getBoardReturns
+	fake.recordInvocation("GetBoard", []interface{}{arg1, arg2, arg3})
+	fake.getBoardMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeStore) GetBoardCallCount() int {
+	fake.getBoardMutex.RLock()
+	defer fake.getBoardMutex.RUnlock()
+	return len(fake.getBoardArgsForCall)
+}
+
+func (fake *FakeStore) GetBoardCalls(stub func(string, string, storage.Team) (storage.Board, bool, error)) {
+	fake.getBoardMutex.Lock()
+	defer fake.getBoardMutex.Unlock()
+	fake.GetBoardStub = stub
+}
+
+func (fake *FakeStore) GetBoardArgsForCall(i int) (string, string, storage.Team) {
+	fake.getBoardMutex.RLock()
+	defer fake.getBoardMutex.RUnlock()
+	argsForCall := fake.getBoardArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) GetBoardReturns(result1 storage.Board, result2 bool, result3 error) {
+	fake.getBoardMutex.Lock()
+	defer fake.getBoardMutex.Unlock()
+	fake.GetBoardStub = nil
+	fake.getBoardReturns = struct {
+		result1 storage.Board
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStore) GetBoardReturnsOnCall(i int, result1 storage.Board, result2 bool, result3 error) {
+	fake.getBoardMutex.Lock()
+	defer fake.getBoardMutex.Unlock()
+	fake.GetBoardStub = nil
+	if fake.getBoardReturnsOnCall == nil {
+		fake.getBoardReturnsOnCall = make(map[int]struct {
+			result1 storage.Board
+			result2 bool
+			result3 error
+		})
+	}
+	fake.getBoardReturnsOnCall[i] = struct {
+		result1 storage.Board
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStore) GetCleanStateRuns() ([]storage.CleanStateRun, error) {
+	fake.getCleanStateRunsMutex.Lock()
+	ret, specificReturn := fake.getCleanStateRunsReturnsOnCall[len(fake.getCleanStateRunsArgsForCall)]
+	fake.getCleanStateRunsArgsForCall = append(fake.getCleanStateRunsArgsForCall, struct {
+	}{})
+	stub := fake.GetCleanStateRunsStub
+	fakeReturns := fake.getCleanStateRunsReturns
+	fake.recordInvocation("GetCleanStateRuns", []interface{}{})
+	fake.getCleanStateRunsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetCleanStateRunsCallCount() int {
+	fake.getCleanStateRunsMutex.RLock()
+	defer fake.getCleanStateRunsMutex.RUnlock()
+	return len(fake.getCleanStateRunsArgsForCall)
+}
+
+func (fake *FakeStore) GetCleanStateRunsCalls(stub func() ([]storage.CleanStateRun, error)) {
+	fake.getCleanStateRunsMutex.Lock()
+	defer fake.getCleanStateRunsMutex.Unlock()
+	fake.GetCleanStateRunsStub = stub
+}
+
+func (fake *FakeStore) GetCleanStateRunsReturns(result1 []storage.CleanStateRun, result2 error) {
+	fake.getCleanStateRunsMutex.Lock()
+	defer fake.getCleanStateRunsMutex.Unlock()
+	fake.GetCleanStateRunsStub = nil
+	fake.getCleanStateRunsReturns = struct {
+		result1 []storage.CleanStateRun
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetCleanStateRunsReturnsOnCall(i int, result1 []storage.CleanStateRun, result2 error) {
+	fake.getCleanStateRunsMutex.Lock()
+	defer fake.getCleanStateRunsMutex.Unlock()
+	fake.GetCleanStateRunsStub = nil
+	if fake.getCleanStateRunsReturnsOnCall == nil {
+		fake.getCleanStateRunsReturnsOnCall = make(map[int]struct {
+			result1 []storage.CleanStateRun
+			result2 error
+		})
+	}
+	fake.getCleanStateRunsReturnsOnCall[i] = struct {
+		result1 []storage.CleanStateRun
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetCommentsVersion(arg1 string, arg2 string) (string, bool, error) {
+	fake.getCommentsVersionMutex.Lock()
+	ret, specificReturn := fake.getCommentsVersionReturnsOnCall[len(fake.getCommentsVersionArgsForCall)]
+	fake.getCommentsVersionArgsForCall = append(fake.getCommentsVersionArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetCommentsVersionStub
+	fakeReturns := fake.getCommentsVersionReturns
+	fake.recordInvocation("GetCommentsVersion", []interface{}{arg1, arg2})
+	fake.getCommentsVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeStore) GetCommentsVersionCallCount() int {
+	fake.getCommentsVersionMutex.RLock()
+	defer fake.getCommentsVersionMutex.RUnlock()
+	return len(fake.getCommentsVersionArgsForCall)
+}
+
+func (fake *FakeStore) GetCommentsVersionCalls(stub func(string, string) (string, bool, error)) {
+	fake.getCommentsVersionMutex.Lock()
+	defer fake.getCommentsVersionMutex.Unlock()
+	fake.GetCommentsVersionStub = stub
+}
+
+func (fake *FakeStore) GetCommentsVersionArgsForCall(i int) (string, string) {
+	fake.getCommentsVersionMutex.RLock()
+	defer fake.getCommentsVersionMutex.RUnlock()
+	argsForCall := fake.getCommentsVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) GetCommentsVersionReturns(result1 string, result2 bool, result3 error) {
+	fake.getCommentsVersionMutex.Lock()
+	defer fake.getCommentsVersionMutex.Unlock()
+	fake.GetCommentsVersionStub = nil
+	fake.getCommentsVersionReturns = struct {
+		result1 string
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStore) GetCommentsVersionReturnsOnCall(i int, result1 string, result2 bool, result3 error) {
+	fake.getCommentsVersionMutex.Lock()
+	defer fake.getCommentsVersionMutex.Unlock()
+	fake.GetCommentsVersionStub = nil
+	if fake.getCommentsVersionReturnsOnCall == nil {
+		fake.getCommentsVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 bool
+			result3 error
+		})
+	}
+	fake.getCommentsVersionReturnsOnCall[i] = struct {
+		result1 string
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStore) GetDeadJobs() ([]storage.Job, error) {
+	fake.getDeadJobsMutex.Lock()
+	ret, specificReturn := fake.getDeadJobsReturnsOnCall[len(fake.getDeadJobsArgsForCall)]
+	fake.getDeadJobsArgsForCall = append(fake.getDeadJobsArgsForCall, struct {
+	}{})
+	stub := fake.GetDeadJobsStub
+	fakeReturns := fake.getDeadJobsReturns
+	fake.recordInvocation("GetDeadJobs", []interface{}{})
+	fake.getDeadJobsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetDeadJobsCallCount() int {
+	fake.getDeadJobsMutex.RLock()
+	defer fake.getDeadJobsMutex.RUnlock()
+	return len(fake.getDeadJobsArgsForCall)
+}
+
+func (fake *FakeStore) GetDeadJobsCalls(stub func() ([]storage.Job, error)) {
+	fake.getDeadJobsMutex.Lock()
+	defer fake.getDeadJobsMutex.Unlock()
+	fake.GetDeadJobsStub = stub
+}
+
+func (fake *FakeStore) GetDeadJobsReturns(result1 []storage.Job, result2 error) {
+	fake.getDeadJobsMutex.Lock()
+	defer fake.getDeadJobsMutex.Unlock()
+	fake.GetDeadJobsStub = nil
+	fake.getDeadJobsReturns = struct {
+		result1 []storage.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetDeadJobsReturnsOnCall(i int, result1 []storage.Job, result2 error) {
+	fake.getDeadJobsMutex.Lock()
+	defer fake.getDeadJobsMutex.Unlock()
+	fake.GetDeadJobsStub = nil
+	if fake.getDeadJobsReturnsOnCall == nil {
+		fake.getDeadJobsReturnsOnCall = make(map[int]struct {
+			result1 []storage.Job
+			result2 error
+		})
+	}
+	fake.getDeadJobsReturnsOnCall[i] = struct {
+		result1 []storage.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetDeliveries() ([]storage.Delivery, error) {
+	fake.getDeliveriesMutex.Lock()
+	ret, specificReturn := fake.getDeliveriesReturnsOnCall[len(fake.getDeliveriesArgsForCall)]
+	fake.getDeliveriesArgsForCall = append(fake.getDeliveriesArgsForCall, struct {
+	}{})
+	stub := fake.GetDeliveriesStub
+	fakeReturns := fake.getDeliveriesReturns
+	fake.recordInvocation("GetDeliveries", []interface{}{})
+	fake.getDeliveriesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetDeliveriesCallCount() int {
+	fake.getDeliveriesMutex.RLock()
+	defer fake.getDeliveriesMutex.RUnlock()
+	return len(fake.getDeliveriesArgsForCall)
+}
+
+func (fake *FakeStore) GetDeliveriesCalls(stub func() ([]storage.Delivery, error)) {
+	fake.getDeliveriesMutex.Lock()
+	defer fake.getDeliveriesMutex.Unlock()
+	fake.GetDeliveriesStub = stub
+}
+
+func (fake *FakeStore) GetDeliveriesReturns(result1 []storage.Delivery, result2 error) {
+	fake.getDeliveriesMutex.Lock()
+	defer fake.getDeliveriesMutex.Unlock()
+	fake.GetDeliveriesStub = nil
+	fake.getDeliveriesReturns = struct {
+		result1 []storage.Delivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetDeliveriesReturnsOnCall(i int, result1 []storage.Delivery, result2 error) {
+	fake.getDeliveriesMutex.Lock()
+	defer fake.getDeliveriesMutex.Unlock()
+	fake.GetDeliveriesStub = nil
+	if fake.getDeliveriesReturnsOnCall == nil {
+		fake.getDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []storage.Delivery
+			result2 error
+		})
+	}
+	fake.getDeliveriesReturnsOnCall[i] = struct {
+		result1 []storage.Delivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetDelivery(arg1 string) (storage.Delivery, bool, error) {
+	fake.getDeliveryMutex.Lock()
+	ret, specificReturn := fake.getDeliveryReturnsOnCall[len(fake.getDeliveryArgsForCall)]
+	fake.getDeliveryArgsForCall = append(fake.getDeliveryArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetDeliveryStub
+	fakeReturns := fake.getDeliveryReturns
+	fake.recordInvocation("GetDelivery", []interface{}{arg1})
+	fake.getDeliveryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeStore) GetDeliveryCallCount() int {
+	fake.getDeliveryMutex.RLock()
+	defer fake.getDeliveryMutex.RUnlock()
+	return len(fake.getDeliveryArgsForCall)
+}
+
+func (fake *FakeStore) GetDeliveryCalls(stub func(string) (storage.Delivery, bool, error)) {
+	fake.getDeliveryMutex.Lock()
+	defer fake.getDeliveryMutex.Unlock()
+	fake.GetDeliveryStub = stub
+}
+
+func (fake *FakeStore) GetDeliveryArgsForCall(i int) string {
+	fake.getDeliveryMutex.RLock()
+	defer fake.getDeliveryMutex.RUnlock()
+	argsForCall := fake.getDeliveryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStore) GetDeliveryReturns(result1 storage.Delivery, result2 bool, result3 error) {
+	fake.getDeliveryMutex.Lock()
+	defer fake.getDeliveryMutex.Unlock()
+	fake.GetDeliveryStub = nil
+	fake.getDeliveryReturns = struct {
+		result1 storage.Delivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStore) GetDeliveryReturnsOnCall(i int, result1 storage.Delivery, result2 bool, result3 error) {
+	fake.getDeliveryMutex.Lock()
+	defer fake.getDeliveryMutex.Unlock()
+	fake.GetDeliveryStub = nil
+	if fake.getDeliveryReturnsOnCall == nil {
+		fake.getDeliveryReturnsOnCall = make(map[int]struct {
+			result1 storage.Delivery
+			result2 bool
+			result3 error
+		})
+	}
+	fake.getDeliveryReturnsOnCall[i] = struct {
+		result1 storage.Delivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStore) GetDeliveryPayload(arg1 string) ([]byte, bool, error) {
+	fake.getDeliveryPayloadMutex.Lock()
+	ret, specificReturn := fake.getDeliveryPayloadReturnsOnCall[len(fake.getDeliveryPayloadArgsForCall)]
+	fake.getDeliveryPayloadArgsForCall = append(fake.getDeliveryPayloadArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetDeliveryPayloadStub
+	fakeReturns := fake.getDeliveryPayloadReturns
+	fake.recordInvocation("GetDeliveryPayload", []interface{}{arg1})
+	fake.getDeliveryPayloadMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeStore) GetDeliveryPayloadCallCount() int {
+	fake.getDeliveryPayloadMutex.RLock()
+	defer fake.getDeliveryPayloadMutex.RUnlock()
+	return len(fake.getDeliveryPayloadArgsForCall)
+}
+
+func (fake *FakeStore) GetDeliveryPayloadCalls(stub func(string) ([]byte, bool, error)) {
+	fake.getDeliveryPayloadMutex.Lock()
+	defer fake.getDeliveryPayloadMutex.Unlock()
+	fake.GetDeliveryPayloadStub = stub
+}
+
+func (fake *FakeStore) GetDeliveryPayloadArgsForCall(i int) string {
+	fake.getDeliveryPayloadMutex.RLock()
+	defer fake.getDeliveryPayloadMutex.RUnlock()
+	argsForCall := fake.getDeliveryPayloadArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStore) GetDeliveryPayloadReturns(result1 []byte, result2 bool, result3 error) {
+	fake.getDeliveryPayloadMutex.Lock()
+	defer fake.getDeliveryPayloadMutex.Unlock()
+	fake.GetDeliveryPayloadStub = nil
+	fake.getDeliveryPayloadReturns = struct {
+		result1 []byte
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStore) GetDeliveryPayloadReturnsOnCall(i int, result1 []byte, result2 bool, result3 error) {
+	fake.getDeliveryPayloadMutex.Lock()
+	defer fake.getDeliveryPayloadMutex.Unlock()
+	fake.GetDeliveryPayloadStub = nil
+	if fake.getDeliveryPayloadReturnsOnCall == nil {
+		fake.getDeliveryPayloadReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 bool
+			result3 error
+		})
+	}
+	fake.getDeliveryPayloadReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStore) GetHighWaterMark(arg1 string, arg2 string) (time.Time, bool, error) {
+	fake.getHighWaterMarkMutex.Lock()
+	ret, specificReturn := fake.getHighWaterMarkReturnsOnCall[len(fake.getHighWaterMarkArgsForCall)]
+	fake.getHighWaterMarkArgsForCall = append(fake.getHighWaterMarkArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetHighWaterMarkStub
+	fakeReturns := fake.getHighWaterMarkReturns
+	fake.recordInvocation("GetHighWaterMark", []interface{}{arg1, arg2})
+	fake.getHighWaterMarkMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeStore) GetHighWaterMarkCallCount() int {
+	fake.getHighWaterMarkMutex.RLock()
+	defer fake.getHighWaterMarkMutex.RUnlock()
+	return len(fake.getHighWaterMarkArgsForCall)
+}
+
+func (fake *FakeStore) GetHighWaterMarkCalls(stub func(string, string) (time.Time, bool, error)) {
+	fake.getHighWaterMarkMutex.Lock()
+	defer fake.getHighWaterMarkMutex.Unlock()
+	fake.GetHighWaterMarkStub = stub
+}
+
+func (fake *FakeStore) GetHighWaterMarkArgsForCall(i int) (string, string) {
+	fake.getHighWaterMarkMutex.RLock()
+	defer fake.getHighWaterMarkMutex.RUnlock()
+	argsForCall := fake.getHighWaterMarkArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) GetHighWaterMarkReturns(result1 time.Time, result2 bool, result3 error) {
+	fake.getHighWaterMarkMutex.Lock()
+	defer fake.getHighWaterMarkMutex.Unlock()
+	fake.GetHighWaterMarkStub = nil
+	fake.getHighWaterMarkReturns = struct {
+		result1 time.Time
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStore) GetHighWaterMarkReturnsOnCall(i int, result1 time.Time, result2 bool, result3 error) {
+	fake.getHighWaterMarkMutex.Lock()
+	defer fake.getHighWaterMarkMutex.Unlock()
+	fake.GetHighWaterMarkStub = nil
+	if fake.getHighWaterMarkReturnsOnCall == nil {
+		fake.getHighWaterMarkReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+			result2 bool
+			result3 error
+		})
+	}
+	fake.getHighWaterMarkReturnsOnCall[i] = struct {
+		result1 time.Time
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStore) GetIssueLedgerEntries(arg1 string, arg2 string, arg3 int) (map[string]model.LedgerEntry, error) {
+	fake.getIssueLedgerEntriesMutex.Lock()
+	ret, specificReturn := fake.getIssueLedgerEntriesReturnsOnCall[len(fake.getIssueLedgerEntriesArgsForCall)]
+	fake.getIssueLedgerEntriesArgsForCall = append(fake.getIssueLedgerEntriesArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.GetIssueLedgerEntriesStub
+	fakeReturns := fake.getIssueLedgerEntriesReturns
+	fake.recordInvocation("GetIssueLedgerEntries", []interface{}{arg1, arg2, arg3})
+	fake.getIssueLedgerEntriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetIssueLedgerEntriesCallCount() int {
+	fake.getIssueLedgerEntriesMutex.RLock()
+	defer fake.getIssueLedgerEntriesMutex.RUnlock()
+	return len(fake.getIssueLedgerEntriesArgsForCall)
+}
+
+func (fake *FakeStore) GetIssueLedgerEntriesCalls(stub func(string, string, int) (map[string]model.LedgerEntry, error)) {
+	fake.getIssueLedgerEntriesMutex.Lock()
+	defer fake.getIssueLedgerEntriesMutex.Unlock()
+	fake.GetIssueLedgerEntriesStub = stub
+}
+
+func (fake *FakeStore) GetIssueLedgerEntriesArgsForCall(i int) (string, string, int) {
+	fake.getIssueLedgerEntriesMutex.RLock()
+	defer fake.getIssueLedgerEntriesMutex.RUnlock()
+	argsForCall := fake.getIssueLedgerEntriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) GetIssueLedgerEntriesReturns(result1 map[string]model.LedgerEntry, result2 error) {
+	fake.getIssueLedgerEntriesMutex.Lock()
+	defer fake.getIssueLedgerEntriesMutex.Unlock()
+	fake.GetIssueLedgerEntriesStub = nil
+	fake.getIssueLedgerEntriesReturns = struct {
+		result1 map[string]model.LedgerEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetIssueLedgerEntriesReturnsOnCall(i int, result1 map[string]model.LedgerEntry, result2 error) {
+	fake.getIssueLedgerEntriesMutex.Lock()
+	defer fake.getIssueLedgerEntriesMutex.Unlock()
+	fake.GetIssueLedgerEntriesStub = nil
+	if fake.getIssueLedgerEntriesReturnsOnCall == nil {
+		fake.getIssueLedgerEntriesReturnsOnCall = make(map[int]struct {
+			result1 map[string]model.LedgerEntry
+			result2 error
+		})
+	}
+	fake.getIssueLedgerEntriesReturnsOnCall[i] = struct {
+		result1 map[string]model.LedgerEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetIssues(arg1 string, arg2 string) (map[int]modela.EnrichedIssue, error) {
+	fake.getIssuesMutex.Lock()
+	ret, specificReturn := fake.getIssuesReturnsOnCall[len(fake.getIssuesArgsForCall)]
+	fake.getIssuesArgsForCall = append(fake.getIssuesArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetIssuesStub
+	fakeReturns := fake.getIssuesReturns
+	fake.recordInvocation("GetIssues", []interface{}{arg1, arg2})
+	fake.getIssuesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetIssuesCallCount() int {
+	fake.getIssuesMutex.RLock()
+	defer fake.getIssuesMutex.RUnlock()
+	return len(fake.getIssuesArgsForCall)
+}
+
+func (fake *FakeStore) GetIssuesCalls(stub func(string, string) (map[int]modela.EnrichedIssue, error)) {
+	fake.getIssuesMutex.Lock()
+	defer fake.getIssuesMutex.Unlock()
+	fake.GetIssuesStub = stub
+}
+
+func (fake *FakeStore) GetIssuesArgsForCall(i int) (string, string) {
+	fake.getIssuesMutex.RLock()
+	defer fake.getIssuesMutex.RUnlock()
+	argsForCall := fake.getIssuesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) GetIssuesReturns(result1 map[int]modela.EnrichedIssue, result2 error) {
+	fake.getIssuesMutex.Lock()
+	defer fake.getIssuesMutex.Unlock()
+	fake.GetIssuesStub = nil
+	fake.getIssuesReturns = struct {
+		result1 map[int]modela.EnrichedIssue
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetIssuesReturnsOnCall(i int, result1 map[int]modela.EnrichedIssue, result2 error) {
+	fake.getIssuesMutex.Lock()
+	defer fake.getIssuesMutex.Unlock()
+	fake.GetIssuesStub = nil
+	if fake.getIssuesReturnsOnCall == nil {
+		fake.getIssuesReturnsOnCall = make(map[int]struct {
+			result1 map[int]modela.EnrichedIssue
+			result2 error
+		})
+	}
+	fake.getIssuesReturnsOnCall[i] = struct {
+		result1 map[int]modela.EnrichedIssue
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetJobs() ([]storage.Job, error) {
+	fake.getJobsMutex.Lock()
+	ret, specificReturn := fake.getJobsReturnsOnCall[len(fake.getJobsArgsForCall)]
+	fake.getJobsArgsForCall = append(fake.getJobsArgsForCall, struct {
+	}{})
+	stub := fake.GetJobsStub
+	fakeReturns := fake.getJobsReturns
+	fake.recordInvocation("GetJobs", []interface{}{})
+	fake.getJobsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetJobsCallCount() int {
+	fake.getJobsMutex.RLock()
+	defer fake.getJobsMutex.RUnlock()
+	return len(fake.getJobsArgsForCall)
+}
+
+func (fake *FakeStore) GetJobsCalls(stub func() ([]storage.Job, error)) {
+	fake.getJobsMutex.Lock()
+	defer fake.getJobsMutex.Unlock()
+	fake.GetJobsStub = stub
+}
+
+func (fake *FakeStore) GetJobsReturns(result1 []storage.Job, result2 error) {
+	fake.getJobsMutex.Lock()
+	defer fake.getJobsMutex.Unlock()
+	fake.GetJobsStub = nil
+	fake.getJobsReturns = struct {
+		result1 []storage.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetJobsReturnsOnCall(i int, result1 []storage.Job, result2 error) {
+	fake.getJobsMutex.Lock()
+	defer fake.getJobsMutex.Unlock()
+	fake.GetJobsStub = nil
+	if fake.getJobsReturnsOnCall == nil {
+		fake.getJobsReturnsOnCall = make(map[int]struct {
+			result1 []storage.Job
+			result2 error
+		})
+	}
+	fake.getJobsReturnsOnCall[i] = struct {
+		result1 []storage.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetLedgerEntries() ([]model.LedgerEntry, error) {
+	fake.getLedgerEntriesMutex.Lock()
+	ret, specificReturn := fake.getLedgerEntriesReturnsOnCall[len(fake.getLedgerEntriesArgsForCall)]
+	fake.getLedgerEntriesArgsForCall = append(fake.getLedgerEntriesArgsForCall, struct {
+	}{})
+	stub := fake.GetLedgerEntriesStub
+	fakeReturns := fake.getLedgerEntriesReturns
+	fake.recordInvocation("GetLedgerEntries", []interface{}{})
+	fake.getLedgerEntriesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetLedgerEntriesCallCount() int {
+	fake.getLedgerEntriesMutex.RLock()
+	defer fake.getLedgerEntriesMutex.RUnlock()
+	return len(fake.getLedgerEntriesArgsForCall)
+}
+
+func (fake *FakeStore) GetLedgerEntriesCalls(stub func() ([]model.LedgerEntry, error)) {
+	fake.getLedgerEntriesMutex.Lock()
+	defer fake.getLedgerEntriesMutex.Unlock()
+	fake.GetLedgerEntriesStub = stub
+}
+
+func (fake *FakeStore) GetLedgerEntriesReturns(result1 []model.LedgerEntry, result2 error) {
+	fake.getLedgerEntriesMutex.Lock()
+	defer fake.getLedgerEntriesMutex.Unlock()
+	fake.GetLedgerEntriesStub = nil
+	fake.getLedgerEntriesReturns = struct {
+		result1 []model.LedgerEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetLedgerEntriesReturnsOnCall(i int, result1 []model.LedgerEntry, result2 error) {
+	fake.getLedgerEntriesMutex.Lock()
+	defer fake.getLedgerEntriesMutex.Unlock()
+	fake.GetLedgerEntriesStub = nil
+	if fake.getLedgerEntriesReturnsOnCall == nil {
+		fake.getLedgerEntriesReturnsOnCall = make(map[int]struct {
+			result1 []model.LedgerEntry
+			result2 error
+		})
+	}
+	fake.getLedgerEntriesReturnsOnCall[i] = struct {
+		result1 []model.LedgerEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetLedgerEntry(arg1 string, arg2 string, arg3 int, arg4 string) (model.LedgerEntry, bool, error) {
+	fake.getLedgerEntryMutex.Lock()
+	ret, specificReturn := fake.getLedgerEntryReturnsOnCall[len(fake.getLedgerEntryArgsForCall)]
+	fake.getLedgerEntryArgsForCall = append(fake.getLedgerEntryArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetLedgerEntryStub
+	fakeReturns := fake.getLedgerEntryReturns
+	fake.recordInvocation("GetLedgerEntry", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getLedgerEntryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeStore) GetLedgerEntryCallCount() int {
+	fake.getLedgerEntryMutex.RLock()
+	defer fake.getLedgerEntryMutex.RUnlock()
+	return len(fake.getLedgerEntryArgsForCall)
+}
+
+func (fake *FakeStore) GetLedgerEntryCalls(stub func(string, string, int, string) (model.LedgerEntry, bool, error)) {
+	fake.getLedgerEntryMutex.Lock()
+	defer fake.getLedgerEntryMutex.Unlock()
+	fake.GetLedgerEntryStub = stub
+}
+
+func (fake *FakeStore) GetLedgerEntryArgsForCall(i int) (string, string, int, string) {
+	fake.getLedgerEntryMutex.RLock()
+	defer fake.getLedgerEntryMutex.RUnlock()
+	argsForCall := fake.getLedgerEntryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStore) GetLedgerEntryReturns(result1 model.LedgerEntry, result2 bool, result3 error) {
+	fake.getLedgerEntryMutex.Lock()
+	defer fake.getLedgerEntryMutex.Unlock()
+	fake.GetLedgerEntryStub = nil
+	fake.getLedgerEntryReturns = struct {
+		result1 model.LedgerEntry
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStore) GetLedgerEntryReturnsOnCall(i int, result1 model.LedgerEntry, result2 bool, result3 error) {
+	fake.getLedgerEntryMutex.Lock()
+	defer fake.getLedgerEntryMutex.Unlock()
+	fake.GetLedgerEntryStub = nil
+	if fake.getLedgerEntryReturnsOnCall == nil {
+		fake.getLedgerEntryReturnsOnCall = make(map[int]struct {
+			result1 model.LedgerEntry
+			result2 bool
+			result3 error
+		})
+	}
+	fake.getLedgerEntryReturnsOnCall[i] = struct {
+		result1 model.LedgerEntry
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStore) GetOverrides(arg1 string, arg2 string) (map[int]model.RewardOverrides, error) {
+	fake.getOverridesMutex.Lock()
+	ret, specificReturn := fake.getOverridesReturnsOnCall[len(fake.getOverridesArgsForCall)]
+	fake.getOverridesArgsForCall = append(fake.getOverridesArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetOverridesStub
+	fakeReturns := fake.getOverridesReturns
+	fake.recordInvocation("GetOverrides", []interface{}{arg1, arg2})
+	fake.getOverridesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetOverridesCallCount() int {
+	fake.getOverridesMutex.RLock()
+	defer fake.getOverridesMutex.RUnlock()
+	return len(fake.getOverridesArgsForCall)
+}
+
+func (fake *FakeStore) GetOverridesCalls(stub func(string, string) (map[int]model.RewardOverrides, error)) {
+	fake.getOverridesMutex.Lock()
+	defer fake.getOverridesMutex.Unlock()
+	fake.GetOverridesStub = stub
+}
+
+func (fake *FakeStore) GetOverridesArgsForCall(i int) (string, string) {
+	fake.getOverridesMutex.RLock()
+	defer fake.getOverridesMutex.RUnlock()
+	argsForCall := fake.getOverridesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) GetOverridesReturns(result1 map[int]model.RewardOverrides, result2 error) {
+	fake.getOverridesMutex.Lock()
+	defer fake.getOverridesMutex.Unlock()
+	fake.GetOverridesStub = nil
+	fake.getOverridesReturns = struct {
+		result1 map[int]model.RewardOverrides
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetOverridesReturnsOnCall(i int, result1 map[int]model.RewardOverrides, result2 error) {
+	fake.getOverridesMutex.Lock()
+	defer fake.getOverridesMutex.Unlock()
+	fake.GetOverridesStub = nil
+	if fake.getOverridesReturnsOnCall == nil {
+		fake.getOverridesReturnsOnCall = make(map[int]struct {
+			result1 map[int]model.RewardOverrides
+			result2 error
+		})
+	}
+	fake.getOverridesReturnsOnCall[i] = struct {
+		result1 map[int]model.RewardOverrides
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) IsRepoHidden(arg1 string, arg2 string) (bool, error) {
+	fake.isRepoHiddenMutex.Lock()
+	ret, specificReturn := fake.isRepoHiddenReturnsOnCall[len(fake.isRepoHiddenArgsForCall)]
+	fake.isRepoHiddenArgsForCall = append(fake.isRepoHiddenArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.IsRepoHiddenStub
+	fakeReturns := fake.isRepoHiddenReturns
+	fake.recordInvocation("IsRepoHidden", []interface{}{arg1, arg2})
+	fake.isRepoHiddenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) IsRepoHiddenCallCount() int {
+	fake.isRepoHiddenMutex.RLock()
+	defer fake.isRepoHiddenMutex.RUnlock()
+	return len(fake.isRepoHiddenArgsForCall)
+}
+
+func (fake *FakeStore) IsRepoHiddenCalls(stub func(string, string) (bool, error)) {
+	fake.isRepoHiddenMutex.Lock()
+	defer fake.isRepoHiddenMutex.Unlock()
+	fake.IsRepoHiddenStub = stub
+}
+
+func (fake *FakeStore) IsRepoHiddenArgsForCall(i int) (string, string) {
+	fake.isRepoHiddenMutex.RLock()
+	defer fake.isRepoHiddenMutex.RUnlock()
+	argsForCall := fake.isRepoHiddenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) IsRepoHiddenReturns(result1 bool, result2 error) {
+	fake.isRepoHiddenMutex.Lock()
+	defer fake.isRepoHiddenMutex.Unlock()
+	fake.IsRepoHiddenStub = nil
+	fake.isRepoHiddenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) IsRepoHiddenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.isRepoHiddenMutex.Lock()
+	defer fake.isRepoHiddenMutex.Unlock()
+	fake.IsRepoHiddenStub = nil
+	if fake.isRepoHiddenReturnsOnCall == nil {
+		fake.isRepoHiddenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isRepoHiddenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) PutBoard(arg1 string, arg2 string, arg3 storage.Team, arg4 storage.Board) error {
+	fake.putBoardMutex.Lock()
+	ret, specificReturn := fake.putBoardReturnsOnCall[len(fake.putBoardArgsForCall)]
+	fake.putBoardArgsForCall = append(fake.putBoardArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 storage.Team
+		arg4 storage.Board
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.PutBoardStub
+	fakeReturns := fake.putBoardReturns
+	fake.recordInvocation("PutBoard", []interface{}{arg1, arg2, arg3, arg4})
+	fake.putBoardMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) PutBoardCallCount() int {
+	fake.putBoardMutex.RLock()
+	defer fake.putBoardMutex.RUnlock()
+	return len(fake.putBoardArgsForCall)
+}
+
+func (fake *FakeStore) PutBoardCalls(stub func(string, string, storage.Team, storage.Board) error) {
+	fake.putBoardMutex.Lock()
+	defer fake.putBoardMutex.Unlock()
+	fake.PutBoardStub = stub
+}
+
+func (fake *FakeStore) PutBoardArgsForCall(i int) (string, string, storage.Team, storage.Board) {
+	fake.putBoardMutex.RLock()
+	defer fake.putBoardMutex.RUnlock()
+	argsForCall := fake.putBoardArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStore) PutBoardReturns(result1 error) {
+	fake.putBoardMutex.Lock()
+	defer fake.putBoardMutex.Unlock()
+	fake.PutBoardStub = nil
+	fake.putBoardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) PutBoardReturnsOnCall(i int, result1 error) {
+	fake.putBoardMutex.Lock()
+	defer fake.putBoardMutex.Unlock()
+	fake.PutBoardStub = nil
+	if fake.putBoardReturnsOnCall == nil {
+		fake.putBoardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.putBoardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) PutCommentsVersion(arg1 string, arg2 string, arg3 string) error {
+	fake.putCommentsVersionMutex.Lock()
+	ret, specificReturn := fake.putCommentsVersionReturnsOnCall[len(fake.putCommentsVersionArgsForCall)]
+	fake.putCommentsVersionArgsForCall = append(fake.putCommentsVersionArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.PutCommentsVersionStub
+	fakeReturns := fake.putCommentsVersionReturns
+	fake.recordInvocation("PutCommentsVersion", []interface{}{arg1, arg2, arg3})
+	fake.putCommentsVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) PutCommentsVersionCallCount() int {
+	fake.putCommentsVersionMutex.RLock()
+	defer fake.putCommentsVersionMutex.RUnlock()
+	return len(fake.putCommentsVersionArgsForCall)
+}
+
+func (fake *FakeStore) PutCommentsVersionCalls(stub func(string, string, string) error) {
+	fake.putCommentsVersionMutex.Lock()
+	defer fake.putCommentsVersionMutex.Unlock()
+	fake.PutCommentsVersionStub = stub
+}
+
+func (fake *FakeStore) PutCommentsVersionArgsForCall(i int) (string, string, string) {
+	fake.putCommentsVersionMutex.RLock()
+	defer fake.putCommentsVersionMutex.RUnlock()
+	argsForCall := fake.putCommentsVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) PutCommentsVersionReturns(result1 error) {
+	fake.putCommentsVersionMutex.Lock()
+	defer fake.putCommentsVersionMutex.Unlock()
+	fake.PutCommentsVersionStub = nil
+	fake.putCommentsVersionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) PutCommentsVersionReturnsOnCall(i int, result1 error) {
+	fake.putCommentsVersionMutex.Lock()
+	defer fake.putCommentsVersionMutex.Unlock()
+	fake.PutCommentsVersionStub = nil
+	if fake.putCommentsVersionReturnsOnCall == nil {
+		fake.putCommentsVersionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.putCommentsVersionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) PutHighWaterMark(arg1 string, arg2 string, arg3 time.Time) error {
+	fake.putHighWaterMarkMutex.Lock()
+	ret, specificReturn := fake.putHighWaterMarkReturnsOnCall[len(fake.putHighWaterMarkArgsForCall)]
+	fake.putHighWaterMarkArgsForCall = append(fake.putHighWaterMarkArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.PutHighWaterMarkStub
+	fakeReturns := fake.putHighWaterMarkReturns
+	fake.recordInvocation("PutHighWaterMark", []interface{}{arg1, arg2, arg3})
+	fake.putHighWaterMarkMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) PutHighWaterMarkCallCount() int {
+	fake.putHighWaterMarkMutex.RLock()
+	defer fake.putHighWaterMarkMutex.RUnlock()
+	return len(fake.putHighWaterMarkArgsForCall)
+}
+
+func (fake *FakeStore) PutHighWaterMarkCalls(stub func(string, string, time.Time) error) {
+	fake.putHighWaterMarkMutex.Lock()
+	defer fake.putHighWaterMarkMutex.Unlock()
+	fake.PutHighWaterMarkStub = stub
+}
+
+func (fake *FakeStore) PutHighWaterMarkArgsForCall(i int) (string, string, time.Time) {
+	fake.putHighWaterMarkMutex.RLock()
+	defer fake.putHighWaterMarkMutex.RUnlock()
+	argsForCall := fake.putHighWaterMarkArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) PutHighWaterMarkReturns(result1 error) {
+	fake.putHighWaterMarkMutex.Lock()
+	defer fake.putHighWaterMarkMutex.Unlock()
+	fake.PutHighWaterMarkStub = nil
+	fake.putHighWaterMarkReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) PutHighWaterMarkReturnsOnCall(i int, result1 error) {
+	fake.putHighWaterMarkMutex.Lock()
+	defer fake.putHighWaterMarkMutex.Unlock()
+	fake.PutHighWaterMarkStub = nil
+	if fake.putHighWaterMarkReturnsOnCall == nil {
+		fake.putHighWaterMarkReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.putHighWaterMarkReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) PutIssue(arg1 string, arg2 string, arg3 modela.EnrichedIssue) error {
+	fake.putIssueMutex.Lock()
+	ret, specificReturn := fake.putIssueReturnsOnCall[len(fake.putIssueArgsForCall)]
+	fake.putIssueArgsForCall = append(fake.putIssueArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 modela.EnrichedIssue
+	}{arg1, arg2, arg3})
+	stub := fake.PutIssueStub
+	fakeReturns := fake.putIssueReturns
+	fake.recordInvocation("PutIssue", []interface{}{arg1, arg2, arg3})
+	fake.putIssueMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) PutIssueCallCount() int {
+	fake.putIssueMutex.RLock()
+	defer fake.putIssueMutex.RUnlock()
+	return len(fake.putIssueArgsForCall)
+}
+
+func (fake *FakeStore) PutIssueCalls(stub func(string, string, modela.EnrichedIssue) error) {
+	fake.putIssueMutex.Lock()
+	defer fake.putIssueMutex.Unlock()
+	fake.PutIssueStub = stub
+}
+
+func (fake *FakeStore) PutIssueArgsForCall(i int) (string, string, modela.EnrichedIssue) {
+	fake.putIssueMutex.RLock()
+	defer fake.putIssueMutex.RUnlock()
+	argsForCall := fake.putIssueArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) PutIssueReturns(result1 error) {
+	fake.putIssueMutex.Lock()
+	defer fake.putIssueMutex.Unlock()
+	fake.PutIssueStub = nil
+	fake.putIssueReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) PutIssueReturnsOnCall(i int, result1 error) {
+	fake.putIssueMutex.Lock()
+	defer fake.putIssueMutex.Unlock()
+	fake.PutIssueStub = nil
+	if fake.putIssueReturnsOnCall == nil {
+		fake.putIssueReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.putIssueReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) PutIssues(arg1 string, arg2 string, arg3 map[int]modela.EnrichedIssue) error {
+	fake.putIssuesMutex.Lock()
+	ret, specificReturn := fake.putIssuesReturnsOnCall[len(fake.putIssuesArgsForCall)]
+	fake.putIssuesArgsForCall = append(fake.putIssuesArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[int]modela.EnrichedIssue
+	}{arg1, arg2, arg3})
+	stub := fake.PutIssuesStub
+	fakeReturns := fake.putIssuesReturns
+	fake.recordInvocation("PutIssues", []interface{}{arg1, arg2, arg3})
+	fake.putIssuesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) PutIssuesCallCount() int {
+	fake.putIssuesMutex.RLock()
+	defer fake.putIssuesMutex.RUnlock()
+	return len(fake.putIssuesArgsForCall)
+}
+
+func (fake *FakeStore) PutIssuesCalls(stub func(string, string, map[int]modela.EnrichedIssue) error) {
+	fake.putIssuesMutex.Lock()
+	defer fake.putIssuesMutex.Unlock()
+	fake.PutIssuesStub = stub
+}
+
+func (fake *FakeStore) PutIssuesArgsForCall(i int) (string, string, map[int]modela.EnrichedIssue) {
+	fake.putIssuesMutex.RLock()
+	defer fake.putIssuesMutex.RUnlock()
+	argsForCall := fake.putIssuesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) PutIssuesReturns(result1 error) {
+	fake.putIssuesMutex.Lock()
+	defer fake.putIssuesMutex.Unlock()
+	fake.PutIssuesStub = nil
+	fake.putIssuesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) PutIssuesReturnsOnCall(i int, result1 error) {
+	fake.putIssuesMutex.Lock()
+	defer fake.putIssuesMutex.Unlock()
+	fake.PutIssuesStub = nil
+	if fake.putIssuesReturnsOnCall == nil {
+		fake.putIssuesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.putIssuesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) PutJob(arg1 storage.Job) (storage.Job, error) {
+	fake.putJobMutex.Lock()
+	ret, specificReturn := fake.putJobReturnsOnCall[len(fake.putJobArgsForCall)]
+	fake.putJobArgsForCall = append(fake.putJobArgsForCall, struct {
+		arg1 storage.Job
+	}{arg1})
+	stub := fake.PutJobStub
+	fakeReturns := fake.putJobReturns
+	fake.recordInvocation("PutJob", []interface{}{arg1})
+	fake.putJobMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) PutJobCallCount() int {
+	fake.putJobMutex.RLock()
+	defer fake.putJobMutex.RUnlock()
+	return len(fake.putJobArgsForCall)
+}
+
+func (fake *FakeStore) PutJobCalls(stub func(storage.Job) (storage.Job, error)) {
+	fake.putJobMutex.Lock()
+	defer fake.putJobMutex.Unlock()
+	fake.PutJobStub = stub
+}
+
+func (fake *FakeStore) PutJobArgsForCall(i int) storage.Job {
+	fake.putJobMutex.RLock()
+	defer fake.putJobMutex.RUnlock()
+	argsForCall := fake.putJobArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStore) PutJobReturns(result1 storage.Job, result2 error) {
+	fake.putJobMutex.Lock()
+	defer fake.putJobMutex.Unlock()
+	fake.PutJobStub = nil
+	fake.putJobReturns = struct {
+		result1 storage.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) PutJobReturnsOnCall(i int, result1 storage.Job, result2 error) {
+	fake.putJobMutex.Lock()
+	defer fake.putJobMutex.Unlock()
+	fake.PutJobStub = nil
+	if fake.putJobReturnsOnCall == nil {
+		fake.putJobReturnsOnCall = make(map[int]struct {
+			result1 storage.Job
+			result2 error
+		})
+	}
+	fake.putJobReturnsOnCall[i] = struct {
+		result1 storage.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) PutLedgerEntry(arg1 model.LedgerEntry) error {
+	fake.putLedgerEntryMutex.Lock()
+	ret, specificReturn := fake.putLedgerEntryReturnsOnCall[len(fake.putLedgerEntryArgsForCall)]
+	fake.putLedgerEntryArgsForCall = append(fake.putLedgerEntryArgsForCall, struct {
+		arg1 model.LedgerEntry
+	}{arg1})
+	stub := fake.PutLedgerEntryStub
+	fakeReturns := fake.putLedgerEntryReturns
+	fake.recordInvocation("PutLedgerEntry", []interface{}{arg1})
+	fake.putLedgerEntryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) PutLedgerEntryCallCount() int {
+	fake.putLedgerEntryMutex.RLock()
+	defer fake.putLedgerEntryMutex.RUnlock()
+	return len(fake.putLedgerEntryArgsForCall)
+}
+
+func (fake *FakeStore) PutLedgerEntryCalls(stub func(model.LedgerEntry) error) {
+	fake.putLedgerEntryMutex.Lock()
+	defer fake.putLedgerEntryMutex.Unlock()
+	fake.PutLedgerEntryStub = stub
+}
+
+func (fake *FakeStore) PutLedgerEntryArgsForCall(i int) model.LedgerEntry {
+	fake.putLedgerEntryMutex.RLock()
+	defer fake.putLedgerEntryMutex.RUnlock()
+	argsForCall := fake.putLedgerEntryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStore) PutLedgerEntryReturns(result1 error) {
+	fake.putLedgerEntryMutex.Lock()
+	defer fake.putLedgerEntryMutex.Unlock()
+	fake.PutLedgerEntryStub = nil
+	fake.putLedgerEntryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) PutLedgerEntryReturnsOnCall(i int, result1 error) {
+	fake.putLedgerEntryMutex.Lock()
+	defer fake.putLedgerEntryMutex.Unlock()
+	fake.PutLedgerEntryStub = nil
+	if fake.putLedgerEntryReturnsOnCall == nil {
+		fake.putLedgerEntryReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.putLedgerEntryReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) PutOverrides(arg1 string, arg2 string, arg3 int, arg4 model.RewardOverrides) error {
+	fake.putOverridesMutex.Lock()
+	ret, specificReturn := fake.putOverridesReturnsOnCall[len(fake.putOverridesArgsForCall)]
+	fake.putOverridesArgsForCall = append(fake.putOverridesArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+		arg4 model.RewardOverrides
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.PutOverridesStub
+	fakeReturns := fake.putOverridesReturns
+	fake.recordInvocation("PutOverrides", []interface{}{arg1, arg2, arg3, arg4})
+	fake.putOverridesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) PutOverridesCallCount() int {
+	fake.putOverridesMutex.RLock()
+	defer fake.putOverridesMutex.RUnlock()
+	return len(fake.putOverridesArgsForCall)
+}
+
+func (fake *FakeStore) PutOverridesCalls(stub func(string, string, int, model.RewardOverrides) error) {
+	fake.putOverridesMutex.Lock()
+	defer fake.putOverridesMutex.Unlock()
+	fake.PutOverridesStub = stub
+}
+
+func (fake *FakeStore) PutOverridesArgsForCall(i int) (string, string, int, model.RewardOverrides) {
+	fake.putOverridesMutex.RLock()
+	defer fake.putOverridesMutex.RUnlock()
+	argsForCall := fake.putOverridesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStore) PutOverridesReturns(result1 error) {
+	fake.putOverridesMutex.Lock()
+	defer fake.putOverridesMutex.Unlock()
+	fake.PutOverridesStub = nil
+	fake.putOverridesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) PutOverridesReturnsOnCall(i int, result1 error) {
+	fake.putOverridesMutex.Lock()
+	defer fake.putOverridesMutex.Unlock()
+	fake.PutOverridesStub = nil
+	if fake.putOverridesReturnsOnCall == nil {
+		fake.putOverridesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.putOverridesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) SetDeliveryStatus(arg1 string, arg2 storage.DeliveryStatus, arg3 string, arg4 time.Time) error {
+	fake.setDeliveryStatusMutex.Lock()
+	ret, specificReturn := fake.setDeliveryStatusReturnsOnCall[len(fake.setDeliveryStatusArgsForCall)]
+	fake.setDeliveryStatusArgsForCall = append(fake.setDeliveryStatusArgsForCall, struct {
+		arg1 string
+		arg2 storage.DeliveryStatus
+		arg3 string
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SetDeliveryStatusStub
+	fakeReturns := fake.setDeliveryStatusReturns
+	fake.recordInvocation("SetDeliveryStatus", []interface{}{arg1, arg2, arg3, arg4})
+	fake.setDeliveryStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) SetDeliveryStatusCallCount() int {
+	fake.setDeliveryStatusMutex.RLock()
+	defer fake.setDeliveryStatusMutex.RUnlock()
+	return len(fake.setDeliveryStatusArgsForCall)
+}
+
+func (fake *FakeStore) SetDeliveryStatusCalls(stub func(string, storage.DeliveryStatus, string, time.Time) error) {
+	fake.setDeliveryStatusMutex.Lock()
+	defer fake.setDeliveryStatusMutex.Unlock()
+	fake.SetDeliveryStatusStub = stub
+}
+
+func (fake *FakeStore) SetDeliveryStatusArgsForCall(i int) (string, storage.DeliveryStatus, string, time.Time) {
+	fake.setDeliveryStatusMutex.RLock()
+	defer fake.setDeliveryStatusMutex.RUnlock()
+	argsForCall := fake.setDeliveryStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStore) SetDeliveryStatusReturns(result1 error) {
+	fake.setDeliveryStatusMutex.Lock()
+	defer fake.setDeliveryStatusMutex.Unlock()
+	fake.SetDeliveryStatusStub = nil
+	fake.setDeliveryStatusReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) SetDeliveryStatusReturnsOnCall(i int, result1 error) {
+	fake.setDeliveryStatusMutex.Lock()
+	defer fake.setDeliveryStatusMutex.Unlock()
+	fake.SetDeliveryStatusStub = nil
+	if fake.setDeliveryStatusReturnsOnCall == nil {
+		fake.setDeliveryStatusReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setDeliveryStatusReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) SetRepoHidden(arg1 string, arg2 string, arg3 bool) error {
+	fake.setRepoHiddenMutex.Lock()
+	ret, specificReturn := fake.setRepoHiddenReturnsOnCall[len(fake.setRepoHiddenArgsForCall)]
+	fake.setRepoHiddenArgsForCall = append(fake.setRepoHiddenArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.SetRepoHiddenStub
+	fakeReturns := fake.setRepoHiddenReturns
+	fake.recordInvocation("SetRepoHidden", []interface{}{arg1, arg2, arg3})
+	fake.setRepoHiddenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) SetRepoHiddenCallCount() int {
+	fake.setRepoHiddenMutex.RLock()
+	defer fake.setRepoHiddenMutex.RUnlock()
+	return len(fake.setRepoHiddenArgsForCall)
+}
+
+func (fake *FakeStore) SetRepoHiddenCalls(stub func(string, string, bool) error) {
+	fake.setRepoHiddenMutex.Lock()
+	defer fake.setRepoHiddenMutex.Unlock()
+	fake.SetRepoHiddenStub = stub
+}
+
+func (fake *FakeStore) SetRepoHiddenArgsForCall(i int) (string, string, bool) {
+	fake.setRepoHiddenMutex.RLock()
+	defer fake.setRepoHiddenMutex.RUnlock()
+	argsForCall := fake.setRepoHiddenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) SetRepoHiddenReturns(result1 error) {
+	fake.setRepoHiddenMutex.Lock()
+	defer fake.setRepoHiddenMutex.Unlock()
+	fake.SetRepoHiddenStub = nil
+	fake.setRepoHiddenReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) SetRepoHiddenReturnsOnCall(i int, result1 error) {
+	fake.setRepoHiddenMutex.Lock()
+	defer fake.setRepoHiddenMutex.Unlock()
+	fake.SetRepoHiddenStub = nil
+	if fake.setRepoHiddenReturnsOnCall == nil {
+		fake.setRepoHiddenReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setRepoHiddenReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.addCleanStateRunMutex.RLock()
+	defer fake.addCleanStateRunMutex.RUnlock()
+	fake.addDeliveryMutex.RLock()
+	defer fake.addDeliveryMutex.RUnlock()
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	fake.deadLetterJobMutex.RLock()
+	defer fake.deadLetterJobMutex.RUnlock()
+	fake.deleteIssueMutex.RLock()
+	defer fake.deleteIssueMutex.RUnlock()
+	fake.deleteJobMutex.RLock()
+	defer fake.deleteJobMutex.RUnlock()
+	fake.deleteLedgerEntryMutex.RLock()
+	defer fake.deleteLedgerEntryMutex.RUnlock()
+	fake.deleteOverridesMutex.RLock()
+	defer fake.deleteOverridesMutex.RUnlock()
+	fake.deleteRepoMutex.RLock()
+	defer fake.deleteRepoMutex.RUnlock()
+	fake.getBoardMutex.RLock()
+	defer fake.getBoardMutex.RUnlock()
+	fake.getCleanStateRunsMutex.RLock()
+	defer fake.getCleanStateRunsMutex.RUnlock()
+	fake.getCommentsVersionMutex.RLock()
+	defer fake.getCommentsVersionMutex.RUnlock()
+	fake.getDeadJobsMutex.RLock()
+	defer fake.getDeadJobsMutex.RUnlock()
+	fake.getDeliveriesMutex.RLock()
+	defer fake.getDeliveriesMutex.RUnlock()
+	fake.getDeliveryMutex.RLock()
+	defer fake.getDeliveryMutex.RUnlock()
+	fake.getDeliveryPayloadMutex.RLock()
+	defer fake.getDeliveryPayloadMutex.RUnlock()
+	fake.getHighWaterMarkMutex.RLock()
+	defer fake.getHighWaterMarkMutex.RUnlock()
+	fake.getIssueLedgerEntriesMutex.RLock()
+	defer fake.getIssueLedgerEntriesMutex.RUnlock()
+	fake.getIssuesMutex.RLock()
+	defer fake.getIssuesMutex.RUnlock()
+	fake.getJobsMutex.RLock()
+	defer fake.getJobsMutex.RUnlock()
+	fake.getLedgerEntriesMutex.RLock()
+	defer fake.getLedgerEntriesMutex.RUnlock()
+	fake.getLedgerEntryMutex.RLock()
+	defer fake.getLedgerEntryMutex.RUnlock()
+	fake.getOverridesMutex.RLock()
+	defer fake.getOverridesMutex.RUnlock()
+	fake.isRepoHiddenMutex.RLock()
+	defer fake.isRepoHiddenMutex.RUnlock()
+	fake.putBoardMutex.RLock()
+	defer fake.putBoardMutex.RUnlock()
+	fake.putCommentsVersionMutex.RLock()
+	defer fake.putCommentsVersionMutex.RUnlock()
+	fake.putHighWaterMarkMutex.RLock()
+	defer fake.putHighWaterMarkMutex.RUnlock()
+	fake.putIssueMutex.RLock()
+	defer fake.putIssueMutex.RUnlock()
+	fake.putIssuesMutex.RLock()
+	defer fake.putIssuesMutex.RUnlock()
+	fake.putJobMutex.RLock()
+	defer fake.putJobMutex.RUnlock()
+	fake.putLedgerEntryMutex.RLock()
+	defer fake.putLedgerEntryMutex.RUnlock()
+	fake.putOverridesMutex.RLock()
+	defer fake.putOverridesMutex.RUnlock()
+	fake.setDeliveryStatusMutex.RLock()
+	defer fake.setDeliveryStatusMutex.RUnlock()
+	fake.setRepoHiddenMutex.RLock()
+	defer fake.setRepoHiddenMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeStore) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ storage.Store = new(FakeStore)
@@ -12,6 +12,8 @@ import (
 func FamedRoutes(g *echo.Group, handler famed.HTTPHandler) {
 	g.GET("/repos/:owner/:repo_name/contributors", handler.GetBlueTeam)
 	g.GET("/repos/:owner/:repo_name/redteam", handler.GetRedTeam)
+	g.GET("/owners/:owner/contributors", handler.GetOwnerBlueTeam)
+	g.GET("/owners/:owner/redteam", handler.GetOwnerRedTeam)
 
 	g.POST("/webhooks/event", handler.PostEvent)
 
@@ -22,6 +24,17 @@ func FamedAdminRoutes(g *echo.Group, famedHandler famed.HTTPHandler, githubHandl
 	g.GET("/installations", famedHandler.GetInstallations)
 	g.GET("/trackedissues", famedHandler.GetTrackedIssues)
 	g.GET("/ratelimits/:owner", githubHandler.GetRateLimits)
+	g.POST("/labels/reconcile", famedHandler.PostReconcileLabels)
+	g.GET("/queue", famedHandler.GetEventQueue)
+	g.GET("/webhooks", famedHandler.GetWebhooks)
+	g.GET("/webhooks/:delivery_id", famedHandler.GetWebhook)
+	g.POST("/webhooks/:delivery_id/replay", famedHandler.PostReplayWebhook)
+	g.GET("/cleanstate/runs", famedHandler.GetCleanStateRuns)
+
+	g.GET("/ledger", famedHandler.GetLedger)
+	g.POST("/ledger/:owner/:repo_name/:issue_number/:login/approve", famedHandler.PostApproveLedgerEntry)
+	g.POST("/ledger/:owner/:repo_name/:issue_number/:login/paid", famedHandler.PostPayLedgerEntry)
+	g.POST("/ledger/:owner/:repo_name/:issue_number/:login/void", famedHandler.PostVoidLedgerEntry)
 }
 
 // HealthRoutes defines endpoints exposed to serve uses cases of infrastructure and customer support.
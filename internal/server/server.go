@@ -22,13 +22,18 @@ import (
 	"github.com/morphysm/famed-github-backend/internal/github"
 	"github.com/morphysm/famed-github-backend/internal/health"
 	"github.com/morphysm/famed-github-backend/internal/repositories/github/providers"
+	"github.com/morphysm/famed-github-backend/internal/repositories/storage"
 	"github.com/morphysm/famed-github-backend/pkg/ticker"
 )
 
 // Server represents the HTTP server single instance.
 type Server struct {
-	echo       *echo.Echo
-	devToolKit *devtoolkit.DevToolkit
+	echo         *echo.Echo
+	devToolKit   *devtoolkit.DevToolkit
+	store        storage.Store
+	famedHandler famed.HTTPHandler
+	// stopEventQueue cancels the context the event queue was started with
+	stopEventQueue context.CancelFunc
 }
 
 // NewServer instantiates and sets up a new server using the echo web framework.
@@ -76,12 +81,28 @@ func NewServer(devToolKit *devtoolkit.DevToolkit) (*Server, error) {
 		return nil, eris.Wrap(err, "failed to create new github client")
 	}
 
+	// Open the store persisting issues and boards
+	store, err := storage.NewBoltStore(devToolKit.Config.Storage.Path)
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to open store")
+	}
+
 	// Create a new GitHub handler handling gateway calls to GitHub
 	githubHandler := github.NewHandler(installationClient)
 
 	// Create the famed handler handling the famed business logic
-	famedConfig := model.NewFamedConfig(devToolKit.Config.Famed.Currency, devToolKit.Config.Famed.Rewards, devToolKit.Config.Famed.Labels, devToolKit.Config.Famed.DaysToFix, devToolKit.Config.Github.BotLogin)
-	famedHandler := famed.NewHandler(appClient, installationClient, famedConfig, time.Now)
+	rewardFormula := model.RewardFormulaOptions{
+		Formula:       devToolKit.Config.Famed.RewardFormula.Name,
+		KMultiplier:   devToolKit.Config.Famed.RewardFormula.KMultiplier,
+		ReopenPenalty: devToolKit.Config.Famed.RewardFormula.ReopenPenalty,
+		Steps:         devToolKit.Config.Famed.RewardFormula.Steps,
+	}
+	famedConfig := model.NewFamedConfig(devToolKit.Config.Famed.Currency, devToolKit.Config.Famed.Rewards, devToolKit.Config.Famed.Labels, devToolKit.Config.Famed.DaysToFix, rewardFormula, devToolKit.Config.Famed.Granularity, devToolKit.Config.Github.BotLogin, devToolKit.Config.Famed.Concurrency, devToolKit.Config.Famed.BoardURL, devToolKit.Config.Famed.Templates, devToolKit.Config.Famed.Language, devToolKit.Config.Famed.EligibilityRules)
+	famedHandler := famed.NewHandler(appClient, installationClient, store, famedConfig, time.Now)
+
+	// Start processing the queued webhook events
+	queueCtx, stopEventQueue := context.WithCancel(context.Background())
+	famedHandler.StartEventQueue(queueCtx, devToolKit.Config.Queue.MaxAttempts, time.Duration(devToolKit.Config.Queue.Backoff)*time.Second)
 
 	// Start comment update interval
 	ticker.NewTicker(time.Duration(devToolKit.Config.Famed.UpdateFrequency)*time.Second, famedHandler.CleanState)
@@ -119,8 +140,11 @@ func NewServer(devToolKit *devtoolkit.DevToolkit) (*Server, error) {
 	}
 
 	return &Server{
-		echo:       echoServer,
-		devToolKit: devToolKit,
+		echo:           echoServer,
+		devToolKit:     devToolKit,
+		store:          store,
+		famedHandler:   famedHandler,
+		stopEventQueue: stopEventQueue,
 	}, nil
 }
 
@@ -173,5 +197,13 @@ func (s *Server) Start() error {
 
 	<-idleConnsClosed
 
+	// The jobs being processed use the store, it is closed once they returned
+	s.stopEventQueue()
+	s.famedHandler.WaitEventQueue()
+
+	if err := s.store.Close(); err != nil {
+		return eris.Wrap(err, "failed to close store")
+	}
+
 	return nil
 }
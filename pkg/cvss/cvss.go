@@ -0,0 +1,109 @@
+// Package cvss parses Common Vulnerability Scoring System (CVSS) vectors of version 3.0, 3.1 and 4.0
+// and computes their base score.
+package cvss
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+)
+
+type Version string
+
+const (
+	V3_0 Version = "3.0"
+	V3_1 Version = "3.1"
+	V4_0 Version = "4.0"
+)
+
+const prefix = "CVSS:"
+
+var (
+	ErrInvalidVector      = errors.New("invalid CVSS vector")
+	ErrUnsupportedVersion = errors.New("unsupported CVSS version")
+	ErrMissingMetric      = errors.New("missing CVSS base metric")
+	ErrInvalidMetricValue = errors.New("invalid CVSS metric value")
+	ErrDuplicateMetric    = errors.New("duplicate CVSS metric")
+)
+
+// vectorRegex matches CVSS vectors of the supported versions within a text.
+var vectorRegex = regexp.MustCompile(`CVSS:(?:3\.[01]|4\.0)(?:/[A-Za-z]+:[A-Za-z])+`)
+
+// Vector is a parsed CVSS vector.
+type Vector struct {
+	Version Version
+	metrics map[string]string
+}
+
+// Parse parses a CVSS vector such as "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H".
+// All base metrics of the version must be present and valid.
+// Metrics outside the base metric group are accepted but do not influence the base score.
+func Parse(vector string) (Vector, error) {
+	if !strings.HasPrefix(vector, prefix) {
+		return Vector{}, ErrInvalidVector
+	}
+
+	parts := strings.Split(strings.TrimPrefix(vector, prefix), "/")
+	version := Version(parts[0])
+	baseMetrics, ok := baseMetricValues[version]
+	if !ok {
+		return Vector{}, fmt.Errorf("%w: %s", ErrUnsupportedVersion, parts[0])
+	}
+
+	metrics := make(map[string]string, len(parts)-1)
+	for _, part := range parts[1:] {
+		key, value, found := strings.Cut(part, ":")
+		if !found || key == "" || value == "" {
+			return Vector{}, ErrInvalidVector
+		}
+		if _, ok := metrics[key]; ok {
+			return Vector{}, fmt.Errorf("%w: %s", ErrDuplicateMetric, key)
+		}
+		if values, ok := baseMetrics[key]; ok && (len(value) != 1 || !strings.Contains(values, value)) {
+			return Vector{}, fmt.Errorf("%w: %s:%s", ErrInvalidMetricValue, key, value)
+		}
+
+		metrics[key] = value
+	}
+
+	for key := range baseMetrics {
+		if _, ok := metrics[key]; !ok {
+			return Vector{}, fmt.Errorf("%w: %s", ErrMissingMetric, key)
+		}
+	}
+
+	return Vector{Version: version, metrics: metrics}, nil
+}
+
+// Find returns the first CVSS vector found in a text.
+// The vector is not validated, use Parse to do so.
+func Find(text string) (string, bool) {
+	vector := vectorRegex.FindString(text)
+	return vector, vector != ""
+}
+
+// BaseScore returns the base score of the vector, ranging from 0.0 to 10.0.
+func (v Vector) BaseScore() float64 {
+	switch v.Version {
+	case V3_0, V3_1:
+		return v.baseScoreV3()
+	case V4_0:
+		return v.baseScoreV4()
+	default:
+		return 0
+	}
+}
+
+// baseMetricValues holds the base metrics of each version and their valid single letter values.
+var baseMetricValues = map[Version]map[string]string{
+	V3_0: baseMetricValuesV3,
+	V3_1: baseMetricValuesV3,
+	V4_0: baseMetricValuesV4,
+}
+
+// roundToOneDecimal rounds x to one decimal.
+func roundToOneDecimal(x float64) float64 {
+	return math.Round(x*10) / 10
+}
@@ -0,0 +1,83 @@
+package cvss
+
+import "math"
+
+var baseMetricValuesV3 = map[string]string{
+	"AV": "NALP",
+	"AC": "LH",
+	"PR": "NLH",
+	"UI": "NR",
+	"S":  "UC",
+	"C":  "HLN",
+	"I":  "HLN",
+	"A":  "HLN",
+}
+
+// Metric weights as defined in section 7.4 of the CVSS v3.1 specification.
+var (
+	attackVectorWeights     = map[string]float64{"N": 0.85, "A": 0.62, "L": 0.55, "P": 0.2}
+	attackComplexityWeights = map[string]float64{"L": 0.77, "H": 0.44}
+	userInteractionWeights  = map[string]float64{"N": 0.85, "R": 0.62}
+	impactWeights           = map[string]float64{"H": 0.56, "L": 0.22, "N": 0}
+	privilegesUnchanged     = map[string]float64{"N": 0.85, "L": 0.62, "H": 0.27}
+	privilegesScopeChanged  = map[string]float64{"N": 0.85, "L": 0.68, "H": 0.5}
+)
+
+const (
+	maxScore                  = 10.0
+	scopeChangedFactor        = 1.08
+	impactUnchangedFactor     = 6.42
+	impactChangedFactor       = 7.52
+	exploitabilityCoefficient = 8.22
+)
+
+// baseScoreV3 returns the base score of a CVSS v3.0 or v3.1 vector as defined in section 7.1 of the specification.
+func (v Vector) baseScoreV3() float64 {
+	scopeChanged := v.metrics["S"] == "C"
+
+	privilegesWeights := privilegesUnchanged
+	if scopeChanged {
+		privilegesWeights = privilegesScopeChanged
+	}
+
+	iss := 1 - (1-impactWeights[v.metrics["C"]])*(1-impactWeights[v.metrics["I"]])*(1-impactWeights[v.metrics["A"]])
+
+	var impact float64
+	if scopeChanged {
+		impact = impactChangedFactor*(iss-0.029) - 3.25*math.Pow(iss-0.02, 15)
+	} else {
+		impact = impactUnchangedFactor * iss
+	}
+
+	exploitability := exploitabilityCoefficient *
+		attackVectorWeights[v.metrics["AV"]] *
+		attackComplexityWeights[v.metrics["AC"]] *
+		privilegesWeights[v.metrics["PR"]] *
+		userInteractionWeights[v.metrics["UI"]]
+
+	if impact <= 0 {
+		return 0
+	}
+
+	score := impact + exploitability
+	if scopeChanged {
+		score *= scopeChangedFactor
+	}
+
+	return v.roundUp(math.Min(score, maxScore))
+}
+
+// roundUp returns the smallest number with one decimal equal to or higher than x.
+// CVSS v3.1 avoids floating point errors by rounding to five decimals first, see appendix A of the specification.
+func (v Vector) roundUp(x float64) float64 {
+	if v.Version == V3_0 {
+		return math.Ceil(x*10) / 10
+	}
+
+	intInput := math.Round(x * 100000)
+	if math.Mod(intInput, 10000) == 0 {
+		return intInput / 100000
+	}
+
+	return (math.Floor(intInput/10000) + 1) / 10
+}
@@ -0,0 +1,286 @@
+package cvss
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+var baseMetricValuesV4 = map[string]string{
+	"AV": "NALP",
+	"AC": "LH",
+	"AT": "NP",
+	"PR": "NLH",
+	"UI": "NPA",
+	"VC": "HLN",
+	"VI": "HLN",
+	"VA": "HLN",
+	"SC": "HLN",
+	"SI": "HLN",
+	"SA": "HLN",
+}
+
+// severityLevels holds the severity level of each metric value in steps of 0.1, a lower level is more severe.
+// The base score treats the security requirements CR, IR and AR as high and the exploit maturity E as attacked.
+var severityLevels = map[string]map[string]int{
+	"AV": {"N": 0, "A": 1, "L": 2, "P": 3},
+	"PR": {"N": 0, "L": 1, "H": 2},
+	"UI": {"N": 0, "P": 1, "A": 2},
+	"AC": {"L": 0, "H": 1},
+	"AT": {"N": 0, "P": 1},
+	"VC": {"H": 0, "L": 1, "N": 2},
+	"VI": {"H": 0, "L": 1, "N": 2},
+	"VA": {"H": 0, "L": 1, "N": 2},
+	"SC": {"H": 1, "L": 2, "N": 3},
+	"SI": {"S": 0, "H": 1, "L": 2, "N": 3},
+	"SA": {"S": 0, "H": 1, "L": 2, "N": 3},
+	"CR": {"H": 0, "M": 1, "L": 2},
+	"IR": {"H": 0, "M": 1, "L": 2},
+	"AR": {"H": 0, "M": 1, "L": 2},
+}
+
+// highestSeverityVectors holds the highest severity vectors of each level of the equivalence classes EQ1, EQ2 and EQ4.
+var highestSeverityVectors = map[int]map[int][]string{
+	1: {
+		0: {"AV:N/PR:N/UI:N"},
+		1: {"AV:A/PR:N/UI:N", "AV:N/PR:L/UI:N", "AV:N/PR:N/UI:P"},
+		2: {"AV:P/PR:N/UI:N", "AV:A/PR:L/UI:P"},
+	},
+	2: {
+		0: {"AC:L/AT:N"},
+		1: {"AC:H/AT:N", "AC:L/AT:P"},
+	},
+	4: {
+		0: {"SC:H/SI:S/SA:S"},
+		1: {"SC:H/SI:H/SA:H"},
+		2: {"SC:L/SI:L/SA:L"},
+	},
+}
+
+// highestSeverityVectorsEQ3EQ6 holds the highest severity vectors of the joint equivalence classes EQ3 and EQ6.
+var highestSeverityVectorsEQ3EQ6 = map[int]map[int][]string{
+	0: {
+		0: {"VC:H/VI:H/VA:H/CR:H/IR:H/AR:H"},
+		1: {"VC:H/VI:H/VA:L/CR:M/IR:M/AR:H", "VC:H/VI:H/VA:H/CR:M/IR:M/AR:M"},
+	},
+	1: {
+		0: {"VC:L/VI:H/VA:H/CR:H/IR:H/AR:H", "VC:H/VI:L/VA:H/CR:H/IR:H/AR:H"},
+		1: {"VC:L/VI:H/VA:L/CR:H/IR:M/AR:H", "VC:L/VI:H/VA:H/CR:H/IR:M/AR:M", "VC:H/VI:L/VA:H/CR:M/IR:H/AR:M", "VC:H/VI:L/VA:L/CR:M/IR:H/AR:H", "VC:L/VI:L/VA:H/CR:H/IR:H/AR:M"},
+	},
+	2: {
+		1: {"VC:L/VI:L/VA:L/CR:H/IR:H/AR:H"},
+	},
+}
+
+// maxSeverityDistances holds the maximal severity distance within each level of the equivalence classes EQ1, EQ2 and EQ4.
+var maxSeverityDistances = map[int]map[int]int{
+	1: {0: 1, 1: 4, 2: 5},
+	2: {0: 1, 1: 2},
+	4: {0: 6, 1: 5, 2: 4},
+}
+
+// maxSeverityDistancesEQ3EQ6 holds the maximal severity distance within each level of the joint equivalence classes EQ3 and EQ6.
+var maxSeverityDistancesEQ3EQ6 = map[int]map[int]int{
+	0: {0: 7, 1: 6},
+	1: {0: 8, 1: 8},
+	2: {1: 10},
+}
+
+// macroVector holds the levels of the equivalence classes EQ1 to EQ6 of a CVSS v4.0 vector.
+type macroVector [6]int
+
+// String returns the macro vector as used by the lookup table, e.g. "001100".
+func (mv macroVector) String() string {
+	return fmt.Sprintf("%d%d%d%d%d%d", mv[0], mv[1], mv[2], mv[3], mv[4], mv[5])
+}
+
+// score returns the score of the macro vector and false if the macro vector does not exist.
+func (mv macroVector) score() (float64, bool) {
+	score, ok := macroVectorScores[mv.String()]
+	return score, ok
+}
+
+// baseScoreV4 returns the base score of a CVSS v4.0 vector as defined in section 8 of the specification.
+// The score of the vector's macro vector is lowered by the mean distance of the vector to the macro vector's
+// highest severity vector relative to the score of the next lower macro vector of each equivalence class.
+func (v Vector) baseScoreV4() float64 {
+	// Without impact on the vulnerable and subsequent systems there is no risk to score
+	if v.metrics["VC"] == "N" && v.metrics["VI"] == "N" && v.metrics["VA"] == "N" &&
+		v.metrics["SC"] == "N" && v.metrics["SI"] == "N" && v.metrics["SA"] == "N" {
+		return 0
+	}
+
+	mv := v.macroVector()
+	score, ok := mv.score()
+	if !ok {
+		return 0
+	}
+
+	// Severity distances of the vector to the first highest severity vector of the macro vector it does not exceed
+	distances, ok := v.severityDistances(mv)
+	if !ok {
+		return roundToOneDecimal(score)
+	}
+
+	// The proportional score difference to the next lower macro vector of each equivalence class
+	var normalizedSum float64
+	var lowerCount int
+	for _, class := range []struct {
+		next        []macroVector
+		distance    int
+		maxDistance int
+	}{
+		{next: []macroVector{mv.increment(0)}, distance: distances[1], maxDistance: maxSeverityDistances[1][mv[0]]},
+		{next: []macroVector{mv.increment(1)}, distance: distances[2], maxDistance: maxSeverityDistances[2][mv[1]]},
+		{next: mv.nextLowerEQ3EQ6(), distance: distances[3], maxDistance: maxSeverityDistancesEQ3EQ6[mv[2]][mv[5]]},
+		{next: []macroVector{mv.increment(3)}, distance: distances[4], maxDistance: maxSeverityDistances[4][mv[3]]},
+		// EQ5 only consists of the exploit maturity, which is treated as attacked for the base score
+		{next: []macroVector{mv.increment(4)}, distance: 0, maxDistance: 1},
+	} {
+		nextScore, ok := highestScore(class.next)
+		if !ok {
+			continue
+		}
+
+		lowerCount++
+		normalizedSum += (score - nextScore) * float64(class.distance) / float64(class.maxDistance)
+	}
+
+	if lowerCount > 0 {
+		score -= normalizedSum / float64(lowerCount)
+	}
+
+	return roundToOneDecimal(math.Max(0, math.Min(score, maxScore)))
+}
+
+// macroVector returns the macro vector of the base metrics of the vector.
+func (v Vector) macroVector() macroVector {
+	m := v.metrics
+	var mv macroVector
+
+	switch {
+	case m["AV"] == "N" && m["PR"] == "N" && m["UI"] == "N":
+		mv[0] = 0
+	case (m["AV"] == "N" || m["PR"] == "N" || m["UI"] == "N") && m["AV"] != "P":
+		mv[0] = 1
+	default:
+		mv[0] = 2
+	}
+
+	if m["AC"] != "L" || m["AT"] != "N" {
+		mv[1] = 1
+	}
+
+	switch {
+	case m["VC"] == "H" && m["VI"] == "H":
+		mv[2] = 0
+	case m["VC"] == "H" || m["VI"] == "H" || m["VA"] == "H":
+		mv[2] = 1
+	default:
+		mv[2] = 2
+	}
+
+	switch {
+	case m["SI"] == "S" || m["SA"] == "S":
+		mv[3] = 0
+	case m["SC"] == "H" || m["SI"] == "H" || m["SA"] == "H":
+		mv[3] = 1
+	default:
+		mv[3] = 2
+	}
+
+	// EQ5 is 0 since the exploit maturity is treated as attacked.
+	// EQ6 depends on the security requirements, which are treated as high.
+	if m["VC"] != "H" && m["VI"] != "H" && m["VA"] != "H" {
+		mv[5] = 1
+	}
+
+	return mv
+}
+
+// severityDistances returns the severity distances of the vector to the first highest severity vector of its macro vector
+// it does not exceed, summed up by the equivalence classes EQ1, EQ2, EQ3 with EQ6 and EQ4.
+func (v Vector) severityDistances(mv macroVector) (map[int]int, bool) {
+	for _, eq1 := range highestSeverityVectors[1][mv[0]] {
+		for _, eq2 := range highestSeverityVectors[2][mv[1]] {
+			for _, eq3eq6 := range highestSeverityVectorsEQ3EQ6[mv[2]][mv[5]] {
+				for _, eq4 := range highestSeverityVectors[4][mv[3]] {
+					distances := map[int]int{}
+					valid := true
+					for class, vector := range map[int]string{1: eq1, 2: eq2, 3: eq3eq6, 4: eq4} {
+						distance, ok := v.severityDistance(vector)
+						if !ok {
+							valid = false
+							break
+						}
+						distances[class] = distance
+					}
+
+					if valid {
+						return distances, true
+					}
+				}
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// severityDistance returns the summed up severity distance of the vector's metrics to the metrics of a partial vector
+// and false if any metric of the vector is more severe than the metric of the partial vector.
+func (v Vector) severityDistance(partial string) (int, bool) {
+	var sum int
+	for _, metric := range strings.Split(partial, "/") {
+		key, value, _ := strings.Cut(metric, ":")
+		distance := severityLevels[key][v.baseValueV4(key)] - severityLevels[key][value]
+		if distance < 0 {
+			return 0, false
+		}
+		sum += distance
+	}
+
+	return sum, true
+}
+
+// baseValueV4 returns the value of a metric, treating the security requirements as high.
+func (v Vector) baseValueV4(key string) string {
+	switch key {
+	case "CR", "IR", "AR":
+		return "H"
+	default:
+		return v.metrics[key]
+	}
+}
+
+// increment returns a copy of the macro vector with the level of the equivalence class at index increased by one.
+func (mv macroVector) increment(index int) macroVector {
+	mv[index]++
+	return mv
+}
+
+// nextLowerEQ3EQ6 returns the candidates for the next lower macro vector of the joint equivalence classes EQ3 and EQ6.
+func (mv macroVector) nextLowerEQ3EQ6() []macroVector {
+	switch {
+	case mv[2] == 0 && mv[5] == 0:
+		return []macroVector{mv.increment(5), mv.increment(2)}
+	case mv[2] == 1 && mv[5] == 0:
+		return []macroVector{mv.increment(5)}
+	default:
+		return []macroVector{mv.increment(2)}
+	}
+}
+
+// highestScore returns the highest score of the existing macro vectors and false if none of them exists.
+func highestScore(macroVectors []macroVector) (float64, bool) {
+	var highest float64
+	var found bool
+	for _, mv := range macroVectors {
+		if score, ok := mv.score(); ok && (!found || score > highest) {
+			highest = score
+			found = true
+		}
+	}
+
+	return highest, found
+}
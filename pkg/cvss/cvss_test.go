@@ -0,0 +1,145 @@
+package cvss_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/pkg/cvss"
+)
+
+func TestBaseScore(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Vector   string
+		Expected float64
+	}{
+		{
+			Name:     "v3.1 critical",
+			Vector:   "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+			Expected: 9.8,
+		},
+		{
+			Name:     "v3.1 scope changed",
+			Vector:   "CVSS:3.1/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N",
+			Expected: 6.1,
+		},
+		{
+			Name:     "v3.1 with temporal metrics",
+			Vector:   "CVSS:3.1/AV:L/AC:L/PR:L/UI:N/S:U/C:H/I:N/A:N/E:P",
+			Expected: 5.5,
+		},
+		{
+			Name:     "v3.1 no impact",
+			Vector:   "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:N",
+			Expected: 0,
+		},
+		{
+			Name:     "v3.0",
+			Vector:   "CVSS:3.0/AV:N/AC:H/PR:N/UI:N/S:U/C:H/I:N/A:N",
+			Expected: 5.9,
+		},
+		{
+			Name:     "v4.0 critical",
+			Vector:   "CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N",
+			Expected: 9.3,
+		},
+		{
+			Name:     "v4.0 high",
+			Vector:   "CVSS:4.0/AV:L/AC:L/AT:N/PR:L/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N",
+			Expected: 8.5,
+		},
+		{
+			Name:     "v4.0 medium",
+			Vector:   "CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:A/VC:N/VI:N/VA:N/SC:L/SI:L/SA:N",
+			Expected: 5.1,
+		},
+		{
+			Name:     "v4.0 no impact",
+			Vector:   "CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:N/VI:N/VA:N/SC:N/SI:N/SA:N",
+			Expected: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// GIVEN
+			vector, err := cvss.Parse(testCase.Vector)
+			assert.NoError(t, err)
+
+			// WHEN
+			score := vector.BaseScore()
+
+			// THEN
+			assert.Equal(t, testCase.Expected, score)
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		Vector      string
+		ExpectedErr error
+	}{
+		{
+			Name:        "Missing prefix",
+			Vector:      "AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+			ExpectedErr: cvss.ErrInvalidVector,
+		},
+		{
+			Name:        "Unsupported version",
+			Vector:      "CVSS:2.0/AV:N/AC:L/Au:N/C:P/I:P/A:P",
+			ExpectedErr: cvss.ErrUnsupportedVersion,
+		},
+		{
+			Name:        "Missing metric",
+			Vector:      "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H",
+			ExpectedErr: cvss.ErrMissingMetric,
+		},
+		{
+			Name:        "Invalid metric value",
+			Vector:      "CVSS:3.1/AV:X/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+			ExpectedErr: cvss.ErrInvalidMetricValue,
+		},
+		{
+			Name:        "Duplicate metric",
+			Vector:      "CVSS:3.1/AV:N/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+			ExpectedErr: cvss.ErrDuplicateMetric,
+		},
+		{
+			Name:        "Malformed metric",
+			Vector:      "CVSS:3.1/AV:N/AC/PR:N/UI:N/S:U/C:H/I:H/A:H",
+			ExpectedErr: cvss.ErrInvalidVector,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// WHEN
+			_, err := cvss.Parse(testCase.Vector)
+
+			// THEN
+			assert.ErrorIs(t, err, testCase.ExpectedErr)
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	vector, ok := cvss.Find("Severity: **CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H**\n\nSummary: ...")
+	assert.True(t, ok)
+	assert.Equal(t, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", vector)
+
+	_, ok = cvss.Find("Severity: high")
+	assert.False(t, ok)
+}
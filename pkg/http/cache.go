@@ -0,0 +1,192 @@
+package http
+
+import (
+	"bufio"
+	"bytes"
+	"container/list"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"sync"
+)
+
+// Cache stores serialized responses by key.
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, response []byte)
+}
+
+type memoryCacheEntry struct {
+	key      string
+	response []byte
+}
+
+// memoryCache is a Cache keeping the least recently used responses up to a total size in memory.
+type memoryCache struct {
+	mu       sync.Mutex
+	maxBytes int
+	bytes    int
+	entries  map[string]*list.Element
+	order    *list.List
+}
+
+// NewMemoryCache returns a Cache holding up to maxBytes of responses in memory.
+// The least recently used responses are evicted first, responses larger than maxBytes are not stored.
+func NewMemoryCache(maxBytes int) Cache {
+	return &memoryCache{
+		maxBytes: maxBytes,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+func (c *memoryCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+	return element.Value.(*memoryCacheEntry).response, true
+}
+
+func (c *memoryCache) Set(key string, response []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+
+	if len(response) > c.maxBytes {
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&memoryCacheEntry{key: key, response: response})
+	c.bytes += len(response)
+	for c.bytes > c.maxBytes {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *memoryCache) remove(element *list.Element) {
+	entry := c.order.Remove(element).(*memoryCacheEntry)
+	delete(c.entries, entry.key)
+	c.bytes -= len(entry.response)
+}
+
+// scopedCache stores responses in a shared cache under keys prefixed by a scope.
+type scopedCache struct {
+	cache Cache
+	scope string
+}
+
+// NewScopedCache returns a Cache storing responses in the shared cache under keys prefixed by scope.
+// Clients authenticated with different credentials must use different scopes to not be served each other's responses.
+func NewScopedCache(cache Cache, scope string) Cache {
+	return scopedCache{cache: cache, scope: scope}
+}
+
+func (c scopedCache) Get(key string) ([]byte, bool) {
+	return c.cache.Get(c.scope + " " + key)
+}
+
+func (c scopedCache) Set(key string, response []byte) {
+	c.cache.Set(c.scope+" "+key, response)
+}
+
+// cachingRoundTripper revalidates GET requests with the ETag or Last-Modified header of the cached response
+// and serves the cached response if the server responds with 304 Not Modified.
+type cachingRoundTripper struct {
+	rT    http.RoundTripper
+	cache Cache
+}
+
+func (cRT cachingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || req.Header.Get("Range") != "" {
+		return cRT.rT.RoundTrip(req)
+	}
+
+	key := cacheKey(req)
+	cached, ok := cRT.cache.Get(key)
+	var cachedRes *http.Response
+	if ok {
+		var err error
+		cachedRes, err = http.ReadResponse(bufio.NewReader(bytes.NewReader(cached)), req)
+		if err != nil {
+			cachedRes = nil
+		}
+	}
+
+	if cachedRes != nil {
+		// The request must not be modified by a RoundTripper
+		req = req.Clone(req.Context())
+		if etag := cachedRes.Header.Get("ETag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if lastModified := cachedRes.Header.Get("Last-Modified"); lastModified != "" {
+			req.Header.Set("If-Modified-Since", lastModified)
+		}
+	}
+
+	res, err := cRT.rT.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == http.StatusNotModified && cachedRes != nil {
+		_ = res.Body.Close()
+		// Headers of the 304 response are more recent than the cached ones,
+		// this keeps the rate limits read from the response accurate
+		for name, values := range res.Header {
+			cachedRes.Header[name] = values
+		}
+		cRT.store(key, cachedRes)
+		// Marks the response as served from the cache, following the convention of other caching transports
+		cachedRes.Header.Set("X-From-Cache", "1")
+		return cachedRes, nil
+	}
+
+	if res.StatusCode == http.StatusOK && (res.Header.Get("ETag") != "" || res.Header.Get("Last-Modified") != "") {
+		cRT.store(key, res)
+	}
+
+	return res, nil
+}
+
+// store serializes a response into the cache, restoring its body to be read by the caller.
+func (cRT cachingRoundTripper) store(key string, res *http.Response) {
+	body, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+
+	// DumpResponse restores the body it read
+	dump, err := httputil.DumpResponse(res, true)
+	if err != nil {
+		return
+	}
+
+	cRT.cache.Set(key, dump)
+}
+
+// cacheKey returns the key of a request, the Accept header is part of the key since it selects the media type of the response.
+func cacheKey(req *http.Request) string {
+	return req.Header.Get("Accept") + " " + req.URL.String()
+}
+
+// AddCaching adds conditional request caching of GET requests to the client.
+func AddCaching(client *http.Client, cache Cache) *http.Client {
+	transport := client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	client.Transport = cachingRoundTripper{rT: transport, cache: cache}
+	return client
+}
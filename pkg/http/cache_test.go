@@ -0,0 +1,92 @@
+package http_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	libHttp "github.com/morphysm/famed-github-backend/pkg/http"
+)
+
+func TestAddCaching(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	var requests, notModified int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(`[{"number":1}]`))
+	}))
+	t.Cleanup(server.Close)
+	client := libHttp.AddCaching(&http.Client{}, libHttp.NewMemoryCache(1<<20))
+
+	for i, expectedFromCache := range []string{"", "1", "1"} {
+		// WHEN
+		res, err := client.Get(server.URL + "/repos/test/test/issues")
+
+		// THEN
+		assert.NoError(t, err)
+		body, err := io.ReadAll(res.Body)
+		assert.NoError(t, err)
+		_ = res.Body.Close()
+		assert.Equal(t, http.StatusOK, res.StatusCode, "request %d", i)
+		assert.Equal(t, `[{"number":1}]`, string(body), "request %d", i)
+		assert.Equal(t, expectedFromCache, res.Header.Get("X-From-Cache"), "request %d", i)
+		assert.Equal(t, "4999", res.Header.Get("X-RateLimit-Remaining"), "request %d", i)
+	}
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&notModified))
+
+	// WHEN requesting a resource with a different media type
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/repos/test/test/issues", nil)
+	req.Header.Set("Accept", "application/vnd.github.v3.raw")
+	res, err := client.Do(req)
+
+	// THEN
+	assert.NoError(t, err)
+	_ = res.Body.Close()
+	assert.Equal(t, "", res.Header.Get("X-From-Cache"))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&notModified))
+}
+
+func TestMemoryCache(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	cache := libHttp.NewMemoryCache(10)
+	cache.Set("a", []byte("1234"))
+	cache.Set("b", []byte("1234"))
+
+	// WHEN "a" is used more recently than "b" and adding "c" exceeds the size
+	_, ok := cache.Get("a")
+	assert.True(t, ok)
+	cache.Set("c", []byte("1234"))
+
+	// THEN
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+	value, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1234"), value)
+	_, ok = cache.Get("c")
+	assert.True(t, ok)
+
+	// WHEN adding a response larger than the cache
+	cache.Set("d", []byte("12345678901"))
+
+	// THEN
+	_, ok = cache.Get("d")
+	assert.False(t, ok)
+}
@@ -0,0 +1,285 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// mutationInterval is the minimum time between requests changing resources, as recommended by GitHub to avoid secondary rate limits.
+	mutationInterval = time.Second
+	// secondaryLimitBackoff is the time requests are paused after a secondary rate limit without Retry-After header.
+	secondaryLimitBackoff = time.Minute
+	// maxRetryWait is the longest time a rate limited GET request is held to be retried, longer waits return the rate limited response.
+	maxRetryWait = 10 * time.Second
+	// lowBudgetShare is the share of the rate limit below which the remaining requests are spread out until the reset.
+	lowBudgetShare = 10
+)
+
+// ThrottleResource represents the rate limit budget of a GitHub API resource, such as core or graphql.
+type ThrottleResource struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// ThrottleState represents the state of a Throttle.
+type ThrottleState struct {
+	Resources    map[string]ThrottleResource
+	BlockedUntil time.Time
+	InFlight     int
+}
+
+// Throttle paces the requests to the GitHub API of an installation.
+// It tracks the remaining rate limit budget of the API resources from the response headers,
+// limits the number of concurrent requests, spaces out requests changing resources,
+// spreads out the requests once the remaining budget runs low and pauses all requests after a rate limited response.
+type Throttle struct {
+	mu           sync.Mutex
+	slots        chan struct{}
+	resources    map[string]ThrottleResource
+	blockedUntil time.Time
+	lastRequest  map[string]time.Time
+	lastMutation time.Time
+	now          func() time.Time
+}
+
+// NewThrottle returns a pointer to a Throttle allowing maxConcurrent requests at a time.
+func NewThrottle(maxConcurrent int) *Throttle {
+	return &Throttle{
+		slots:       make(chan struct{}, maxConcurrent),
+		resources:   make(map[string]ThrottleResource),
+		lastRequest: make(map[string]time.Time),
+		now:         time.Now,
+	}
+}
+
+// State returns the current state of the throttle.
+func (t *Throttle) State() ThrottleState {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	resources := make(map[string]ThrottleResource, len(t.resources))
+	for name, resource := range t.resources {
+		resources[name] = resource
+	}
+
+	return ThrottleState{
+		Resources:    resources,
+		BlockedUntil: t.blockedUntil,
+		InFlight:     len(t.slots),
+	}
+}
+
+// acquire blocks until a request to resource may be sent and takes a concurrency slot, which must be released.
+func (t *Throttle) acquire(ctx context.Context, resource string, mutation bool) error {
+	select {
+	case t.slots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	for {
+		wait := t.reserve(resource, mutation)
+		if wait <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			t.release()
+			return ctx.Err()
+		}
+	}
+}
+
+func (t *Throttle) release() {
+	<-t.slots
+}
+
+// reserve returns how long a request must wait, if it does not have to wait the request is recorded as sent.
+func (t *Throttle) reserve(resource string, mutation bool) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	next := t.blockedUntil
+
+	if budget, ok := t.resources[resource]; ok && budget.Reset.After(now) {
+		switch {
+		case budget.Remaining <= 0:
+			next = latest(next, budget.Reset)
+		case budget.Remaining < budget.Limit/lowBudgetShare:
+			// Spread the remaining budget evenly until the reset
+			interval := budget.Reset.Sub(now) / time.Duration(budget.Remaining)
+			next = latest(next, t.lastRequest[resource].Add(interval))
+		}
+	}
+
+	if mutation {
+		next = latest(next, t.lastMutation.Add(mutationInterval))
+	}
+
+	if next.After(now) {
+		return next.Sub(now)
+	}
+
+	t.lastRequest[resource] = now
+	if mutation {
+		t.lastMutation = now
+	}
+
+	return 0
+}
+
+// update records the rate limit headers of a response and returns the time requests are paused until if the response was rate limited.
+func (t *Throttle) update(resource string, res *http.Response, secondaryLimit bool) time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	if name := res.Header.Get("X-RateLimit-Resource"); name != "" {
+		resource = name
+	}
+
+	budget, hasBudget := parseRateLimitHeaders(res.Header)
+	if hasBudget {
+		t.resources[resource] = budget
+	}
+
+	if res.StatusCode != http.StatusForbidden && res.StatusCode != http.StatusTooManyRequests {
+		return time.Time{}
+	}
+
+	var until time.Time
+	switch {
+	case res.Header.Get("Retry-After") != "":
+		seconds, err := strconv.Atoi(res.Header.Get("Retry-After"))
+		if err != nil {
+			seconds = int(secondaryLimitBackoff.Seconds())
+		}
+		until = now.Add(time.Duration(seconds) * time.Second)
+	case hasBudget && budget.Remaining == 0:
+		until = budget.Reset
+	case res.StatusCode == http.StatusTooManyRequests || secondaryLimit:
+		until = now.Add(secondaryLimitBackoff)
+	default:
+		// Any other 403 is a permission error
+		return time.Time{}
+	}
+
+	t.blockedUntil = latest(t.blockedUntil, until)
+	return until
+}
+
+// isSecondaryLimit returns whether a 403 response is a secondary rate limit, the body of the response is restored.
+func isSecondaryLimit(res *http.Response) bool {
+	body, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+
+	message := strings.ToLower(string(body))
+	return strings.Contains(message, "secondary rate limit") || strings.Contains(message, "abuse detection")
+}
+
+func parseRateLimitHeaders(header http.Header) (ThrottleResource, bool) {
+	limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return ThrottleResource{}, false
+	}
+
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return ThrottleResource{}, false
+	}
+
+	reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return ThrottleResource{}, false
+	}
+
+	return ThrottleResource{Limit: limit, Remaining: remaining, Reset: time.Unix(reset, 0)}, true
+}
+
+func latest(a time.Time, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// requestResource returns the GitHub API resource a request is counted against.
+func requestResource(req *http.Request) string {
+	switch {
+	case strings.HasSuffix(req.URL.Path, "/graphql"):
+		return "graphql"
+	case strings.Contains(req.URL.Path, "/search/"):
+		return "search"
+	default:
+		return "core"
+	}
+}
+
+// throttlingRoundTripper sends requests paced by a Throttle.
+// Rate limited GET requests are retried once if the wait is short.
+type throttlingRoundTripper struct {
+	rT       http.RoundTripper
+	throttle *Throttle
+}
+
+func (tRT throttlingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Requesting the rate limits is not counted against them and must not be blocked to report them
+	if strings.HasSuffix(req.URL.Path, "/rate_limit") {
+		return tRT.rT.RoundTrip(req)
+	}
+
+	var (
+		resource = requestResource(req)
+		mutation = req.Method != http.MethodGet && req.Method != http.MethodHead && resource != "graphql"
+		retried  bool
+	)
+
+	for {
+		if err := tRT.throttle.acquire(req.Context(), resource, mutation); err != nil {
+			return nil, err
+		}
+		res, err := tRT.rT.RoundTrip(req)
+		tRT.throttle.release()
+		if err != nil {
+			return nil, err
+		}
+
+		secondaryLimit := res.StatusCode == http.StatusForbidden && isSecondaryLimit(res)
+		until := tRT.throttle.update(resource, res, secondaryLimit)
+		if until.IsZero() || retried || req.Method != http.MethodGet || until.Sub(tRT.throttle.now()) > maxRetryWait {
+			return res, nil
+		}
+
+		_ = res.Body.Close()
+		retried = true
+	}
+}
+
+// AddThrottling paces the requests of the client with the throttle.
+// Clients sharing a rate limit budget should share the throttle.
+func AddThrottling(client *http.Client, throttle *Throttle) *http.Client {
+	transport := client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	client.Transport = throttlingRoundTripper{rT: transport, throttle: throttle}
+	return client
+}
@@ -0,0 +1,179 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	libHttp "github.com/morphysm/famed-github-backend/pkg/http"
+)
+
+func TestAddThrottling(t *testing.T) {
+	t.Parallel()
+
+	reset := time.Now().Add(time.Hour).Truncate(time.Second)
+	testCases := []struct {
+		Name                 string
+		Responses            []func(w http.ResponseWriter)
+		ExpectedStatus       int
+		ExpectedRequests     int32
+		ExpectedBlocked      bool
+		ExpectedMinDuration  time.Duration
+		ExpectedCoreResource *libHttp.ThrottleResource
+	}{
+		{
+			Name: "Budget",
+			Responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.Header().Set("X-RateLimit-Limit", "5000")
+					w.Header().Set("X-RateLimit-Remaining", "4999")
+					w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+					w.Header().Set("X-RateLimit-Resource", "core")
+				},
+			},
+			ExpectedStatus:       http.StatusOK,
+			ExpectedRequests:     1,
+			ExpectedCoreResource: &libHttp.ThrottleResource{Limit: 5000, Remaining: 4999, Reset: reset},
+		},
+		{
+			Name: "Retry-After",
+			Responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.Header().Set("Retry-After", "1")
+					w.WriteHeader(http.StatusTooManyRequests)
+				},
+				func(w http.ResponseWriter) {},
+			},
+			ExpectedStatus:      http.StatusOK,
+			ExpectedRequests:    2,
+			ExpectedMinDuration: time.Second,
+		},
+		{
+			Name: "Secondary rate limit",
+			Responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.WriteHeader(http.StatusForbidden)
+					_, _ = w.Write([]byte(`{"message":"You have exceeded a secondary rate limit."}`))
+				},
+			},
+			ExpectedStatus:   http.StatusForbidden,
+			ExpectedRequests: 1,
+			ExpectedBlocked:  true,
+		},
+		{
+			Name: "Primary rate limit",
+			Responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.Header().Set("X-RateLimit-Limit", "5000")
+					w.Header().Set("X-RateLimit-Remaining", "0")
+					w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+					w.WriteHeader(http.StatusForbidden)
+				},
+			},
+			ExpectedStatus:       http.StatusForbidden,
+			ExpectedRequests:     1,
+			ExpectedBlocked:      true,
+			ExpectedCoreResource: &libHttp.ThrottleResource{Limit: 5000, Remaining: 0, Reset: reset},
+		},
+		{
+			Name: "Permission error",
+			Responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.WriteHeader(http.StatusForbidden)
+					_, _ = w.Write([]byte(`{"message":"Resource not accessible by integration"}`))
+				},
+			},
+			ExpectedStatus:   http.StatusForbidden,
+			ExpectedRequests: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			// GIVEN
+			var requests int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				i := atomic.AddInt32(&requests, 1) - 1
+				testCase.Responses[i](w)
+			}))
+			t.Cleanup(server.Close)
+			throttle := libHttp.NewThrottle(2)
+			client := libHttp.AddThrottling(&http.Client{}, throttle)
+
+			// WHEN
+			start := time.Now()
+			res, err := client.Get(server.URL + "/repos/test/test/issues")
+
+			// THEN
+			assert.NoError(t, err)
+			_ = res.Body.Close()
+			assert.Equal(t, testCase.ExpectedStatus, res.StatusCode)
+			assert.Equal(t, testCase.ExpectedRequests, atomic.LoadInt32(&requests))
+			assert.GreaterOrEqual(t, time.Since(start), testCase.ExpectedMinDuration)
+			state := throttle.State()
+			assert.Equal(t, testCase.ExpectedBlocked, state.BlockedUntil.After(time.Now()))
+			assert.Equal(t, 0, state.InFlight)
+			if testCase.ExpectedCoreResource != nil {
+				assert.Equal(t, *testCase.ExpectedCoreResource, state.Resources["core"])
+			}
+
+			if testCase.ExpectedBlocked {
+				// WHEN sending a request while blocked
+				ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+				defer cancel()
+				req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/repos/test/test/issues", nil)
+				_, err = client.Do(req)
+
+				// THEN
+				assert.ErrorIs(t, err, context.DeadlineExceeded)
+				assert.Equal(t, testCase.ExpectedRequests, atomic.LoadInt32(&requests))
+			}
+		})
+	}
+}
+
+func TestAddThrottlingConcurrency(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}))
+	t.Cleanup(server.Close)
+	client := libHttp.AddThrottling(&http.Client{}, libHttp.NewThrottle(2))
+
+	// WHEN
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := client.Get(server.URL + "/repos/test/test/issues")
+			if assert.NoError(t, err) {
+				_ = res.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	// THEN
+	assert.Equal(t, int32(2), atomic.LoadInt32(&maxInFlight))
+}
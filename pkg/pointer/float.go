@@ -0,0 +1,5 @@
+package pointer
+
+func Float64(f float64) *float64 {
+	return &f
+}
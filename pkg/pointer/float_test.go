@@ -0,0 +1,16 @@
+package pointer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/morphysm/famed-github-backend/pkg/pointer"
+)
+
+func TestFloat64(t *testing.T) {
+	t.Parallel()
+
+	value := pointer.Float64(1.5)
+	assert.Equal(t, 1.5, *value)
+}
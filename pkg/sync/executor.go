@@ -0,0 +1,151 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+)
+
+// Errors represents the errors returned by the tasks of an Executor.
+type Errors []error
+
+// Error returns the messages of all errors separated by "; ".
+func (e Errors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Is reports whether any of the errors matches target, it is used by errors.Is.
+func (e Errors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// As finds the first of the errors that matches target and sets target to it, it is used by errors.As.
+func (e Errors) As(target interface{}) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Budget bounds the number of tasks running at a time across the executors sharing it.
+type Budget struct {
+	slots chan struct{}
+}
+
+// NewBudget returns a pointer to a Budget of maxConcurrent tasks.
+// A maxConcurrent below 1 runs one task at a time.
+func NewBudget(maxConcurrent int) *Budget {
+	if maxConcurrent < 1 {
+		maxConcurrent = 1
+	}
+
+	return &Budget{slots: make(chan struct{}, maxConcurrent)}
+}
+
+// heldSlotKey is the context key of the budget a slot is held of by the running task.
+type heldSlotKey struct{}
+
+// Executor runs tasks concurrently with a bounded number of goroutines.
+// Tasks receive the context of the executor, tasks not yet started when the context is cancelled are skipped.
+// The errors of all tasks are collected and returned by Wait.
+type Executor struct {
+	ctx    context.Context
+	budget *Budget
+	// yielded is true if the executor was started by a task of the same budget that yielded its slot until Wait returns
+	yielded bool
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	errs    Errors
+}
+
+// NewExecutor returns a pointer to an Executor running up to maxConcurrent tasks at a time.
+// A maxConcurrent below 1 runs one task at a time.
+func NewExecutor(ctx context.Context, maxConcurrent int) *Executor {
+	return NewSharedExecutor(ctx, NewBudget(maxConcurrent))
+}
+
+// NewSharedExecutor returns a pointer to an Executor running its tasks within a budget shared with other executors.
+// A task of the same budget starting a nested executor yields its slot to the nested tasks until Wait returns,
+// so nested executors never wait for slots held by their parents. Such a task must wait for a nested executor before starting another.
+func NewSharedExecutor(ctx context.Context, budget *Budget) *Executor {
+	executor := &Executor{
+		ctx:    ctx,
+		budget: budget,
+	}
+
+	if held, ok := ctx.Value(heldSlotKey{}).(*Budget); ok && held == budget {
+		executor.yielded = true
+		<-budget.slots
+	}
+
+	return executor
+}
+
+// Go runs the task in a new goroutine, blocking until fewer than maxConcurrent tasks are running.
+// If the context is cancelled before the task is started, the task is skipped and the context error is collected.
+func (e *Executor) Go(task func(ctx context.Context) error) {
+	select {
+	case e.budget.slots <- struct{}{}:
+	case <-e.ctx.Done():
+		e.addError(e.ctx.Err())
+		return
+	}
+
+	// A slot and the cancellation may become ready at the same time
+	if err := e.ctx.Err(); err != nil {
+		<-e.budget.slots
+		e.addError(err)
+		return
+	}
+
+	e.wg.Add(1)
+	go func() {
+		defer func() {
+			<-e.budget.slots
+			e.wg.Done()
+		}()
+
+		if err := task(context.WithValue(e.ctx, heldSlotKey{}, e.budget)); err != nil {
+			e.addError(err)
+		}
+	}()
+}
+
+// Wait waits for all started tasks to return and takes back the slot yielded by the task that started the executor.
+// It returns the errors of the tasks as Errors, or nil if all tasks succeeded.
+func (e *Executor) Wait() error {
+	e.wg.Wait()
+	if e.yielded {
+		e.yielded = false
+		e.budget.slots <- struct{}{}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.errs) == 0 {
+		return nil
+	}
+
+	return e.errs
+}
+
+func (e *Executor) addError(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.errs = append(e.errs, err)
+}
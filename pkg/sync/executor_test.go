@@ -0,0 +1,151 @@
+package sync_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	libSync "github.com/morphysm/famed-github-backend/pkg/sync"
+)
+
+func TestExecutor(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	var running, maxRunning, done int32
+	executor := libSync.NewExecutor(context.Background(), 3)
+	errTask := errors.New("task error")
+
+	// WHEN
+	for i := 0; i < 10; i++ {
+		i := i
+		executor.Go(func(ctx context.Context) error {
+			current := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				observed := atomic.LoadInt32(&maxRunning)
+				if current <= observed || atomic.CompareAndSwapInt32(&maxRunning, observed, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&done, 1)
+
+			if i%5 == 0 {
+				return errTask
+			}
+			return nil
+		})
+	}
+	err := executor.Wait()
+
+	// THEN
+	assert.Equal(t, int32(10), atomic.LoadInt32(&done))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&maxRunning))
+	assert.ErrorIs(t, err, errTask)
+	var errs libSync.Errors
+	assert.ErrorAs(t, err, &errs)
+	assert.Len(t, errs, 2)
+}
+
+func TestSharedExecutor(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	var running, maxRunning, done int32
+	budget := libSync.NewBudget(2)
+	executor := libSync.NewSharedExecutor(context.Background(), budget)
+
+	// WHEN every task fans out to a nested executor sharing the budget
+	for i := 0; i < 4; i++ {
+		executor.Go(func(ctx context.Context) error {
+			nested := libSync.NewSharedExecutor(ctx, budget)
+			for j := 0; j < 5; j++ {
+				nested.Go(func(ctx context.Context) error {
+					current := atomic.AddInt32(&running, 1)
+					defer atomic.AddInt32(&running, -1)
+					for {
+						observed := atomic.LoadInt32(&maxRunning)
+						if current <= observed || atomic.CompareAndSwapInt32(&maxRunning, observed, current) {
+							break
+						}
+					}
+					time.Sleep(time.Millisecond)
+					atomic.AddInt32(&done, 1)
+					return nil
+				})
+			}
+
+			return nested.Wait()
+		})
+	}
+	err := executor.Wait()
+
+	// THEN the nested tasks do not wait for the slots of their parents and stay within the budget
+	assert.NoError(t, err)
+	assert.Equal(t, int32(20), atomic.LoadInt32(&done))
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxRunning), int32(2))
+}
+
+func TestExecutorCancel(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	var started int32
+	ctx, cancel := context.WithCancel(context.Background())
+	executor := libSync.NewExecutor(ctx, 1)
+	executor.Go(func(ctx context.Context) error {
+		atomic.AddInt32(&started, 1)
+		cancel()
+		<-ctx.Done()
+		return nil
+	})
+
+	// WHEN
+	executor.Go(func(ctx context.Context) error {
+		atomic.AddInt32(&started, 1)
+		return nil
+	})
+	err := executor.Wait()
+
+	// THEN
+	assert.Equal(t, int32(1), atomic.LoadInt32(&started))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestExecutorNoErrors(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	executor := libSync.NewExecutor(context.Background(), 0)
+
+	// WHEN
+	executor.Go(func(ctx context.Context) error { return nil })
+
+	// THEN
+	assert.NoError(t, executor.Wait())
+}
+
+func TestErrors(t *testing.T) {
+	t.Parallel()
+
+	// GIVEN
+	errTask := errors.New("task error")
+	errPath := &fs.PathError{Op: "open", Path: "test", Err: fs.ErrNotExist}
+	var err error = libSync.Errors{errTask, fmt.Errorf("wrapped: %w", errPath)}
+
+	// THEN
+	assert.Equal(t, "task error; wrapped: open test: file does not exist", err.Error())
+	assert.ErrorIs(t, err, errTask)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	assert.NotErrorIs(t, err, context.Canceled)
+	var pathErr *fs.PathError
+	assert.ErrorAs(t, err, &pathErr)
+	assert.Equal(t, errPath, pathErr)
+}